@@ -637,6 +637,23 @@ func deepCopy_api_SubjectAccessReviewResponse(in api.SubjectAccessReviewResponse
 	return nil
 }
 
+func deepCopy_api_BinaryBuildSource(in buildapi.BinaryBuildSource, out *buildapi.BinaryBuildSource, c *conversion.Cloner) error {
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func deepCopy_api_BitbucketWebHookCause(in buildapi.BitbucketWebHookCause, out *buildapi.BitbucketWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := deepCopy_api_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Build(in buildapi.Build, out *buildapi.Build, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -715,6 +732,19 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 	if err := deepCopy_api_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = in.RunPolicy
 	return nil
 }
 
@@ -723,6 +753,32 @@ func deepCopy_api_BuildConfigStatus(in buildapi.BuildConfigStatus, out *buildapi
 	return nil
 }
 
+func deepCopy_api_BuildEnvVar(in buildapi.BuildEnvVar, out *buildapi.BuildEnvVar, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(buildapi.BuildEnvVarSource)
+		if err := deepCopy_api_BuildEnvVarSource(*in.ValueFrom, out.ValueFrom, c); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildEnvVarSource(in buildapi.BuildEnvVarSource, out *buildapi.BuildEnvVarSource, c *conversion.Cloner) error {
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(buildapi.SecretKeySelector)
+		if err := deepCopy_api_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
 func deepCopy_api_BuildList(in buildapi.BuildList, out *buildapi.BuildList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -794,6 +850,27 @@ func deepCopy_api_BuildOutput(in buildapi.BuildOutput, out *buildapi.BuildOutput
 	return nil
 }
 
+func deepCopy_api_BuildPostCommitSpec(in buildapi.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, c *conversion.Cloner) error {
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
 func deepCopy_api_BuildRequest(in buildapi.BuildRequest, out *buildapi.BuildRequest, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -822,6 +899,14 @@ func deepCopy_api_BuildRequest(in buildapi.BuildRequest, out *buildapi.BuildRequ
 	} else {
 		out.TriggeredByImage = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(buildapi.BinaryBuildSource)
+		if err := deepCopy_api_BinaryBuildSource(*in.Binary, out.Binary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
 			return err
@@ -837,6 +922,16 @@ func deepCopy_api_BuildRequest(in buildapi.BuildRequest, out *buildapi.BuildRequ
 	} else {
 		out.LastVersion = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]buildapi.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := deepCopy_api_BuildTriggerCause(in.TriggeredBy[i], &out.TriggeredBy[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -850,6 +945,30 @@ func deepCopy_api_BuildSource(in buildapi.BuildSource, out *buildapi.BuildSource
 	} else {
 		out.Git = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(buildapi.BinaryBuildSource)
+		if err := deepCopy_api_BinaryBuildSource(*in.Binary, out.Binary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]buildapi.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_api_ImageSource(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		if newVal, err := c.DeepCopy(in.SourceSecret); err != nil {
@@ -860,6 +979,16 @@ func deepCopy_api_BuildSource(in buildapi.BuildSource, out *buildapi.BuildSource
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]buildapi.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := deepCopy_api_SecretBuildSource(in.Secrets[i], &out.Secrets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -887,12 +1016,31 @@ func deepCopy_api_BuildSpec(in buildapi.BuildSpec, out *buildapi.BuildSpec, c *c
 	} else {
 		out.Resources = newVal.(pkgapi.ResourceRequirements)
 	}
+	if err := deepCopy_api_BuildPostCommitSpec(in.PostCommit, &out.PostCommit, c); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildStage(in buildapi.BuildStage, out *buildapi.BuildStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.BuildConfig); err != nil {
+		return err
+	} else {
+		out.BuildConfig = newVal.(pkgapi.LocalObjectReference)
+	}
 	return nil
 }
 
 func deepCopy_api_BuildStatus(in buildapi.BuildStatus, out *buildapi.BuildStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
 	out.Cancelled = in.Cancelled
+	out.Reason = in.Reason
 	out.Message = in.Message
 	if in.StartTimestamp != nil {
 		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
@@ -922,6 +1070,16 @@ func deepCopy_api_BuildStatus(in buildapi.BuildStatus, out *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]buildapi.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := deepCopy_api_BuildTriggerCause(in.TriggeredBy[i], &out.TriggeredBy[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -954,6 +1112,59 @@ func deepCopy_api_BuildStrategy(in buildapi.BuildStrategy, out *buildapi.BuildSt
 	return nil
 }
 
+func deepCopy_api_BuildTriggerCause(in buildapi.BuildTriggerCause, out *buildapi.BuildTriggerCause, c *conversion.Cloner) error {
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(buildapi.GenericWebHookCause)
+		if err := deepCopy_api_GenericWebHookCause(*in.GenericWebHook, out.GenericWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(buildapi.GitHubWebHookCause)
+		if err := deepCopy_api_GitHubWebHookCause(*in.GitHubWebHook, out.GitHubWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.GitLabWebHookCause)
+		if err := deepCopy_api_GitLabWebHookCause(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.BitbucketWebHookCause)
+		if err := deepCopy_api_BitbucketWebHookCause(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(buildapi.ImageChangeCause)
+		if err := deepCopy_api_ImageChangeCause(*in.ImageChangeBuild, out.ImageChangeBuild, c); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(buildapi.ManualCause)
+		if err := deepCopy_api_ManualCause(*in.Manual, out.Manual, c); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
 func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *buildapi.BuildTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.GitHubWebHook != nil {
@@ -972,6 +1183,22 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := deepCopy_api_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1015,6 +1242,15 @@ func deepCopy_api_CustomBuildStrategy(in buildapi.CustomBuildStrategy, out *buil
 	return nil
 }
 
+func deepCopy_api_DeployStage(in buildapi.DeployStage, out *buildapi.DeployStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.DeploymentConfig); err != nil {
+		return err
+	} else {
+		out.DeploymentConfig = newVal.(pkgapi.LocalObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buildapi.DockerBuildStrategy, c *conversion.Cloner) error {
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
@@ -1036,12 +1272,10 @@ func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buil
 	}
 	out.NoCache = in.NoCache
 	if in.Env != nil {
-		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		out.Env = make([]buildapi.BuildEnvVar, len(in.Env))
 		for i := range in.Env {
-			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+			if err := deepCopy_api_BuildEnvVar(in.Env[i], &out.Env[i], c); err != nil {
 				return err
-			} else {
-				out.Env[i] = newVal.(pkgapi.EnvVar)
 			}
 		}
 	} else {
@@ -1051,6 +1285,18 @@ func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buil
 	return nil
 }
 
+func deepCopy_api_GenericWebHookCause(in buildapi.GenericWebHookCause, out *buildapi.GenericWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := deepCopy_api_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_api_GitBuildSource(in buildapi.GitBuildSource, out *buildapi.GitBuildSource, c *conversion.Cloner) error {
 	out.URI = in.URI
 	out.Ref = in.Ref
@@ -1059,6 +1305,30 @@ func deepCopy_api_GitBuildSource(in buildapi.GitBuildSource, out *buildapi.GitBu
 	return nil
 }
 
+func deepCopy_api_GitHubWebHookCause(in buildapi.GitHubWebHookCause, out *buildapi.GitHubWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := deepCopy_api_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func deepCopy_api_GitLabWebHookCause(in buildapi.GitLabWebHookCause, out *buildapi.GitLabWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := deepCopy_api_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_api_GitSourceRevision(in buildapi.GitSourceRevision, out *buildapi.GitSourceRevision, c *conversion.Cloner) error {
 	out.Commit = in.Commit
 	if err := deepCopy_api_SourceControlUser(in.Author, &out.Author, c); err != nil {
@@ -1068,6 +1338,21 @@ func deepCopy_api_GitSourceRevision(in buildapi.GitSourceRevision, out *buildapi
 		return err
 	}
 	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func deepCopy_api_ImageChangeCause(in buildapi.ImageChangeCause, out *buildapi.ImageChangeCause, c *conversion.Cloner) error {
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		if newVal, err := c.DeepCopy(in.FromRef); err != nil {
+			return err
+		} else {
+			out.FromRef = newVal.(*pkgapi.ObjectReference)
+		}
+	} else {
+		out.FromRef = nil
+	}
 	return nil
 }
 
@@ -1085,12 +1370,22 @@ func deepCopy_api_ImageChangeTrigger(in buildapi.ImageChangeTrigger, out *builda
 	return nil
 }
 
-func deepCopy_api_SourceBuildStrategy(in buildapi.SourceBuildStrategy, out *buildapi.SourceBuildStrategy, c *conversion.Cloner) error {
+func deepCopy_api_ImageSource(in buildapi.ImageSource, out *buildapi.ImageSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
 	} else {
 		out.From = newVal.(pkgapi.ObjectReference)
 	}
+	if in.Paths != nil {
+		out.Paths = make([]buildapi.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := deepCopy_api_ImageSourcePath(in.Paths[i], &out.Paths[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
 	if in.PullSecret != nil {
 		if newVal, err := c.DeepCopy(in.PullSecret); err != nil {
 			return err
@@ -1100,64 +1395,436 @@ func deepCopy_api_SourceBuildStrategy(in buildapi.SourceBuildStrategy, out *buil
 	} else {
 		out.PullSecret = nil
 	}
-	if in.Env != nil {
-		out.Env = make([]pkgapi.EnvVar, len(in.Env))
-		for i := range in.Env {
-			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
-				return err
-			} else {
-				out.Env[i] = newVal.(pkgapi.EnvVar)
-			}
-		}
-	} else {
-		out.Env = nil
-	}
-	out.Scripts = in.Scripts
-	out.Incremental = in.Incremental
-	out.ForcePull = in.ForcePull
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func deepCopy_api_SourceControlUser(in buildapi.SourceControlUser, out *buildapi.SourceControlUser, c *conversion.Cloner) error {
-	out.Name = in.Name
-	out.Email = in.Email
+func deepCopy_api_ImageSourcePath(in buildapi.ImageSourcePath, out *buildapi.ImageSourcePath, c *conversion.Cloner) error {
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func deepCopy_api_SourceRevision(in buildapi.SourceRevision, out *buildapi.SourceRevision, c *conversion.Cloner) error {
-	out.Type = in.Type
-	if in.Git != nil {
-		out.Git = new(buildapi.GitSourceRevision)
-		if err := deepCopy_api_GitSourceRevision(*in.Git, out.Git, c); err != nil {
-			return err
-		}
-	} else {
-		out.Git = nil
-	}
+func deepCopy_api_ManualCause(in buildapi.ManualCause, out *buildapi.ManualCause, c *conversion.Cloner) error {
+	out.User = in.User
 	return nil
 }
 
-func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHookTrigger, c *conversion.Cloner) error {
-	out.Secret = in.Secret
+func deepCopy_api_Pipeline(in buildapi.Pipeline, out *buildapi.Pipeline, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapi.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_PipelineSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_PipelineStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
 	return nil
 }
 
-func deepCopy_api_CustomDeploymentStrategyParams(in deployapi.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
-	out.Image = in.Image
-	if in.Environment != nil {
-		out.Environment = make([]pkgapi.EnvVar, len(in.Environment))
-		for i := range in.Environment {
-			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+func deepCopy_api_PipelineList(in buildapi.PipelineList, out *buildapi.PipelineList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapi.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(pkgapi.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.Pipeline, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_Pipeline(in.Items[i], &out.Items[i], c); err != nil {
 				return err
-			} else {
-				out.Environment[i] = newVal.(pkgapi.EnvVar)
 			}
 		}
 	} else {
-		out.Environment = nil
+		out.Items = nil
 	}
-	if in.Command != nil {
-		out.Command = make([]string, len(in.Command))
+	return nil
+}
+
+func deepCopy_api_PipelineSpec(in buildapi.PipelineSpec, out *buildapi.PipelineSpec, c *conversion.Cloner) error {
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_api_PipelineStage(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PipelineStage(in buildapi.PipelineStage, out *buildapi.PipelineStage, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	if in.Build != nil {
+		out.Build = new(buildapi.BuildStage)
+		if err := deepCopy_api_BuildStage(*in.Build, out.Build, c); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Deploy != nil {
+		out.Deploy = new(buildapi.DeployStage)
+		if err := deepCopy_api_DeployStage(*in.Deploy, out.Deploy, c); err != nil {
+			return err
+		}
+	} else {
+		out.Deploy = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(buildapi.VerifyStage)
+		if err := deepCopy_api_VerifyStage(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(buildapi.PromoteStage)
+		if err := deepCopy_api_PromoteStage(*in.Promote, out.Promote, c); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PipelineStageStatus(in buildapi.PipelineStageStatus, out *buildapi.PipelineStageStatus, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.Reference != nil {
+		if newVal, err := c.DeepCopy(in.Reference); err != nil {
+			return err
+		} else {
+			out.Reference = newVal.(*pkgapi.ObjectReference)
+		}
+	} else {
+		out.Reference = nil
+	}
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PipelineStatus(in buildapi.PipelineStatus, out *buildapi.PipelineStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_api_PipelineStageStatus(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PromoteStage(in buildapi.PromoteStage, out *buildapi.PromoteStage, c *conversion.Cloner) error {
+	if in.From != nil {
+		if newVal, err := c.DeepCopy(in.From); err != nil {
+			return err
+		} else {
+			out.From = newVal.(*pkgapi.ObjectReference)
+		}
+	} else {
+		out.From = nil
+	}
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapi.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_api_SecretBuildSource(in buildapi.SecretBuildSource, out *buildapi.SecretBuildSource, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapi.LocalObjectReference)
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func deepCopy_api_SecretKeySelector(in buildapi.SecretKeySelector, out *buildapi.SecretKeySelector, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.LocalObjectReference); err != nil {
+		return err
+	} else {
+		out.LocalObjectReference = newVal.(pkgapi.LocalObjectReference)
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func deepCopy_api_SourceBuildStrategy(in buildapi.SourceBuildStrategy, out *buildapi.SourceBuildStrategy, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	if in.PullSecret != nil {
+		if newVal, err := c.DeepCopy(in.PullSecret); err != nil {
+			return err
+		} else {
+			out.PullSecret = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.PullSecret = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	out.Scripts = in.Scripts
+	out.Incremental = in.Incremental
+	out.ForcePull = in.ForcePull
+	return nil
+}
+
+func deepCopy_api_SourceControlUser(in buildapi.SourceControlUser, out *buildapi.SourceControlUser, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+func deepCopy_api_SourceRevision(in buildapi.SourceRevision, out *buildapi.SourceRevision, c *conversion.Cloner) error {
+	out.Type = in.Type
+	if in.Git != nil {
+		out.Git = new(buildapi.GitSourceRevision)
+		if err := deepCopy_api_GitSourceRevision(*in.Git, out.Git, c); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	return nil
+}
+
+func deepCopy_api_VerifyStage(in buildapi.VerifyStage, out *buildapi.VerifyStage, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHookTrigger, c *conversion.Cloner) error {
+	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
+	return nil
+}
+
+func deepCopy_api_BlueGreenDeploymentStrategyParams(in deployapi.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryDeploymentStrategyParams(in deployapi.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.Steps != nil {
+		out.Steps = make([]deployapi.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_api_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.MaxContainerRestarts != nil {
+		out.MaxContainerRestarts = new(int)
+		*out.MaxContainerRestarts = *in.MaxContainerRestarts
+	} else {
+		out.MaxContainerRestarts = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryStep(in deployapi.CanaryStep, out *deployapi.CanaryStep, c *conversion.Cloner) error {
+	if in.Percent != nil {
+		out.Percent = new(int)
+		*out.Percent = *in.Percent
+	} else {
+		out.Percent = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = new(int)
+		*out.Replicas = *in.Replicas
+	} else {
+		out.Replicas = nil
+	}
+	if in.PauseSeconds != nil {
+		out.PauseSeconds = new(int64)
+		*out.PauseSeconds = *in.PauseSeconds
+	} else {
+		out.PauseSeconds = nil
+	}
+	out.ManualApproval = in.ManualApproval
+	return nil
+}
+
+func deepCopy_api_CustomDeploymentStrategyParams(in deployapi.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Environment != nil {
+		out.Environment = make([]pkgapi.EnvVar, len(in.Environment))
+		for i := range in.Environment {
+			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+				return err
+			} else {
+				out.Environment[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
+	} else {
+		out.Environment = nil
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
 		for i := range in.Command {
 			out.Command[i] = in.Command[i]
 		}
@@ -1207,6 +1874,14 @@ func deepCopy_api_DeploymentConfig(in deployapi.DeploymentConfig, out *deployapi
 	} else {
 		out.Triggers = nil
 	}
+	out.Paused = in.Paused
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	if err := deepCopy_api_DeploymentTemplate(in.Template, &out.Template, c); err != nil {
 		return err
 	}
@@ -1288,6 +1963,32 @@ func deepCopy_api_DeploymentDetails(in deployapi.DeploymentDetails, out *deploya
 	return nil
 }
 
+func deepCopy_api_DeploymentLog(in deployapi.DeploymentLog, out *deployapi.DeploymentLog, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapi.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(pkgapi.ListMeta)
+	}
+	return nil
+}
+
+func deepCopy_api_DeploymentLogOptions(in deployapi.DeploymentLogOptions, out *deployapi.DeploymentLogOptions, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapi.TypeMeta)
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deployapi.DeploymentStrategy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.CustomParams != nil {
@@ -1314,6 +2015,22 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_api_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := deepCopy_api_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1390,6 +2107,14 @@ func deepCopy_api_ExecNewPodHook(in deployapi.ExecNewPodHook, out *deployapi.Exe
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	if in.Volumes != nil {
+		out.Volumes = make([]string, len(in.Volumes))
+		for i := range in.Volumes {
+			out.Volumes[i] = in.Volumes[i]
+		}
+	} else {
+		out.Volumes = nil
+	}
 	return nil
 }
 
@@ -1403,6 +2128,16 @@ func deepCopy_api_LifecycleHook(in deployapi.LifecycleHook, out *deployapi.Lifec
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapi.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := deepCopy_api_TagImageHook(in.TagImages[i], &out.TagImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -1415,6 +2150,14 @@ func deepCopy_api_RecreateDeploymentStrategyParams(in deployapi.RecreateDeployme
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1451,6 +2194,25 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.MaxUnavailable != nil {
+		if newVal, err := c.DeepCopy(in.MaxUnavailable); err != nil {
+			return err
+		} else {
+			out.MaxUnavailable = newVal.(*util.IntOrString)
+		}
+	} else {
+		out.MaxUnavailable = nil
+	}
+	if in.MaxSurge != nil {
+		if newVal, err := c.DeepCopy(in.MaxSurge); err != nil {
+			return err
+		} else {
+			out.MaxSurge = newVal.(*util.IntOrString)
+		}
+	} else {
+		out.MaxSurge = nil
+	}
+	out.MinReadySeconds = in.MinReadySeconds
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1459,6 +2221,14 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1470,6 +2240,16 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	return nil
 }
 
+func deepCopy_api_TagImageHook(in deployapi.TagImageHook, out *deployapi.TagImageHook, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapi.ObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_api_DockerConfig(in imageapi.DockerConfig, out *imageapi.DockerConfig, c *conversion.Cloner) error {
 	out.Hostname = in.Hostname
 	out.Domainname = in.Domainname
@@ -2571,30 +3351,58 @@ func init() {
 		deepCopy_api_RoleList,
 		deepCopy_api_SubjectAccessReview,
 		deepCopy_api_SubjectAccessReviewResponse,
+		deepCopy_api_BinaryBuildSource,
+		deepCopy_api_BitbucketWebHookCause,
 		deepCopy_api_Build,
 		deepCopy_api_BuildConfig,
 		deepCopy_api_BuildConfigList,
 		deepCopy_api_BuildConfigSpec,
 		deepCopy_api_BuildConfigStatus,
+		deepCopy_api_BuildEnvVar,
+		deepCopy_api_BuildEnvVarSource,
 		deepCopy_api_BuildList,
 		deepCopy_api_BuildLog,
 		deepCopy_api_BuildLogOptions,
 		deepCopy_api_BuildOutput,
+		deepCopy_api_BuildPostCommitSpec,
 		deepCopy_api_BuildRequest,
 		deepCopy_api_BuildSource,
 		deepCopy_api_BuildSpec,
+		deepCopy_api_BuildStage,
 		deepCopy_api_BuildStatus,
 		deepCopy_api_BuildStrategy,
+		deepCopy_api_BuildTriggerCause,
 		deepCopy_api_BuildTriggerPolicy,
 		deepCopy_api_CustomBuildStrategy,
+		deepCopy_api_DeployStage,
 		deepCopy_api_DockerBuildStrategy,
+		deepCopy_api_GenericWebHookCause,
 		deepCopy_api_GitBuildSource,
+		deepCopy_api_GitHubWebHookCause,
+		deepCopy_api_GitLabWebHookCause,
 		deepCopy_api_GitSourceRevision,
+		deepCopy_api_ImageChangeCause,
 		deepCopy_api_ImageChangeTrigger,
+		deepCopy_api_ImageSource,
+		deepCopy_api_ImageSourcePath,
+		deepCopy_api_ManualCause,
+		deepCopy_api_Pipeline,
+		deepCopy_api_PipelineList,
+		deepCopy_api_PipelineSpec,
+		deepCopy_api_PipelineStage,
+		deepCopy_api_PipelineStageStatus,
+		deepCopy_api_PipelineStatus,
+		deepCopy_api_PromoteStage,
+		deepCopy_api_SecretBuildSource,
+		deepCopy_api_SecretKeySelector,
 		deepCopy_api_SourceBuildStrategy,
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
+		deepCopy_api_VerifyStage,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_BlueGreenDeploymentStrategyParams,
+		deepCopy_api_CanaryDeploymentStrategyParams,
+		deepCopy_api_CanaryStep,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
@@ -2603,6 +3411,8 @@ func init() {
 		deepCopy_api_DeploymentConfigRollback,
 		deepCopy_api_DeploymentConfigRollbackSpec,
 		deepCopy_api_DeploymentDetails,
+		deepCopy_api_DeploymentLog,
+		deepCopy_api_DeploymentLogOptions,
 		deepCopy_api_DeploymentStrategy,
 		deepCopy_api_DeploymentTemplate,
 		deepCopy_api_DeploymentTriggerImageChangeParams,
@@ -2611,6 +3421,7 @@ func init() {
 		deepCopy_api_LifecycleHook,
 		deepCopy_api_RecreateDeploymentStrategyParams,
 		deepCopy_api_RollingDeploymentStrategyParams,
+		deepCopy_api_TagImageHook,
 		deepCopy_api_DockerConfig,
 		deepCopy_api_DockerImage,
 		deepCopy_api_Image,
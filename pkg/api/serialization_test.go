@@ -112,13 +112,19 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 				j.To.Name = strings.Replace(j.To.Name, ":", "-", -1)
 			}
 		},
+		func(j *util.IntOrString, c fuzz.Continue) {
+			// Allocates optional IntOrString fields before fuzzing them.
+			j.Fuzz(c)
+		},
 		func(j *deploy.DeploymentStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
 			mkintp := func(i int) *int64 {
 				v := int64(i)
 				return &v
 			}
-			switch c.Intn(3) {
+			j.BlueGreenParams = nil
+			j.CanaryParams = nil
+			switch c.Intn(5) {
 			case 0:
 				// TODO: we should not have to set defaults, instead we should be able
 				// to detect defaults were applied.
@@ -128,12 +134,34 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 					UpdatePeriodSeconds: mkintp(1),
 					TimeoutSeconds:      mkintp(120),
 				}
+				if c.RandBool() {
+					maxSurge := util.NewIntOrStringFromString("25%")
+					j.RollingParams.MaxSurge = &maxSurge
+					j.RollingParams.MinReadySeconds = int64(c.Intn(60))
+				}
 			case 1:
 				j.Type = deploy.DeploymentStrategyTypeRecreate
 				j.RollingParams = nil
 			case 2:
 				j.Type = deploy.DeploymentStrategyTypeCustom
 				j.RollingParams = nil
+			case 3:
+				j.Type = deploy.DeploymentStrategyTypeBlueGreen
+				j.RollingParams = nil
+				j.BlueGreenParams = &deploy.BlueGreenDeploymentStrategyParams{
+					ServiceName:    c.RandString(),
+					TimeoutSeconds: mkintp(600),
+				}
+			case 4:
+				j.Type = deploy.DeploymentStrategyTypeCanary
+				j.RollingParams = nil
+				restarts := 3
+				j.CanaryParams = &deploy.CanaryDeploymentStrategyParams{
+					Steps:                []deploy.CanaryStep{{Replicas: &restarts, ManualApproval: c.RandBool()}},
+					IntervalSeconds:      mkintp(1),
+					TimeoutSeconds:       mkintp(600),
+					MaxContainerRestarts: &restarts,
+				}
 			}
 		},
 		func(j *deploy.DeploymentCauseImageTrigger, c fuzz.Continue) {
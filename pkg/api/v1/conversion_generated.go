@@ -567,6 +567,29 @@ func convert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse(i
 	return nil
 }
 
+func convert_api_BinaryBuildSource_To_v1_BinaryBuildSource(in *buildapi.BinaryBuildSource, out *apiv1.BinaryBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BinaryBuildSource))(in)
+	}
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func convert_api_BitbucketWebHookCause_To_v1_BitbucketWebHookCause(in *buildapi.BitbucketWebHookCause, out *apiv1.BitbucketWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BitbucketWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func convert_api_Build_To_v1_Build(in *buildapi.Build, out *apiv1.Build, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.Build))(in)
@@ -645,6 +668,19 @@ func convert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *buildapi.BuildConfigS
 	if err := convert_api_BuildSpec_To_v1_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = apiv1.BuildRunPolicy(in.RunPolicy)
 	return nil
 }
 
@@ -656,6 +692,38 @@ func convert_api_BuildConfigStatus_To_v1_BuildConfigStatus(in *buildapi.BuildCon
 	return nil
 }
 
+func convert_api_BuildEnvVar_To_v1_BuildEnvVar(in *buildapi.BuildEnvVar, out *apiv1.BuildEnvVar, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildEnvVar))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(apiv1.BuildEnvVarSource)
+		if err := convert_api_BuildEnvVarSource_To_v1_BuildEnvVarSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func convert_api_BuildEnvVarSource_To_v1_BuildEnvVarSource(in *buildapi.BuildEnvVarSource, out *apiv1.BuildEnvVarSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildEnvVarSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(apiv1.SecretKeySelector)
+		if err := convert_api_SecretKeySelector_To_v1_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
 func convert_api_BuildList_To_v1_BuildList(in *buildapi.BuildList, out *apiv1.BuildList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildList))(in)
@@ -704,6 +772,30 @@ func convert_api_BuildLogOptions_To_v1_BuildLogOptions(in *buildapi.BuildLogOpti
 	return nil
 }
 
+func convert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec(in *buildapi.BuildPostCommitSpec, out *apiv1.BuildPostCommitSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildPostCommitSpec))(in)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
 func convert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest, out *apiv1.BuildRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildRequest))(in)
@@ -730,6 +822,14 @@ func convert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest, out
 	} else {
 		out.TriggeredByImage = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1.BinaryBuildSource)
+		if err := convert_api_BinaryBuildSource_To_v1_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
 	if in.From != nil {
 		out.From = new(pkgapiv1.ObjectReference)
 		if err := convert_api_ObjectReference_To_v1_ObjectReference(in.From, out.From, s); err != nil {
@@ -744,6 +844,16 @@ func convert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest, out
 	} else {
 		out.LastVersion = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_api_BuildTriggerCause_To_v1_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -760,6 +870,30 @@ func convert_api_BuildSource_To_v1_BuildSource(in *buildapi.BuildSource, out *ap
 	} else {
 		out.Git = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1.BinaryBuildSource)
+		if err := convert_api_BinaryBuildSource_To_v1_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]apiv1.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageSource_To_v1_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		out.SourceSecret = new(pkgapiv1.LocalObjectReference)
@@ -769,6 +903,16 @@ func convert_api_BuildSource_To_v1_BuildSource(in *buildapi.BuildSource, out *ap
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_api_SecretBuildSource_To_v1_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -797,6 +941,25 @@ func convert_api_BuildSpec_To_v1_BuildSpec(in *buildapi.BuildSpec, out *apiv1.Bu
 	if err := convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	if err := convert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func convert_api_BuildStage_To_v1_BuildStage(in *buildapi.BuildStage, out *apiv1.BuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildStage))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.BuildConfig, &out.BuildConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -806,6 +969,7 @@ func convert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out *ap
 	}
 	out.Phase = apiv1.BuildPhase(in.Phase)
 	out.Cancelled = in.Cancelled
+	out.Reason = apiv1.StatusReason(in.Reason)
 	out.Message = in.Message
 	if in.StartTimestamp != nil {
 		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
@@ -830,6 +994,16 @@ func convert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out *ap
 	} else {
 		out.Config = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_api_BuildTriggerCause_To_v1_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -862,6 +1036,87 @@ func convert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrategy, o
 	return nil
 }
 
+func convert_api_BuildTriggerCause_To_v1_BuildTriggerCause(in *buildapi.BuildTriggerCause, out *apiv1.BuildTriggerCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildTriggerCause))(in)
+	}
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(apiv1.GenericWebHookCause)
+		if err := convert_api_GenericWebHookCause_To_v1_GenericWebHookCause(in.GenericWebHook, out.GenericWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(apiv1.GitHubWebHookCause)
+		if err := convert_api_GitHubWebHookCause_To_v1_GitHubWebHookCause(in.GitHubWebHook, out.GitHubWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.GitLabWebHookCause)
+		if err := convert_api_GitLabWebHookCause_To_v1_GitLabWebHookCause(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.BitbucketWebHookCause)
+		if err := convert_api_BitbucketWebHookCause_To_v1_BitbucketWebHookCause(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(apiv1.ImageChangeCause)
+		if err := convert_api_ImageChangeCause_To_v1_ImageChangeCause(in.ImageChangeBuild, out.ImageChangeBuild, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(apiv1.ManualCause)
+		if err := convert_api_ManualCause_To_v1_ManualCause(in.Manual, out.Manual, s); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
+func convert_api_DeployStage_To_v1_DeployStage(in *buildapi.DeployStage, out *apiv1.DeployStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.DeployStage))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.DeploymentConfig, &out.DeploymentConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_GenericWebHookCause_To_v1_GenericWebHookCause(in *buildapi.GenericWebHookCause, out *apiv1.GenericWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GenericWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func convert_api_GitBuildSource_To_v1_GitBuildSource(in *buildapi.GitBuildSource, out *apiv1.GitBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitBuildSource))(in)
@@ -873,6 +1128,36 @@ func convert_api_GitBuildSource_To_v1_GitBuildSource(in *buildapi.GitBuildSource
 	return nil
 }
 
+func convert_api_GitHubWebHookCause_To_v1_GitHubWebHookCause(in *buildapi.GitHubWebHookCause, out *apiv1.GitHubWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GitHubWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_api_GitLabWebHookCause_To_v1_GitLabWebHookCause(in *buildapi.GitLabWebHookCause, out *apiv1.GitLabWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GitLabWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func convert_api_GitSourceRevision_To_v1_GitSourceRevision(in *buildapi.GitSourceRevision, out *apiv1.GitSourceRevision, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitSourceRevision))(in)
@@ -885,6 +1170,23 @@ func convert_api_GitSourceRevision_To_v1_GitSourceRevision(in *buildapi.GitSourc
 		return err
 	}
 	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func convert_api_ImageChangeCause_To_v1_ImageChangeCause(in *buildapi.ImageChangeCause, out *apiv1.ImageChangeCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.ImageChangeCause))(in)
+	}
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		out.FromRef = new(pkgapiv1.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1_ObjectReference(in.FromRef, out.FromRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.FromRef = nil
+	}
 	return nil
 }
 
@@ -904,91 +1206,85 @@ func convert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger(in *buildapi.ImageC
 	return nil
 }
 
-func convert_api_SourceControlUser_To_v1_SourceControlUser(in *buildapi.SourceControlUser, out *apiv1.SourceControlUser, s conversion.Scope) error {
+func convert_api_ImageSource_To_v1_ImageSource(in *buildapi.ImageSource, out *apiv1.ImageSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapi.SourceControlUser))(in)
+		defaulting.(func(*buildapi.ImageSource))(in)
 	}
-	out.Name = in.Name
-	out.Email = in.Email
-	return nil
-}
-
-func convert_api_SourceRevision_To_v1_SourceRevision(in *buildapi.SourceRevision, out *apiv1.SourceRevision, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapi.SourceRevision))(in)
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
 	}
-	out.Type = apiv1.BuildSourceType(in.Type)
-	if in.Git != nil {
-		out.Git = new(apiv1.GitSourceRevision)
-		if err := convert_api_GitSourceRevision_To_v1_GitSourceRevision(in.Git, out.Git, s); err != nil {
+	if in.Paths != nil {
+		out.Paths = make([]apiv1.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := convert_api_ImageSourcePath_To_v1_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.PullSecret != nil {
+		out.PullSecret = new(pkgapiv1.LocalObjectReference)
+		if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.PullSecret, out.PullSecret, s); err != nil {
 			return err
 		}
 	} else {
-		out.Git = nil
+		out.PullSecret = nil
 	}
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func convert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1.WebHookTrigger, s conversion.Scope) error {
+func convert_api_ImageSourcePath_To_v1_ImageSourcePath(in *buildapi.ImageSourcePath, out *apiv1.ImageSourcePath, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapi.WebHookTrigger))(in)
+		defaulting.(func(*buildapi.ImageSourcePath))(in)
 	}
-	out.Secret = in.Secret
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func convert_v1_Build_To_api_Build(in *apiv1.Build, out *buildapi.Build, s conversion.Scope) error {
+func convert_api_ManualCause_To_v1_ManualCause(in *buildapi.ManualCause, out *apiv1.ManualCause, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.Build))(in)
-	}
-	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
-		return err
-	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
-	}
-	if err := convert_v1_BuildSpec_To_api_BuildSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_v1_BuildStatus_To_api_BuildStatus(&in.Status, &out.Status, s); err != nil {
-		return err
+		defaulting.(func(*buildapi.ManualCause))(in)
 	}
+	out.User = in.User
 	return nil
 }
 
-func convert_v1_BuildConfig_To_api_BuildConfig(in *apiv1.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
+func convert_api_Pipeline_To_v1_Pipeline(in *buildapi.Pipeline, out *apiv1.Pipeline, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildConfig))(in)
+		defaulting.(func(*buildapi.Pipeline))(in)
 	}
-	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+	if err := convert_api_TypeMeta_To_v1_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1_BuildConfigSpec_To_api_BuildConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := convert_api_PipelineSpec_To_v1_PipelineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := convert_v1_BuildConfigStatus_To_api_BuildConfigStatus(&in.Status, &out.Status, s); err != nil {
+	if err := convert_api_PipelineStatus_To_v1_PipelineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-func convert_v1_BuildConfigList_To_api_BuildConfigList(in *apiv1.BuildConfigList, out *buildapi.BuildConfigList, s conversion.Scope) error {
+func convert_api_PipelineList_To_v1_PipelineList(in *buildapi.PipelineList, out *apiv1.PipelineList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildConfigList))(in)
+		defaulting.(func(*buildapi.PipelineList))(in)
 	}
-	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+	if err := convert_api_TypeMeta_To_v1_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+	if err := convert_api_ListMeta_To_v1_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]buildapi.BuildConfig, len(in.Items))
+		out.Items = make([]apiv1.Pipeline, len(in.Items))
 		for i := range in.Items {
-			if err := convert_v1_BuildConfig_To_api_BuildConfig(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_api_Pipeline_To_v1_Pipeline(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -998,185 +1294,1037 @@ func convert_v1_BuildConfigList_To_api_BuildConfigList(in *apiv1.BuildConfigList
 	return nil
 }
 
-func convert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1.BuildConfigSpec, out *buildapi.BuildConfigSpec, s conversion.Scope) error {
+func convert_api_PipelineSpec_To_v1_PipelineSpec(in *buildapi.PipelineSpec, out *apiv1.PipelineSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildConfigSpec))(in)
+		defaulting.(func(*buildapi.PipelineSpec))(in)
 	}
-	if in.Triggers != nil {
-		out.Triggers = make([]buildapi.BuildTriggerPolicy, len(in.Triggers))
-		for i := range in.Triggers {
-			if err := s.Convert(&in.Triggers[i], &out.Triggers[i], 0); err != nil {
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_api_PipelineStage_To_v1_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Triggers = nil
-	}
-	if err := convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
-		return err
+		out.Stages = nil
 	}
 	return nil
 }
 
-func convert_v1_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1.BuildConfigStatus, out *buildapi.BuildConfigStatus, s conversion.Scope) error {
+func convert_api_PipelineStage_To_v1_PipelineStage(in *buildapi.PipelineStage, out *apiv1.PipelineStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildConfigStatus))(in)
+		defaulting.(func(*buildapi.PipelineStage))(in)
 	}
-	out.LastVersion = in.LastVersion
-	return nil
-}
-
-func convert_v1_BuildList_To_api_BuildList(in *apiv1.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildList))(in)
+	out.Name = in.Name
+	out.Type = apiv1.PipelineStageType(in.Type)
+	if in.Build != nil {
+		out.Build = new(apiv1.BuildStage)
+		if err := convert_api_BuildStage_To_v1_BuildStage(in.Build, out.Build, s); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Deploy != nil {
+		out.Deploy = new(apiv1.DeployStage)
+		if err := convert_api_DeployStage_To_v1_DeployStage(in.Deploy, out.Deploy, s); err != nil {
+			return err
+		}
+	} else {
+		out.Deploy = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(apiv1.VerifyStage)
+		if err := convert_api_VerifyStage_To_v1_VerifyStage(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(apiv1.PromoteStage)
+		if err := convert_api_PromoteStage_To_v1_PromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func convert_api_PipelineStageStatus_To_v1_PipelineStageStatus(in *buildapi.PipelineStageStatus, out *apiv1.PipelineStageStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStageStatus))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1.PipelineStagePhase(in.Phase)
+	out.Message = in.Message
+	if in.Reference != nil {
+		out.Reference = new(pkgapiv1.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1_ObjectReference(in.Reference, out.Reference, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reference = nil
+	}
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func convert_api_PipelineStatus_To_v1_PipelineStatus(in *buildapi.PipelineStatus, out *apiv1.PipelineStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStatus))(in)
+	}
+	out.Phase = apiv1.PipelinePhase(in.Phase)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_api_PipelineStageStatus_To_v1_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func convert_api_PromoteStage_To_v1_PromoteStage(in *buildapi.PromoteStage, out *apiv1.PromoteStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PromoteStage))(in)
+	}
+	if in.From != nil {
+		out.From = new(pkgapiv1.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_SecretBuildSource_To_v1_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SecretBuildSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_api_SecretKeySelector_To_v1_SecretKeySelector(in *buildapi.SecretKeySelector, out *apiv1.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SecretKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_SourceControlUser_To_v1_SourceControlUser(in *buildapi.SourceControlUser, out *apiv1.SourceControlUser, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SourceControlUser))(in)
+	}
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+func convert_api_SourceRevision_To_v1_SourceRevision(in *buildapi.SourceRevision, out *apiv1.SourceRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SourceRevision))(in)
+	}
+	out.Type = apiv1.BuildSourceType(in.Type)
+	if in.Git != nil {
+		out.Git = new(apiv1.GitSourceRevision)
+		if err := convert_api_GitSourceRevision_To_v1_GitSourceRevision(in.Git, out.Git, s); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	return nil
+}
+
+func convert_api_VerifyStage_To_v1_VerifyStage(in *buildapi.VerifyStage, out *apiv1.VerifyStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.VerifyStage))(in)
+	}
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_api_EnvVar_To_v1_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func convert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1.WebHookTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.WebHookTrigger))(in)
+	}
+	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
+	return nil
+}
+
+func convert_v1_BinaryBuildSource_To_api_BinaryBuildSource(in *apiv1.BinaryBuildSource, out *buildapi.BinaryBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BinaryBuildSource))(in)
+	}
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func convert_v1_BitbucketWebHookCause_To_api_BitbucketWebHookCause(in *apiv1.BitbucketWebHookCause, out *buildapi.BitbucketWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BitbucketWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1_Build_To_api_Build(in *apiv1.Build, out *buildapi.Build, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.Build))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BuildSpec_To_api_BuildSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BuildStatus_To_api_BuildStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_BuildConfig_To_api_BuildConfig(in *apiv1.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildConfig))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BuildConfigSpec_To_api_BuildConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BuildConfigStatus_To_api_BuildConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_BuildConfigList_To_api_BuildConfigList(in *apiv1.BuildConfigList, out *buildapi.BuildConfigList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildConfigList))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.BuildConfig, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_BuildConfig_To_api_BuildConfig(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1.BuildConfigSpec, out *buildapi.BuildConfigSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildConfigSpec))(in)
+	}
+	if in.Triggers != nil {
+		out.Triggers = make([]buildapi.BuildTriggerPolicy, len(in.Triggers))
+		for i := range in.Triggers {
+			if err := s.Convert(&in.Triggers[i], &out.Triggers[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Triggers = nil
+	}
+	if err := convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
+		return err
+	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	return nil
+}
+
+func convert_v1_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1.BuildConfigStatus, out *buildapi.BuildConfigStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildConfigStatus))(in)
+	}
+	out.LastVersion = in.LastVersion
+	return nil
+}
+
+func convert_v1_BuildEnvVar_To_api_BuildEnvVar(in *apiv1.BuildEnvVar, out *buildapi.BuildEnvVar, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildEnvVar))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(buildapi.BuildEnvVarSource)
+		if err := convert_v1_BuildEnvVarSource_To_api_BuildEnvVarSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildEnvVarSource_To_api_BuildEnvVarSource(in *apiv1.BuildEnvVarSource, out *buildapi.BuildEnvVarSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildEnvVarSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(buildapi.SecretKeySelector)
+		if err := convert_v1_SecretKeySelector_To_api_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildList_To_api_BuildList(in *apiv1.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildList))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.Build, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_Build_To_api_Build(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildLog_To_api_BuildLog(in *apiv1.BuildLog, out *buildapi.BuildLog, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildLog))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_BuildLogOptions_To_api_BuildLogOptions(in *apiv1.BuildLogOptions, out *buildapi.BuildLogOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildLogOptions))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	return nil
+}
+
+func convert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *apiv1.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildPostCommitSpec))(in)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
+func convert_v1_BuildRequest_To_api_BuildRequest(in *apiv1.BuildRequest, out *buildapi.BuildRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildRequest))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	if in.TriggeredByImage != nil {
+		out.TriggeredByImage = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.TriggeredByImage, out.TriggeredByImage, s); err != nil {
+			return err
+		}
+	} else {
+		out.TriggeredByImage = nil
+	}
+	if in.Binary != nil {
+		out.Binary = new(buildapi.BinaryBuildSource)
+		if err := convert_v1_BinaryBuildSource_To_api_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.From != nil {
+		out.From = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	if in.LastVersion != nil {
+		out.LastVersion = new(int)
+		*out.LastVersion = *in.LastVersion
+	} else {
+		out.LastVersion = nil
+	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]buildapi.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_v1_BuildTriggerCause_To_api_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildSource_To_api_BuildSource(in *apiv1.BuildSource, out *buildapi.BuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildSource))(in)
+	}
+	out.Type = buildapi.BuildSourceType(in.Type)
+	if in.Git != nil {
+		out.Git = new(buildapi.GitBuildSource)
+		if err := convert_v1_GitBuildSource_To_api_GitBuildSource(in.Git, out.Git, s); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	if in.Binary != nil {
+		out.Binary = new(buildapi.BinaryBuildSource)
+		if err := convert_v1_BinaryBuildSource_To_api_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]buildapi.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1_ImageSource_To_api_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	out.ContextDir = in.ContextDir
+	if in.SourceSecret != nil {
+		out.SourceSecret = new(pkgapi.LocalObjectReference)
+		if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.SourceSecret, out.SourceSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.SourceSecret = nil
+	}
+	if in.Secrets != nil {
+		out.Secrets = make([]buildapi.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_v1_SecretBuildSource_To_api_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildSpec_To_api_BuildSpec(in *apiv1.BuildSpec, out *buildapi.BuildSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildSpec))(in)
+	}
+	out.ServiceAccount = in.ServiceAccount
+	if err := convert_v1_BuildSource_To_api_BuildSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	if err := convert_v1_BuildStrategy_To_api_BuildStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.Output, &out.Output, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildStage_To_api_BuildStage(in *apiv1.BuildStage, out *buildapi.BuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildStage))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.BuildConfig, &out.BuildConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_BuildStatus_To_api_BuildStatus(in *apiv1.BuildStatus, out *buildapi.BuildStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildStatus))(in)
+	}
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	out.Cancelled = in.Cancelled
+	out.Reason = buildapi.StatusReason(in.Reason)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	if in.Config != nil {
+		out.Config = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.Config, out.Config, s); err != nil {
+			return err
+		}
+	} else {
+		out.Config = nil
+	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]buildapi.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_v1_BuildTriggerCause_To_api_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildStrategy_To_api_BuildStrategy(in *apiv1.BuildStrategy, out *buildapi.BuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildStrategy))(in)
+	}
+	out.Type = buildapi.BuildStrategyType(in.Type)
+	if in.DockerStrategy != nil {
+		if err := s.Convert(&in.DockerStrategy, &out.DockerStrategy, 0); err != nil {
+			return err
+		}
+	} else {
+		out.DockerStrategy = nil
+	}
+	if in.SourceStrategy != nil {
+		if err := s.Convert(&in.SourceStrategy, &out.SourceStrategy, 0); err != nil {
+			return err
+		}
+	} else {
+		out.SourceStrategy = nil
+	}
+	if in.CustomStrategy != nil {
+		if err := s.Convert(&in.CustomStrategy, &out.CustomStrategy, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CustomStrategy = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildTriggerCause_To_api_BuildTriggerCause(in *apiv1.BuildTriggerCause, out *buildapi.BuildTriggerCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildTriggerCause))(in)
+	}
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(buildapi.GenericWebHookCause)
+		if err := convert_v1_GenericWebHookCause_To_api_GenericWebHookCause(in.GenericWebHook, out.GenericWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(buildapi.GitHubWebHookCause)
+		if err := convert_v1_GitHubWebHookCause_To_api_GitHubWebHookCause(in.GitHubWebHook, out.GitHubWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.GitLabWebHookCause)
+		if err := convert_v1_GitLabWebHookCause_To_api_GitLabWebHookCause(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.BitbucketWebHookCause)
+		if err := convert_v1_BitbucketWebHookCause_To_api_BitbucketWebHookCause(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(buildapi.ImageChangeCause)
+		if err := convert_v1_ImageChangeCause_To_api_ImageChangeCause(in.ImageChangeBuild, out.ImageChangeBuild, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(buildapi.ManualCause)
+		if err := convert_v1_ManualCause_To_api_ManualCause(in.Manual, out.Manual, s); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
+func convert_v1_DeployStage_To_api_DeployStage(in *apiv1.DeployStage, out *buildapi.DeployStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.DeployStage))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.DeploymentConfig, &out.DeploymentConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_GenericWebHookCause_To_api_GenericWebHookCause(in *apiv1.GenericWebHookCause, out *buildapi.GenericWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.GenericWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1_GitBuildSource_To_api_GitBuildSource(in *apiv1.GitBuildSource, out *buildapi.GitBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.GitBuildSource))(in)
+	}
+	out.URI = in.URI
+	out.Ref = in.Ref
+	out.HTTPProxy = in.HTTPProxy
+	out.HTTPSProxy = in.HTTPSProxy
+	return nil
+}
+
+func convert_v1_GitHubWebHookCause_To_api_GitHubWebHookCause(in *apiv1.GitHubWebHookCause, out *buildapi.GitHubWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.GitHubWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1_GitLabWebHookCause_To_api_GitLabWebHookCause(in *apiv1.GitLabWebHookCause, out *buildapi.GitLabWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.GitLabWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1_GitSourceRevision_To_api_GitSourceRevision(in *apiv1.GitSourceRevision, out *buildapi.GitSourceRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.GitSourceRevision))(in)
+	}
+	out.Commit = in.Commit
+	if err := convert_v1_SourceControlUser_To_api_SourceControlUser(&in.Author, &out.Author, s); err != nil {
+		return err
+	}
+	if err := convert_v1_SourceControlUser_To_api_SourceControlUser(&in.Committer, &out.Committer, s); err != nil {
+		return err
+	}
+	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func convert_v1_ImageChangeCause_To_api_ImageChangeCause(in *apiv1.ImageChangeCause, out *buildapi.ImageChangeCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.ImageChangeCause))(in)
+	}
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		out.FromRef = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.FromRef, out.FromRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.FromRef = nil
+	}
+	return nil
+}
+
+func convert_v1_ImageChangeTrigger_To_api_ImageChangeTrigger(in *apiv1.ImageChangeTrigger, out *buildapi.ImageChangeTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.ImageChangeTrigger))(in)
+	}
+	out.LastTriggeredImageID = in.LastTriggeredImageID
+	if in.From != nil {
+		out.From = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
 	}
-	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
-		return err
+	return nil
+}
+
+func convert_v1_ImageSource_To_api_ImageSource(in *apiv1.ImageSource, out *buildapi.ImageSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.ImageSource))(in)
 	}
-	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
 		return err
 	}
-	if in.Items != nil {
-		out.Items = make([]buildapi.Build, len(in.Items))
-		for i := range in.Items {
-			if err := convert_v1_Build_To_api_Build(&in.Items[i], &out.Items[i], s); err != nil {
+	if in.Paths != nil {
+		out.Paths = make([]buildapi.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := convert_v1_ImageSourcePath_To_api_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Items = nil
+		out.Paths = nil
+	}
+	if in.PullSecret != nil {
+		out.PullSecret = new(pkgapi.LocalObjectReference)
+		if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.PullSecret, out.PullSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullSecret = nil
 	}
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func convert_v1_BuildLog_To_api_BuildLog(in *apiv1.BuildLog, out *buildapi.BuildLog, s conversion.Scope) error {
+func convert_v1_ImageSourcePath_To_api_ImageSourcePath(in *apiv1.ImageSourcePath, out *buildapi.ImageSourcePath, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildLog))(in)
-	}
-	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
-		return err
+		defaulting.(func(*apiv1.ImageSourcePath))(in)
 	}
-	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
-		return err
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_v1_ManualCause_To_api_ManualCause(in *apiv1.ManualCause, out *buildapi.ManualCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.ManualCause))(in)
 	}
+	out.User = in.User
 	return nil
 }
 
-func convert_v1_BuildLogOptions_To_api_BuildLogOptions(in *apiv1.BuildLogOptions, out *buildapi.BuildLogOptions, s conversion.Scope) error {
+func convert_v1_Pipeline_To_api_Pipeline(in *apiv1.Pipeline, out *buildapi.Pipeline, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildLogOptions))(in)
+		defaulting.(func(*apiv1.Pipeline))(in)
 	}
 	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	out.Follow = in.Follow
-	out.NoWait = in.NoWait
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_PipelineSpec_To_api_PipelineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_PipelineStatus_To_api_PipelineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-func convert_v1_BuildRequest_To_api_BuildRequest(in *apiv1.BuildRequest, out *buildapi.BuildRequest, s conversion.Scope) error {
+func convert_v1_PipelineList_To_api_PipelineList(in *apiv1.PipelineList, out *buildapi.PipelineList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildRequest))(in)
+		defaulting.(func(*apiv1.PipelineList))(in)
 	}
 	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
 		return err
 	}
-	if in.Revision != nil {
-		out.Revision = new(buildapi.SourceRevision)
-		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
-			return err
+	if in.Items != nil {
+		out.Items = make([]buildapi.Pipeline, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_Pipeline_To_api_Pipeline(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Revision = nil
+		out.Items = nil
 	}
-	if in.TriggeredByImage != nil {
-		out.TriggeredByImage = new(pkgapi.ObjectReference)
-		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.TriggeredByImage, out.TriggeredByImage, s); err != nil {
-			return err
-		}
-	} else {
-		out.TriggeredByImage = nil
+	return nil
+}
+
+func convert_v1_PipelineSpec_To_api_PipelineSpec(in *apiv1.PipelineSpec, out *buildapi.PipelineSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.PipelineSpec))(in)
 	}
-	if in.From != nil {
-		out.From = new(pkgapi.ObjectReference)
-		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
-			return err
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_v1_PipelineStage_To_api_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.From = nil
-	}
-	if in.LastVersion != nil {
-		out.LastVersion = new(int)
-		*out.LastVersion = *in.LastVersion
-	} else {
-		out.LastVersion = nil
+		out.Stages = nil
 	}
 	return nil
 }
 
-func convert_v1_BuildSource_To_api_BuildSource(in *apiv1.BuildSource, out *buildapi.BuildSource, s conversion.Scope) error {
+func convert_v1_PipelineStage_To_api_PipelineStage(in *apiv1.PipelineStage, out *buildapi.PipelineStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildSource))(in)
+		defaulting.(func(*apiv1.PipelineStage))(in)
 	}
-	out.Type = buildapi.BuildSourceType(in.Type)
-	if in.Git != nil {
-		out.Git = new(buildapi.GitBuildSource)
-		if err := convert_v1_GitBuildSource_To_api_GitBuildSource(in.Git, out.Git, s); err != nil {
+	out.Name = in.Name
+	out.Type = buildapi.PipelineStageType(in.Type)
+	if in.Build != nil {
+		out.Build = new(buildapi.BuildStage)
+		if err := convert_v1_BuildStage_To_api_BuildStage(in.Build, out.Build, s); err != nil {
 			return err
 		}
 	} else {
-		out.Git = nil
+		out.Build = nil
 	}
-	out.ContextDir = in.ContextDir
-	if in.SourceSecret != nil {
-		out.SourceSecret = new(pkgapi.LocalObjectReference)
-		if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.SourceSecret, out.SourceSecret, s); err != nil {
+	if in.Deploy != nil {
+		out.Deploy = new(buildapi.DeployStage)
+		if err := convert_v1_DeployStage_To_api_DeployStage(in.Deploy, out.Deploy, s); err != nil {
 			return err
 		}
 	} else {
-		out.SourceSecret = nil
-	}
-	return nil
-}
-
-func convert_v1_BuildSpec_To_api_BuildSpec(in *apiv1.BuildSpec, out *buildapi.BuildSpec, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildSpec))(in)
+		out.Deploy = nil
 	}
-	out.ServiceAccount = in.ServiceAccount
-	if err := convert_v1_BuildSource_To_api_BuildSource(&in.Source, &out.Source, s); err != nil {
-		return err
-	}
-	if in.Revision != nil {
-		out.Revision = new(buildapi.SourceRevision)
-		if err := convert_v1_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+	if in.Verify != nil {
+		out.Verify = new(buildapi.VerifyStage)
+		if err := convert_v1_VerifyStage_To_api_VerifyStage(in.Verify, out.Verify, s); err != nil {
 			return err
 		}
 	} else {
-		out.Revision = nil
+		out.Verify = nil
 	}
-	if err := convert_v1_BuildStrategy_To_api_BuildStrategy(&in.Strategy, &out.Strategy, s); err != nil {
-		return err
-	}
-	if err := s.Convert(&in.Output, &out.Output, 0); err != nil {
-		return err
-	}
-	if err := convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
-		return err
+	if in.Promote != nil {
+		out.Promote = new(buildapi.PromoteStage)
+		if err := convert_v1_PromoteStage_To_api_PromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
 	}
 	return nil
 }
 
-func convert_v1_BuildStatus_To_api_BuildStatus(in *apiv1.BuildStatus, out *buildapi.BuildStatus, s conversion.Scope) error {
+func convert_v1_PipelineStageStatus_To_api_PipelineStageStatus(in *apiv1.PipelineStageStatus, out *buildapi.PipelineStageStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildStatus))(in)
+		defaulting.(func(*apiv1.PipelineStageStatus))(in)
 	}
-	out.Phase = buildapi.BuildPhase(in.Phase)
-	out.Cancelled = in.Cancelled
+	out.Name = in.Name
+	out.Phase = buildapi.PipelineStagePhase(in.Phase)
 	out.Message = in.Message
+	if in.Reference != nil {
+		out.Reference = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.Reference, out.Reference, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reference = nil
+	}
 	if in.StartTimestamp != nil {
 		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
 			return err
@@ -1191,86 +2339,79 @@ func convert_v1_BuildStatus_To_api_BuildStatus(in *apiv1.BuildStatus, out *build
 	} else {
 		out.CompletionTimestamp = nil
 	}
-	out.Duration = in.Duration
-	if in.Config != nil {
-		out.Config = new(pkgapi.ObjectReference)
-		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.Config, out.Config, s); err != nil {
-			return err
-		}
-	} else {
-		out.Config = nil
-	}
 	return nil
 }
 
-func convert_v1_BuildStrategy_To_api_BuildStrategy(in *apiv1.BuildStrategy, out *buildapi.BuildStrategy, s conversion.Scope) error {
+func convert_v1_PipelineStatus_To_api_PipelineStatus(in *apiv1.PipelineStatus, out *buildapi.PipelineStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.BuildStrategy))(in)
+		defaulting.(func(*apiv1.PipelineStatus))(in)
 	}
-	out.Type = buildapi.BuildStrategyType(in.Type)
-	if in.DockerStrategy != nil {
-		if err := s.Convert(&in.DockerStrategy, &out.DockerStrategy, 0); err != nil {
+	out.Phase = buildapi.PipelinePhase(in.Phase)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
 			return err
 		}
 	} else {
-		out.DockerStrategy = nil
+		out.StartTimestamp = nil
 	}
-	if in.SourceStrategy != nil {
-		if err := s.Convert(&in.SourceStrategy, &out.SourceStrategy, 0); err != nil {
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
 			return err
 		}
 	} else {
-		out.SourceStrategy = nil
+		out.CompletionTimestamp = nil
 	}
-	if in.CustomStrategy != nil {
-		if err := s.Convert(&in.CustomStrategy, &out.CustomStrategy, 0); err != nil {
-			return err
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_v1_PipelineStageStatus_To_api_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.CustomStrategy = nil
+		out.Stages = nil
 	}
 	return nil
 }
 
-func convert_v1_GitBuildSource_To_api_GitBuildSource(in *apiv1.GitBuildSource, out *buildapi.GitBuildSource, s conversion.Scope) error {
+func convert_v1_PromoteStage_To_api_PromoteStage(in *apiv1.PromoteStage, out *buildapi.PromoteStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.GitBuildSource))(in)
+		defaulting.(func(*apiv1.PromoteStage))(in)
+	}
+	if in.From != nil {
+		out.From = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
 	}
-	out.URI = in.URI
-	out.Ref = in.Ref
-	out.HTTPProxy = in.HTTPProxy
-	out.HTTPSProxy = in.HTTPSProxy
 	return nil
 }
 
-func convert_v1_GitSourceRevision_To_api_GitSourceRevision(in *apiv1.GitSourceRevision, out *buildapi.GitSourceRevision, s conversion.Scope) error {
+func convert_v1_SecretBuildSource_To_api_SecretBuildSource(in *apiv1.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.GitSourceRevision))(in)
-	}
-	out.Commit = in.Commit
-	if err := convert_v1_SourceControlUser_To_api_SourceControlUser(&in.Author, &out.Author, s); err != nil {
-		return err
+		defaulting.(func(*apiv1.SecretBuildSource))(in)
 	}
-	if err := convert_v1_SourceControlUser_To_api_SourceControlUser(&in.Committer, &out.Committer, s); err != nil {
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
 		return err
 	}
-	out.Message = in.Message
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func convert_v1_ImageChangeTrigger_To_api_ImageChangeTrigger(in *apiv1.ImageChangeTrigger, out *buildapi.ImageChangeTrigger, s conversion.Scope) error {
+func convert_v1_SecretKeySelector_To_api_SecretKeySelector(in *apiv1.SecretKeySelector, out *buildapi.SecretKeySelector, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1.ImageChangeTrigger))(in)
+		defaulting.(func(*apiv1.SecretKeySelector))(in)
 	}
-	out.LastTriggeredImageID = in.LastTriggeredImageID
-	if in.From != nil {
-		out.From = new(pkgapi.ObjectReference)
-		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
-			return err
-		}
-	} else {
-		out.From = nil
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
 	}
+	out.Key = in.Key
 	return nil
 }
 
@@ -1299,11 +2440,46 @@ func convert_v1_SourceRevision_To_api_SourceRevision(in *apiv1.SourceRevision, o
 	return nil
 }
 
+func convert_v1_VerifyStage_To_api_VerifyStage(in *apiv1.VerifyStage, out *buildapi.VerifyStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.VerifyStage))(in)
+	}
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_v1_EnvVar_To_api_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
 func convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *apiv1.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
 	return nil
 }
 
@@ -1357,6 +2533,32 @@ func convert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec
 	return nil
 }
 
+func convert_api_DeploymentLog_To_v1_DeploymentLog(in *deployapi.DeploymentLog, out *deployapiv1.DeploymentLog, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentLog))(in)
+	}
+	if err := convert_api_TypeMeta_To_v1_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ListMeta_To_v1_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in *deployapi.DeploymentLogOptions, out *deployapiv1.DeploymentLogOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentLogOptions))(in)
+	}
+	if err := convert_api_TypeMeta_To_v1_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func convert_v1_DeploymentConfigList_To_api_DeploymentConfigList(in *deployapiv1.DeploymentConfigList, out *deployapi.DeploymentConfigList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfigList))(in)
@@ -1407,6 +2609,32 @@ func convert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec
 	return nil
 }
 
+func convert_v1_DeploymentLog_To_api_DeploymentLog(in *deployapiv1.DeploymentLog, out *deployapi.DeploymentLog, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentLog))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deployapiv1.DeploymentLogOptions, out *deployapi.DeploymentLogOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentLogOptions))(in)
+	}
+	if err := convert_v1_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func convert_api_ImageList_To_v1_ImageList(in *imageapi.ImageList, out *imageapiv1.ImageList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.ImageList))(in)
@@ -3133,18 +4361,25 @@ func convert_v1_TypeMeta_To_api_TypeMeta(in *pkgapiv1.TypeMeta, out *pkgapi.Type
 
 func init() {
 	err := pkgapi.Scheme.AddGeneratedConversionFuncs(
+		convert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
+		convert_api_BitbucketWebHookCause_To_v1_BitbucketWebHookCause,
 		convert_api_BuildConfigList_To_v1_BuildConfigList,
 		convert_api_BuildConfigSpec_To_v1_BuildConfigSpec,
 		convert_api_BuildConfigStatus_To_v1_BuildConfigStatus,
 		convert_api_BuildConfig_To_v1_BuildConfig,
+		convert_api_BuildEnvVarSource_To_v1_BuildEnvVarSource,
+		convert_api_BuildEnvVar_To_v1_BuildEnvVar,
 		convert_api_BuildList_To_v1_BuildList,
 		convert_api_BuildLogOptions_To_v1_BuildLogOptions,
 		convert_api_BuildLog_To_v1_BuildLog,
+		convert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec,
 		convert_api_BuildRequest_To_v1_BuildRequest,
 		convert_api_BuildSource_To_v1_BuildSource,
 		convert_api_BuildSpec_To_v1_BuildSpec,
+		convert_api_BuildStage_To_v1_BuildStage,
 		convert_api_BuildStatus_To_v1_BuildStatus,
 		convert_api_BuildStrategy_To_v1_BuildStrategy,
+		convert_api_BuildTriggerCause_To_v1_BuildTriggerCause,
 		convert_api_Build_To_v1_Build,
 		convert_api_ClusterNetworkList_To_v1_ClusterNetworkList,
 		convert_api_ClusterNetwork_To_v1_ClusterNetwork,
@@ -3153,12 +4388,18 @@ func init() {
 		convert_api_ClusterRoleBindingList_To_v1_ClusterRoleBindingList,
 		convert_api_ClusterRoleList_To_v1_ClusterRoleList,
 		convert_api_ClusterRole_To_v1_ClusterRole,
+		convert_api_DeployStage_To_v1_DeployStage,
 		convert_api_DeploymentConfigList_To_v1_DeploymentConfigList,
 		convert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec,
 		convert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback,
+		convert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions,
+		convert_api_DeploymentLog_To_v1_DeploymentLog,
 		convert_api_EnvVarSource_To_v1_EnvVarSource,
 		convert_api_EnvVar_To_v1_EnvVar,
+		convert_api_GenericWebHookCause_To_v1_GenericWebHookCause,
 		convert_api_GitBuildSource_To_v1_GitBuildSource,
+		convert_api_GitHubWebHookCause_To_v1_GitHubWebHookCause,
+		convert_api_GitLabWebHookCause_To_v1_GitLabWebHookCause,
 		convert_api_GitSourceRevision_To_v1_GitSourceRevision,
 		convert_api_GroupList_To_v1_GroupList,
 		convert_api_Group_To_v1_Group,
@@ -3166,8 +4407,11 @@ func init() {
 		convert_api_HostSubnet_To_v1_HostSubnet,
 		convert_api_IdentityList_To_v1_IdentityList,
 		convert_api_Identity_To_v1_Identity,
+		convert_api_ImageChangeCause_To_v1_ImageChangeCause,
 		convert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger,
 		convert_api_ImageList_To_v1_ImageList,
+		convert_api_ImageSourcePath_To_v1_ImageSourcePath,
+		convert_api_ImageSource_To_v1_ImageSource,
 		convert_api_ImageStreamImage_To_v1_ImageStreamImage,
 		convert_api_ImageStreamList_To_v1_ImageStreamList,
 		convert_api_ImageStreamTag_To_v1_ImageStreamTag,
@@ -3175,6 +4419,7 @@ func init() {
 		convert_api_IsPersonalSubjectAccessReview_To_v1_IsPersonalSubjectAccessReview,
 		convert_api_ListMeta_To_v1_ListMeta,
 		convert_api_LocalObjectReference_To_v1_LocalObjectReference,
+		convert_api_ManualCause_To_v1_ManualCause,
 		convert_api_NetNamespaceList_To_v1_NetNamespaceList,
 		convert_api_NetNamespace_To_v1_NetNamespace,
 		convert_api_OAuthAccessTokenList_To_v1_OAuthAccessTokenList,
@@ -3189,6 +4434,12 @@ func init() {
 		convert_api_ObjectMeta_To_v1_ObjectMeta,
 		convert_api_ObjectReference_To_v1_ObjectReference,
 		convert_api_Parameter_To_v1_Parameter,
+		convert_api_PipelineList_To_v1_PipelineList,
+		convert_api_PipelineSpec_To_v1_PipelineSpec,
+		convert_api_PipelineStageStatus_To_v1_PipelineStageStatus,
+		convert_api_PipelineStage_To_v1_PipelineStage,
+		convert_api_PipelineStatus_To_v1_PipelineStatus,
+		convert_api_Pipeline_To_v1_Pipeline,
 		convert_api_PolicyBindingList_To_v1_PolicyBindingList,
 		convert_api_PolicyList_To_v1_PolicyList,
 		convert_api_ProjectList_To_v1_ProjectList,
@@ -3196,12 +4447,15 @@ func init() {
 		convert_api_ProjectSpec_To_v1_ProjectSpec,
 		convert_api_ProjectStatus_To_v1_ProjectStatus,
 		convert_api_Project_To_v1_Project,
+		convert_api_PromoteStage_To_v1_PromoteStage,
 		convert_api_ResourceAccessReview_To_v1_ResourceAccessReview,
 		convert_api_ResourceRequirements_To_v1_ResourceRequirements,
 		convert_api_RoleBindingList_To_v1_RoleBindingList,
 		convert_api_RoleList_To_v1_RoleList,
 		convert_api_Role_To_v1_Role,
 		convert_api_RouteList_To_v1_RouteList,
+		convert_api_SecretBuildSource_To_v1_SecretBuildSource,
+		convert_api_SecretKeySelector_To_v1_SecretKeySelector,
 		convert_api_SourceControlUser_To_v1_SourceControlUser,
 		convert_api_SourceRevision_To_v1_SourceRevision,
 		convert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse,
@@ -3210,19 +4464,27 @@ func init() {
 		convert_api_UserIdentityMapping_To_v1_UserIdentityMapping,
 		convert_api_UserList_To_v1_UserList,
 		convert_api_User_To_v1_User,
+		convert_api_VerifyStage_To_v1_VerifyStage,
 		convert_api_WebHookTrigger_To_v1_WebHookTrigger,
+		convert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
+		convert_v1_BitbucketWebHookCause_To_api_BitbucketWebHookCause,
 		convert_v1_BuildConfigList_To_api_BuildConfigList,
 		convert_v1_BuildConfigSpec_To_api_BuildConfigSpec,
 		convert_v1_BuildConfigStatus_To_api_BuildConfigStatus,
 		convert_v1_BuildConfig_To_api_BuildConfig,
+		convert_v1_BuildEnvVarSource_To_api_BuildEnvVarSource,
+		convert_v1_BuildEnvVar_To_api_BuildEnvVar,
 		convert_v1_BuildList_To_api_BuildList,
 		convert_v1_BuildLogOptions_To_api_BuildLogOptions,
 		convert_v1_BuildLog_To_api_BuildLog,
+		convert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec,
 		convert_v1_BuildRequest_To_api_BuildRequest,
 		convert_v1_BuildSource_To_api_BuildSource,
 		convert_v1_BuildSpec_To_api_BuildSpec,
+		convert_v1_BuildStage_To_api_BuildStage,
 		convert_v1_BuildStatus_To_api_BuildStatus,
 		convert_v1_BuildStrategy_To_api_BuildStrategy,
+		convert_v1_BuildTriggerCause_To_api_BuildTriggerCause,
 		convert_v1_Build_To_api_Build,
 		convert_v1_ClusterNetworkList_To_api_ClusterNetworkList,
 		convert_v1_ClusterNetwork_To_api_ClusterNetwork,
@@ -3231,12 +4493,18 @@ func init() {
 		convert_v1_ClusterRoleBindingList_To_api_ClusterRoleBindingList,
 		convert_v1_ClusterRoleList_To_api_ClusterRoleList,
 		convert_v1_ClusterRole_To_api_ClusterRole,
+		convert_v1_DeployStage_To_api_DeployStage,
 		convert_v1_DeploymentConfigList_To_api_DeploymentConfigList,
 		convert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		convert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
+		convert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions,
+		convert_v1_DeploymentLog_To_api_DeploymentLog,
 		convert_v1_EnvVarSource_To_api_EnvVarSource,
 		convert_v1_EnvVar_To_api_EnvVar,
+		convert_v1_GenericWebHookCause_To_api_GenericWebHookCause,
 		convert_v1_GitBuildSource_To_api_GitBuildSource,
+		convert_v1_GitHubWebHookCause_To_api_GitHubWebHookCause,
+		convert_v1_GitLabWebHookCause_To_api_GitLabWebHookCause,
 		convert_v1_GitSourceRevision_To_api_GitSourceRevision,
 		convert_v1_GroupList_To_api_GroupList,
 		convert_v1_Group_To_api_Group,
@@ -3244,8 +4512,11 @@ func init() {
 		convert_v1_HostSubnet_To_api_HostSubnet,
 		convert_v1_IdentityList_To_api_IdentityList,
 		convert_v1_Identity_To_api_Identity,
+		convert_v1_ImageChangeCause_To_api_ImageChangeCause,
 		convert_v1_ImageChangeTrigger_To_api_ImageChangeTrigger,
 		convert_v1_ImageList_To_api_ImageList,
+		convert_v1_ImageSourcePath_To_api_ImageSourcePath,
+		convert_v1_ImageSource_To_api_ImageSource,
 		convert_v1_ImageStreamImage_To_api_ImageStreamImage,
 		convert_v1_ImageStreamList_To_api_ImageStreamList,
 		convert_v1_ImageStreamTag_To_api_ImageStreamTag,
@@ -3253,6 +4524,7 @@ func init() {
 		convert_v1_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
 		convert_v1_ListMeta_To_api_ListMeta,
 		convert_v1_LocalObjectReference_To_api_LocalObjectReference,
+		convert_v1_ManualCause_To_api_ManualCause,
 		convert_v1_NetNamespaceList_To_api_NetNamespaceList,
 		convert_v1_NetNamespace_To_api_NetNamespace,
 		convert_v1_OAuthAccessTokenList_To_api_OAuthAccessTokenList,
@@ -3267,6 +4539,12 @@ func init() {
 		convert_v1_ObjectMeta_To_api_ObjectMeta,
 		convert_v1_ObjectReference_To_api_ObjectReference,
 		convert_v1_Parameter_To_api_Parameter,
+		convert_v1_PipelineList_To_api_PipelineList,
+		convert_v1_PipelineSpec_To_api_PipelineSpec,
+		convert_v1_PipelineStageStatus_To_api_PipelineStageStatus,
+		convert_v1_PipelineStage_To_api_PipelineStage,
+		convert_v1_PipelineStatus_To_api_PipelineStatus,
+		convert_v1_Pipeline_To_api_Pipeline,
 		convert_v1_PolicyBindingList_To_api_PolicyBindingList,
 		convert_v1_PolicyList_To_api_PolicyList,
 		convert_v1_ProjectList_To_api_ProjectList,
@@ -3274,12 +4552,15 @@ func init() {
 		convert_v1_ProjectSpec_To_api_ProjectSpec,
 		convert_v1_ProjectStatus_To_api_ProjectStatus,
 		convert_v1_Project_To_api_Project,
+		convert_v1_PromoteStage_To_api_PromoteStage,
 		convert_v1_ResourceAccessReview_To_api_ResourceAccessReview,
 		convert_v1_ResourceRequirements_To_api_ResourceRequirements,
 		convert_v1_RoleBindingList_To_api_RoleBindingList,
 		convert_v1_RoleList_To_api_RoleList,
 		convert_v1_Role_To_api_Role,
 		convert_v1_RouteList_To_api_RouteList,
+		convert_v1_SecretBuildSource_To_api_SecretBuildSource,
+		convert_v1_SecretKeySelector_To_api_SecretKeySelector,
 		convert_v1_SourceControlUser_To_api_SourceControlUser,
 		convert_v1_SourceRevision_To_api_SourceRevision,
 		convert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
@@ -3288,6 +4569,7 @@ func init() {
 		convert_v1_UserIdentityMapping_To_api_UserIdentityMapping,
 		convert_v1_UserList_To_api_UserList,
 		convert_v1_User_To_api_User,
+		convert_v1_VerifyStage_To_api_VerifyStage,
 		convert_v1_WebHookTrigger_To_api_WebHookTrigger,
 	)
 	if err != nil {
@@ -618,6 +618,23 @@ func deepCopy_v1_SubjectAccessReviewResponse(in v1.SubjectAccessReviewResponse,
 	return nil
 }
 
+func deepCopy_v1_BinaryBuildSource(in apiv1.BinaryBuildSource, out *apiv1.BinaryBuildSource, c *conversion.Cloner) error {
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func deepCopy_v1_BitbucketWebHookCause(in apiv1.BitbucketWebHookCause, out *apiv1.BitbucketWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := deepCopy_v1_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_Build(in apiv1.Build, out *apiv1.Build, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -696,6 +713,19 @@ func deepCopy_v1_BuildConfigSpec(in apiv1.BuildConfigSpec, out *apiv1.BuildConfi
 	if err := deepCopy_v1_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = in.RunPolicy
 	return nil
 }
 
@@ -704,6 +734,32 @@ func deepCopy_v1_BuildConfigStatus(in apiv1.BuildConfigStatus, out *apiv1.BuildC
 	return nil
 }
 
+func deepCopy_v1_BuildEnvVar(in apiv1.BuildEnvVar, out *apiv1.BuildEnvVar, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(apiv1.BuildEnvVarSource)
+		if err := deepCopy_v1_BuildEnvVarSource(*in.ValueFrom, out.ValueFrom, c); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildEnvVarSource(in apiv1.BuildEnvVarSource, out *apiv1.BuildEnvVarSource, c *conversion.Cloner) error {
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(apiv1.SecretKeySelector)
+		if err := deepCopy_v1_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_BuildList(in apiv1.BuildList, out *apiv1.BuildList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -775,6 +831,27 @@ func deepCopy_v1_BuildOutput(in apiv1.BuildOutput, out *apiv1.BuildOutput, c *co
 	return nil
 }
 
+func deepCopy_v1_BuildPostCommitSpec(in apiv1.BuildPostCommitSpec, out *apiv1.BuildPostCommitSpec, c *conversion.Cloner) error {
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
 func deepCopy_v1_BuildRequest(in apiv1.BuildRequest, out *apiv1.BuildRequest, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -803,6 +880,14 @@ func deepCopy_v1_BuildRequest(in apiv1.BuildRequest, out *apiv1.BuildRequest, c
 	} else {
 		out.TriggeredByImage = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1.BinaryBuildSource)
+		if err := deepCopy_v1_BinaryBuildSource(*in.Binary, out.Binary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
 			return err
@@ -818,6 +903,16 @@ func deepCopy_v1_BuildRequest(in apiv1.BuildRequest, out *apiv1.BuildRequest, c
 	} else {
 		out.LastVersion = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := deepCopy_v1_BuildTriggerCause(in.TriggeredBy[i], &out.TriggeredBy[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -831,6 +926,30 @@ func deepCopy_v1_BuildSource(in apiv1.BuildSource, out *apiv1.BuildSource, c *co
 	} else {
 		out.Git = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1.BinaryBuildSource)
+		if err := deepCopy_v1_BinaryBuildSource(*in.Binary, out.Binary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]apiv1.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1_ImageSource(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		if newVal, err := c.DeepCopy(in.SourceSecret); err != nil {
@@ -841,6 +960,16 @@ func deepCopy_v1_BuildSource(in apiv1.BuildSource, out *apiv1.BuildSource, c *co
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := deepCopy_v1_SecretBuildSource(in.Secrets[i], &out.Secrets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -868,12 +997,31 @@ func deepCopy_v1_BuildSpec(in apiv1.BuildSpec, out *apiv1.BuildSpec, c *conversi
 	} else {
 		out.Resources = newVal.(pkgapiv1.ResourceRequirements)
 	}
+	if err := deepCopy_v1_BuildPostCommitSpec(in.PostCommit, &out.PostCommit, c); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildStage(in apiv1.BuildStage, out *apiv1.BuildStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.BuildConfig); err != nil {
+		return err
+	} else {
+		out.BuildConfig = newVal.(pkgapiv1.LocalObjectReference)
+	}
 	return nil
 }
 
 func deepCopy_v1_BuildStatus(in apiv1.BuildStatus, out *apiv1.BuildStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
 	out.Cancelled = in.Cancelled
+	out.Reason = in.Reason
 	out.Message = in.Message
 	if in.StartTimestamp != nil {
 		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
@@ -903,6 +1051,16 @@ func deepCopy_v1_BuildStatus(in apiv1.BuildStatus, out *apiv1.BuildStatus, c *co
 	} else {
 		out.Config = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := deepCopy_v1_BuildTriggerCause(in.TriggeredBy[i], &out.TriggeredBy[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -935,6 +1093,59 @@ func deepCopy_v1_BuildStrategy(in apiv1.BuildStrategy, out *apiv1.BuildStrategy,
 	return nil
 }
 
+func deepCopy_v1_BuildTriggerCause(in apiv1.BuildTriggerCause, out *apiv1.BuildTriggerCause, c *conversion.Cloner) error {
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(apiv1.GenericWebHookCause)
+		if err := deepCopy_v1_GenericWebHookCause(*in.GenericWebHook, out.GenericWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(apiv1.GitHubWebHookCause)
+		if err := deepCopy_v1_GitHubWebHookCause(*in.GitHubWebHook, out.GitHubWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.GitLabWebHookCause)
+		if err := deepCopy_v1_GitLabWebHookCause(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.BitbucketWebHookCause)
+		if err := deepCopy_v1_BitbucketWebHookCause(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(apiv1.ImageChangeCause)
+		if err := deepCopy_v1_ImageChangeCause(*in.ImageChangeBuild, out.ImageChangeBuild, c); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(apiv1.ManualCause)
+		if err := deepCopy_v1_ManualCause(*in.Manual, out.Manual, c); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.BuildTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.GitHubWebHook != nil {
@@ -953,6 +1164,22 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := deepCopy_v1_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -996,6 +1223,15 @@ func deepCopy_v1_CustomBuildStrategy(in apiv1.CustomBuildStrategy, out *apiv1.Cu
 	return nil
 }
 
+func deepCopy_v1_DeployStage(in apiv1.DeployStage, out *apiv1.DeployStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.DeploymentConfig); err != nil {
+		return err
+	} else {
+		out.DeploymentConfig = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1_DockerBuildStrategy(in apiv1.DockerBuildStrategy, out *apiv1.DockerBuildStrategy, c *conversion.Cloner) error {
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
@@ -1017,12 +1253,10 @@ func deepCopy_v1_DockerBuildStrategy(in apiv1.DockerBuildStrategy, out *apiv1.Do
 	}
 	out.NoCache = in.NoCache
 	if in.Env != nil {
-		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		out.Env = make([]apiv1.BuildEnvVar, len(in.Env))
 		for i := range in.Env {
-			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+			if err := deepCopy_v1_BuildEnvVar(in.Env[i], &out.Env[i], c); err != nil {
 				return err
-			} else {
-				out.Env[i] = newVal.(pkgapiv1.EnvVar)
 			}
 		}
 	} else {
@@ -1032,6 +1266,18 @@ func deepCopy_v1_DockerBuildStrategy(in apiv1.DockerBuildStrategy, out *apiv1.Do
 	return nil
 }
 
+func deepCopy_v1_GenericWebHookCause(in apiv1.GenericWebHookCause, out *apiv1.GenericWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := deepCopy_v1_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_GitBuildSource(in apiv1.GitBuildSource, out *apiv1.GitBuildSource, c *conversion.Cloner) error {
 	out.URI = in.URI
 	out.Ref = in.Ref
@@ -1040,6 +1286,30 @@ func deepCopy_v1_GitBuildSource(in apiv1.GitBuildSource, out *apiv1.GitBuildSour
 	return nil
 }
 
+func deepCopy_v1_GitHubWebHookCause(in apiv1.GitHubWebHookCause, out *apiv1.GitHubWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := deepCopy_v1_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_GitLabWebHookCause(in apiv1.GitLabWebHookCause, out *apiv1.GitLabWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1.SourceRevision)
+		if err := deepCopy_v1_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_GitSourceRevision(in apiv1.GitSourceRevision, out *apiv1.GitSourceRevision, c *conversion.Cloner) error {
 	out.Commit = in.Commit
 	if err := deepCopy_v1_SourceControlUser(in.Author, &out.Author, c); err != nil {
@@ -1049,6 +1319,21 @@ func deepCopy_v1_GitSourceRevision(in apiv1.GitSourceRevision, out *apiv1.GitSou
 		return err
 	}
 	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func deepCopy_v1_ImageChangeCause(in apiv1.ImageChangeCause, out *apiv1.ImageChangeCause, c *conversion.Cloner) error {
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		if newVal, err := c.DeepCopy(in.FromRef); err != nil {
+			return err
+		} else {
+			out.FromRef = newVal.(*pkgapiv1.ObjectReference)
+		}
+	} else {
+		out.FromRef = nil
+	}
 	return nil
 }
 
@@ -1066,12 +1351,22 @@ func deepCopy_v1_ImageChangeTrigger(in apiv1.ImageChangeTrigger, out *apiv1.Imag
 	return nil
 }
 
-func deepCopy_v1_SourceBuildStrategy(in apiv1.SourceBuildStrategy, out *apiv1.SourceBuildStrategy, c *conversion.Cloner) error {
+func deepCopy_v1_ImageSource(in apiv1.ImageSource, out *apiv1.ImageSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
 	} else {
 		out.From = newVal.(pkgapiv1.ObjectReference)
 	}
+	if in.Paths != nil {
+		out.Paths = make([]apiv1.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := deepCopy_v1_ImageSourcePath(in.Paths[i], &out.Paths[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
 	if in.PullSecret != nil {
 		if newVal, err := c.DeepCopy(in.PullSecret); err != nil {
 			return err
@@ -1081,64 +1376,436 @@ func deepCopy_v1_SourceBuildStrategy(in apiv1.SourceBuildStrategy, out *apiv1.So
 	} else {
 		out.PullSecret = nil
 	}
-	if in.Env != nil {
-		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
-		for i := range in.Env {
-			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
-				return err
-			} else {
-				out.Env[i] = newVal.(pkgapiv1.EnvVar)
-			}
-		}
-	} else {
-		out.Env = nil
-	}
-	out.Scripts = in.Scripts
-	out.Incremental = in.Incremental
-	out.ForcePull = in.ForcePull
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func deepCopy_v1_SourceControlUser(in apiv1.SourceControlUser, out *apiv1.SourceControlUser, c *conversion.Cloner) error {
-	out.Name = in.Name
-	out.Email = in.Email
+func deepCopy_v1_ImageSourcePath(in apiv1.ImageSourcePath, out *apiv1.ImageSourcePath, c *conversion.Cloner) error {
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func deepCopy_v1_SourceRevision(in apiv1.SourceRevision, out *apiv1.SourceRevision, c *conversion.Cloner) error {
-	out.Type = in.Type
-	if in.Git != nil {
-		out.Git = new(apiv1.GitSourceRevision)
-		if err := deepCopy_v1_GitSourceRevision(*in.Git, out.Git, c); err != nil {
-			return err
-		}
-	} else {
-		out.Git = nil
-	}
+func deepCopy_v1_ManualCause(in apiv1.ManualCause, out *apiv1.ManualCause, c *conversion.Cloner) error {
+	out.User = in.User
 	return nil
 }
 
-func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigger, c *conversion.Cloner) error {
-	out.Secret = in.Secret
+func deepCopy_v1_Pipeline(in apiv1.Pipeline, out *apiv1.Pipeline, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_PipelineSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_PipelineStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
 	return nil
 }
 
-func deepCopy_v1_CustomDeploymentStrategyParams(in deployapiv1.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
-	out.Image = in.Image
-	if in.Environment != nil {
-		out.Environment = make([]pkgapiv1.EnvVar, len(in.Environment))
-		for i := range in.Environment {
-			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+func deepCopy_v1_PipelineList(in apiv1.PipelineList, out *apiv1.PipelineList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(pkgapiv1.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]apiv1.Pipeline, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_Pipeline(in.Items[i], &out.Items[i], c); err != nil {
 				return err
-			} else {
-				out.Environment[i] = newVal.(pkgapiv1.EnvVar)
 			}
 		}
 	} else {
-		out.Environment = nil
+		out.Items = nil
 	}
-	if in.Command != nil {
-		out.Command = make([]string, len(in.Command))
+	return nil
+}
+
+func deepCopy_v1_PipelineSpec(in apiv1.PipelineSpec, out *apiv1.PipelineSpec, c *conversion.Cloner) error {
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1_PipelineStage(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelineStage(in apiv1.PipelineStage, out *apiv1.PipelineStage, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	if in.Build != nil {
+		out.Build = new(apiv1.BuildStage)
+		if err := deepCopy_v1_BuildStage(*in.Build, out.Build, c); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Deploy != nil {
+		out.Deploy = new(apiv1.DeployStage)
+		if err := deepCopy_v1_DeployStage(*in.Deploy, out.Deploy, c); err != nil {
+			return err
+		}
+	} else {
+		out.Deploy = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(apiv1.VerifyStage)
+		if err := deepCopy_v1_VerifyStage(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(apiv1.PromoteStage)
+		if err := deepCopy_v1_PromoteStage(*in.Promote, out.Promote, c); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelineStageStatus(in apiv1.PipelineStageStatus, out *apiv1.PipelineStageStatus, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.Reference != nil {
+		if newVal, err := c.DeepCopy(in.Reference); err != nil {
+			return err
+		} else {
+			out.Reference = newVal.(*pkgapiv1.ObjectReference)
+		}
+	} else {
+		out.Reference = nil
+	}
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelineStatus(in apiv1.PipelineStatus, out *apiv1.PipelineStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1_PipelineStageStatus(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PromoteStage(in apiv1.PromoteStage, out *apiv1.PromoteStage, c *conversion.Cloner) error {
+	if in.From != nil {
+		if newVal, err := c.DeepCopy(in.From); err != nil {
+			return err
+		} else {
+			out.From = newVal.(*pkgapiv1.ObjectReference)
+		}
+	} else {
+		out.From = nil
+	}
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1_SecretBuildSource(in apiv1.SecretBuildSource, out *apiv1.SecretBuildSource, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func deepCopy_v1_SecretKeySelector(in apiv1.SecretKeySelector, out *apiv1.SecretKeySelector, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.LocalObjectReference); err != nil {
+		return err
+	} else {
+		out.LocalObjectReference = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func deepCopy_v1_SourceBuildStrategy(in apiv1.SourceBuildStrategy, out *apiv1.SourceBuildStrategy, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.PullSecret != nil {
+		if newVal, err := c.DeepCopy(in.PullSecret); err != nil {
+			return err
+		} else {
+			out.PullSecret = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.PullSecret = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	out.Scripts = in.Scripts
+	out.Incremental = in.Incremental
+	out.ForcePull = in.ForcePull
+	return nil
+}
+
+func deepCopy_v1_SourceControlUser(in apiv1.SourceControlUser, out *apiv1.SourceControlUser, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+func deepCopy_v1_SourceRevision(in apiv1.SourceRevision, out *apiv1.SourceRevision, c *conversion.Cloner) error {
+	out.Type = in.Type
+	if in.Git != nil {
+		out.Git = new(apiv1.GitSourceRevision)
+		if err := deepCopy_v1_GitSourceRevision(*in.Git, out.Git, c); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_VerifyStage(in apiv1.VerifyStage, out *apiv1.VerifyStage, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigger, c *conversion.Cloner) error {
+	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
+	return nil
+}
+
+func deepCopy_v1_BlueGreenDeploymentStrategyParams(in deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryDeploymentStrategyParams(in deployapiv1.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_v1_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.MaxContainerRestarts != nil {
+		out.MaxContainerRestarts = new(int)
+		*out.MaxContainerRestarts = *in.MaxContainerRestarts
+	} else {
+		out.MaxContainerRestarts = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryStep(in deployapiv1.CanaryStep, out *deployapiv1.CanaryStep, c *conversion.Cloner) error {
+	if in.Percent != nil {
+		out.Percent = new(int)
+		*out.Percent = *in.Percent
+	} else {
+		out.Percent = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = new(int)
+		*out.Replicas = *in.Replicas
+	} else {
+		out.Replicas = nil
+	}
+	if in.PauseSeconds != nil {
+		out.PauseSeconds = new(int64)
+		*out.PauseSeconds = *in.PauseSeconds
+	} else {
+		out.PauseSeconds = nil
+	}
+	out.ManualApproval = in.ManualApproval
+	return nil
+}
+
+func deepCopy_v1_CustomDeploymentStrategyParams(in deployapiv1.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Environment != nil {
+		out.Environment = make([]pkgapiv1.EnvVar, len(in.Environment))
+		for i := range in.Environment {
+			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+				return err
+			} else {
+				out.Environment[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
+	} else {
+		out.Environment = nil
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
 		for i := range in.Command {
 			out.Command[i] = in.Command[i]
 		}
@@ -1253,6 +1920,14 @@ func deepCopy_v1_DeploymentConfigSpec(in deployapiv1.DeploymentConfigSpec, out *
 	} else {
 		out.Triggers = nil
 	}
+	out.Paused = in.Paused
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	out.Replicas = in.Replicas
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
@@ -1304,6 +1979,32 @@ func deepCopy_v1_DeploymentDetails(in deployapiv1.DeploymentDetails, out *deploy
 	return nil
 }
 
+func deepCopy_v1_DeploymentLog(in deployapiv1.DeploymentLog, out *deployapiv1.DeploymentLog, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(pkgapiv1.ListMeta)
+	}
+	return nil
+}
+
+func deepCopy_v1_DeploymentLogOptions(in deployapiv1.DeploymentLogOptions, out *deployapiv1.DeploymentLogOptions, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1.TypeMeta)
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *deployapiv1.DeploymentStrategy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.CustomParams != nil {
@@ -1330,6 +2031,22 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1392,6 +2109,14 @@ func deepCopy_v1_ExecNewPodHook(in deployapiv1.ExecNewPodHook, out *deployapiv1.
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	if in.Volumes != nil {
+		out.Volumes = make([]string, len(in.Volumes))
+		for i := range in.Volumes {
+			out.Volumes[i] = in.Volumes[i]
+		}
+	} else {
+		out.Volumes = nil
+	}
 	return nil
 }
 
@@ -1405,6 +2130,16 @@ func deepCopy_v1_LifecycleHook(in deployapiv1.LifecycleHook, out *deployapiv1.Li
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapiv1.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := deepCopy_v1_TagImageHook(in.TagImages[i], &out.TagImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -1417,6 +2152,14 @@ func deepCopy_v1_RecreateDeploymentStrategyParams(in deployapiv1.RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1453,6 +2196,25 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.MaxUnavailable != nil {
+		if newVal, err := c.DeepCopy(in.MaxUnavailable); err != nil {
+			return err
+		} else {
+			out.MaxUnavailable = newVal.(*util.IntOrString)
+		}
+	} else {
+		out.MaxUnavailable = nil
+	}
+	if in.MaxSurge != nil {
+		if newVal, err := c.DeepCopy(in.MaxSurge); err != nil {
+			return err
+		} else {
+			out.MaxSurge = newVal.(*util.IntOrString)
+		}
+	} else {
+		out.MaxSurge = nil
+	}
+	out.MinReadySeconds = in.MinReadySeconds
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1461,6 +2223,14 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1472,6 +2242,16 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	return nil
 }
 
+func deepCopy_v1_TagImageHook(in deployapiv1.TagImageHook, out *deployapiv1.TagImageHook, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1.ObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1_Image(in imageapiv1.Image, out *imageapiv1.Image, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2452,30 +3232,58 @@ func init() {
 		deepCopy_v1_RoleList,
 		deepCopy_v1_SubjectAccessReview,
 		deepCopy_v1_SubjectAccessReviewResponse,
+		deepCopy_v1_BinaryBuildSource,
+		deepCopy_v1_BitbucketWebHookCause,
 		deepCopy_v1_Build,
 		deepCopy_v1_BuildConfig,
 		deepCopy_v1_BuildConfigList,
 		deepCopy_v1_BuildConfigSpec,
 		deepCopy_v1_BuildConfigStatus,
+		deepCopy_v1_BuildEnvVar,
+		deepCopy_v1_BuildEnvVarSource,
 		deepCopy_v1_BuildList,
 		deepCopy_v1_BuildLog,
 		deepCopy_v1_BuildLogOptions,
 		deepCopy_v1_BuildOutput,
+		deepCopy_v1_BuildPostCommitSpec,
 		deepCopy_v1_BuildRequest,
 		deepCopy_v1_BuildSource,
 		deepCopy_v1_BuildSpec,
+		deepCopy_v1_BuildStage,
 		deepCopy_v1_BuildStatus,
 		deepCopy_v1_BuildStrategy,
+		deepCopy_v1_BuildTriggerCause,
 		deepCopy_v1_BuildTriggerPolicy,
 		deepCopy_v1_CustomBuildStrategy,
+		deepCopy_v1_DeployStage,
 		deepCopy_v1_DockerBuildStrategy,
+		deepCopy_v1_GenericWebHookCause,
 		deepCopy_v1_GitBuildSource,
+		deepCopy_v1_GitHubWebHookCause,
+		deepCopy_v1_GitLabWebHookCause,
 		deepCopy_v1_GitSourceRevision,
+		deepCopy_v1_ImageChangeCause,
 		deepCopy_v1_ImageChangeTrigger,
+		deepCopy_v1_ImageSource,
+		deepCopy_v1_ImageSourcePath,
+		deepCopy_v1_ManualCause,
+		deepCopy_v1_Pipeline,
+		deepCopy_v1_PipelineList,
+		deepCopy_v1_PipelineSpec,
+		deepCopy_v1_PipelineStage,
+		deepCopy_v1_PipelineStageStatus,
+		deepCopy_v1_PipelineStatus,
+		deepCopy_v1_PromoteStage,
+		deepCopy_v1_SecretBuildSource,
+		deepCopy_v1_SecretKeySelector,
 		deepCopy_v1_SourceBuildStrategy,
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
+		deepCopy_v1_VerifyStage,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_BlueGreenDeploymentStrategyParams,
+		deepCopy_v1_CanaryDeploymentStrategyParams,
+		deepCopy_v1_CanaryStep,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
@@ -2486,6 +3294,8 @@ func init() {
 		deepCopy_v1_DeploymentConfigSpec,
 		deepCopy_v1_DeploymentConfigStatus,
 		deepCopy_v1_DeploymentDetails,
+		deepCopy_v1_DeploymentLog,
+		deepCopy_v1_DeploymentLogOptions,
 		deepCopy_v1_DeploymentStrategy,
 		deepCopy_v1_DeploymentTriggerImageChangeParams,
 		deepCopy_v1_DeploymentTriggerPolicy,
@@ -2493,6 +3303,7 @@ func init() {
 		deepCopy_v1_LifecycleHook,
 		deepCopy_v1_RecreateDeploymentStrategyParams,
 		deepCopy_v1_RollingDeploymentStrategyParams,
+		deepCopy_v1_TagImageHook,
 		deepCopy_v1_Image,
 		deepCopy_v1_ImageList,
 		deepCopy_v1_ImageStream,
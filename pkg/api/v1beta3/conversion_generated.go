@@ -605,6 +605,29 @@ func convert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewRespo
 	return nil
 }
 
+func convert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource(in *buildapi.BinaryBuildSource, out *apiv1beta3.BinaryBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BinaryBuildSource))(in)
+	}
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func convert_api_BitbucketWebHookCause_To_v1beta3_BitbucketWebHookCause(in *buildapi.BitbucketWebHookCause, out *apiv1beta3.BitbucketWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BitbucketWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1beta3_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func convert_api_Build_To_v1beta3_Build(in *buildapi.Build, out *apiv1beta3.Build, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.Build))(in)
@@ -683,6 +706,19 @@ func convert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec(in *buildapi.BuildCo
 	if err := convert_api_BuildSpec_To_v1beta3_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = apiv1beta3.BuildRunPolicy(in.RunPolicy)
 	return nil
 }
 
@@ -694,6 +730,38 @@ func convert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus(in *buildapi.Bui
 	return nil
 }
 
+func convert_api_BuildEnvVar_To_v1beta3_BuildEnvVar(in *buildapi.BuildEnvVar, out *apiv1beta3.BuildEnvVar, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildEnvVar))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(apiv1beta3.BuildEnvVarSource)
+		if err := convert_api_BuildEnvVarSource_To_v1beta3_BuildEnvVarSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func convert_api_BuildEnvVarSource_To_v1beta3_BuildEnvVarSource(in *buildapi.BuildEnvVarSource, out *apiv1beta3.BuildEnvVarSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildEnvVarSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(apiv1beta3.SecretKeySelector)
+		if err := convert_api_SecretKeySelector_To_v1beta3_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
 func convert_api_BuildList_To_v1beta3_BuildList(in *buildapi.BuildList, out *apiv1beta3.BuildList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildList))(in)
@@ -742,6 +810,30 @@ func convert_api_BuildLogOptions_To_v1beta3_BuildLogOptions(in *buildapi.BuildLo
 	return nil
 }
 
+func convert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec(in *buildapi.BuildPostCommitSpec, out *apiv1beta3.BuildPostCommitSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildPostCommitSpec))(in)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
 func convert_api_BuildRequest_To_v1beta3_BuildRequest(in *buildapi.BuildRequest, out *apiv1beta3.BuildRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildRequest))(in)
@@ -768,6 +860,14 @@ func convert_api_BuildRequest_To_v1beta3_BuildRequest(in *buildapi.BuildRequest,
 	} else {
 		out.TriggeredByImage = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1beta3.BinaryBuildSource)
+		if err := convert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
 	if in.From != nil {
 		out.From = new(pkgapiv1beta3.ObjectReference)
 		if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(in.From, out.From, s); err != nil {
@@ -782,6 +882,16 @@ func convert_api_BuildRequest_To_v1beta3_BuildRequest(in *buildapi.BuildRequest,
 	} else {
 		out.LastVersion = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1beta3.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_api_BuildTriggerCause_To_v1beta3_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -798,6 +908,30 @@ func convert_api_BuildSource_To_v1beta3_BuildSource(in *buildapi.BuildSource, ou
 	} else {
 		out.Git = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1beta3.BinaryBuildSource)
+		if err := convert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]apiv1beta3.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageSource_To_v1beta3_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		out.SourceSecret = new(pkgapiv1beta3.LocalObjectReference)
@@ -807,6 +941,16 @@ func convert_api_BuildSource_To_v1beta3_BuildSource(in *buildapi.BuildSource, ou
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1beta3.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -835,6 +979,25 @@ func convert_api_BuildSpec_To_v1beta3_BuildSpec(in *buildapi.BuildSpec, out *api
 	if err := convert_api_ResourceRequirements_To_v1beta3_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	if err := convert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func convert_api_BuildStage_To_v1beta3_BuildStage(in *buildapi.BuildStage, out *apiv1beta3.BuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildStage))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.BuildConfig, &out.BuildConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -844,6 +1007,7 @@ func convert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus, ou
 	}
 	out.Phase = apiv1beta3.BuildPhase(in.Phase)
 	out.Cancelled = in.Cancelled
+	out.Reason = apiv1beta3.StatusReason(in.Reason)
 	out.Message = in.Message
 	if in.StartTimestamp != nil {
 		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
@@ -868,6 +1032,16 @@ func convert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus, ou
 	} else {
 		out.Config = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1beta3.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_api_BuildTriggerCause_To_v1beta3_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -900,6 +1074,87 @@ func convert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildStrate
 	return nil
 }
 
+func convert_api_BuildTriggerCause_To_v1beta3_BuildTriggerCause(in *buildapi.BuildTriggerCause, out *apiv1beta3.BuildTriggerCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildTriggerCause))(in)
+	}
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(apiv1beta3.GenericWebHookCause)
+		if err := convert_api_GenericWebHookCause_To_v1beta3_GenericWebHookCause(in.GenericWebHook, out.GenericWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(apiv1beta3.GitHubWebHookCause)
+		if err := convert_api_GitHubWebHookCause_To_v1beta3_GitHubWebHookCause(in.GitHubWebHook, out.GitHubWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.GitLabWebHookCause)
+		if err := convert_api_GitLabWebHookCause_To_v1beta3_GitLabWebHookCause(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.BitbucketWebHookCause)
+		if err := convert_api_BitbucketWebHookCause_To_v1beta3_BitbucketWebHookCause(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(apiv1beta3.ImageChangeCause)
+		if err := convert_api_ImageChangeCause_To_v1beta3_ImageChangeCause(in.ImageChangeBuild, out.ImageChangeBuild, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(apiv1beta3.ManualCause)
+		if err := convert_api_ManualCause_To_v1beta3_ManualCause(in.Manual, out.Manual, s); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
+func convert_api_DeployStage_To_v1beta3_DeployStage(in *buildapi.DeployStage, out *apiv1beta3.DeployStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.DeployStage))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.DeploymentConfig, &out.DeploymentConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_GenericWebHookCause_To_v1beta3_GenericWebHookCause(in *buildapi.GenericWebHookCause, out *apiv1beta3.GenericWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GenericWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1beta3_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func convert_api_GitBuildSource_To_v1beta3_GitBuildSource(in *buildapi.GitBuildSource, out *apiv1beta3.GitBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitBuildSource))(in)
@@ -911,6 +1166,36 @@ func convert_api_GitBuildSource_To_v1beta3_GitBuildSource(in *buildapi.GitBuildS
 	return nil
 }
 
+func convert_api_GitHubWebHookCause_To_v1beta3_GitHubWebHookCause(in *buildapi.GitHubWebHookCause, out *apiv1beta3.GitHubWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GitHubWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1beta3_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_api_GitLabWebHookCause_To_v1beta3_GitLabWebHookCause(in *buildapi.GitLabWebHookCause, out *apiv1beta3.GitLabWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GitLabWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := convert_api_SourceRevision_To_v1beta3_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func convert_api_GitSourceRevision_To_v1beta3_GitSourceRevision(in *buildapi.GitSourceRevision, out *apiv1beta3.GitSourceRevision, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitSourceRevision))(in)
@@ -923,6 +1208,23 @@ func convert_api_GitSourceRevision_To_v1beta3_GitSourceRevision(in *buildapi.Git
 		return err
 	}
 	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func convert_api_ImageChangeCause_To_v1beta3_ImageChangeCause(in *buildapi.ImageChangeCause, out *apiv1beta3.ImageChangeCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.ImageChangeCause))(in)
+	}
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		out.FromRef = new(pkgapiv1beta3.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(in.FromRef, out.FromRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.FromRef = nil
+	}
 	return nil
 }
 
@@ -942,91 +1244,85 @@ func convert_api_ImageChangeTrigger_To_v1beta3_ImageChangeTrigger(in *buildapi.I
 	return nil
 }
 
-func convert_api_SourceControlUser_To_v1beta3_SourceControlUser(in *buildapi.SourceControlUser, out *apiv1beta3.SourceControlUser, s conversion.Scope) error {
+func convert_api_ImageSource_To_v1beta3_ImageSource(in *buildapi.ImageSource, out *apiv1beta3.ImageSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapi.SourceControlUser))(in)
+		defaulting.(func(*buildapi.ImageSource))(in)
 	}
-	out.Name = in.Name
-	out.Email = in.Email
-	return nil
-}
-
-func convert_api_SourceRevision_To_v1beta3_SourceRevision(in *buildapi.SourceRevision, out *apiv1beta3.SourceRevision, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapi.SourceRevision))(in)
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
 	}
-	out.Type = apiv1beta3.BuildSourceType(in.Type)
-	if in.Git != nil {
-		out.Git = new(apiv1beta3.GitSourceRevision)
-		if err := convert_api_GitSourceRevision_To_v1beta3_GitSourceRevision(in.Git, out.Git, s); err != nil {
+	if in.Paths != nil {
+		out.Paths = make([]apiv1beta3.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.PullSecret != nil {
+		out.PullSecret = new(pkgapiv1beta3.LocalObjectReference)
+		if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.PullSecret, out.PullSecret, s); err != nil {
 			return err
 		}
 	} else {
-		out.Git = nil
+		out.PullSecret = nil
 	}
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1beta3.WebHookTrigger, s conversion.Scope) error {
+func convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in *buildapi.ImageSourcePath, out *apiv1beta3.ImageSourcePath, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapi.WebHookTrigger))(in)
+		defaulting.(func(*buildapi.ImageSourcePath))(in)
 	}
-	out.Secret = in.Secret
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func convert_v1beta3_Build_To_api_Build(in *apiv1beta3.Build, out *buildapi.Build, s conversion.Scope) error {
+func convert_api_ManualCause_To_v1beta3_ManualCause(in *buildapi.ManualCause, out *apiv1beta3.ManualCause, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.Build))(in)
-	}
-	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
-		return err
-	}
-	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
-	}
-	if err := convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_v1beta3_BuildStatus_To_api_BuildStatus(&in.Status, &out.Status, s); err != nil {
-		return err
+		defaulting.(func(*buildapi.ManualCause))(in)
 	}
+	out.User = in.User
 	return nil
 }
 
-func convert_v1beta3_BuildConfig_To_api_BuildConfig(in *apiv1beta3.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
+func convert_api_Pipeline_To_v1beta3_Pipeline(in *buildapi.Pipeline, out *apiv1beta3.Pipeline, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildConfig))(in)
+		defaulting.(func(*buildapi.Pipeline))(in)
 	}
-	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+	if err := convert_api_TypeMeta_To_v1beta3_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := convert_api_PipelineSpec_To_v1beta3_PipelineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := convert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(&in.Status, &out.Status, s); err != nil {
+	if err := convert_api_PipelineStatus_To_v1beta3_PipelineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-func convert_v1beta3_BuildConfigList_To_api_BuildConfigList(in *apiv1beta3.BuildConfigList, out *buildapi.BuildConfigList, s conversion.Scope) error {
+func convert_api_PipelineList_To_v1beta3_PipelineList(in *buildapi.PipelineList, out *apiv1beta3.PipelineList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildConfigList))(in)
+		defaulting.(func(*buildapi.PipelineList))(in)
 	}
-	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+	if err := convert_api_TypeMeta_To_v1beta3_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+	if err := convert_api_ListMeta_To_v1beta3_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]buildapi.BuildConfig, len(in.Items))
+		out.Items = make([]apiv1beta3.Pipeline, len(in.Items))
 		for i := range in.Items {
-			if err := convert_v1beta3_BuildConfig_To_api_BuildConfig(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_api_Pipeline_To_v1beta3_Pipeline(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -1036,185 +1332,1037 @@ func convert_v1beta3_BuildConfigList_To_api_BuildConfigList(in *apiv1beta3.Build
 	return nil
 }
 
-func convert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1beta3.BuildConfigSpec, out *buildapi.BuildConfigSpec, s conversion.Scope) error {
+func convert_api_PipelineSpec_To_v1beta3_PipelineSpec(in *buildapi.PipelineSpec, out *apiv1beta3.PipelineSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildConfigSpec))(in)
+		defaulting.(func(*buildapi.PipelineSpec))(in)
 	}
-	if in.Triggers != nil {
-		out.Triggers = make([]buildapi.BuildTriggerPolicy, len(in.Triggers))
-		for i := range in.Triggers {
-			if err := s.Convert(&in.Triggers[i], &out.Triggers[i], 0); err != nil {
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_api_PipelineStage_To_v1beta3_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Triggers = nil
-	}
-	if err := convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
-		return err
+		out.Stages = nil
 	}
 	return nil
 }
 
-func convert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1beta3.BuildConfigStatus, out *buildapi.BuildConfigStatus, s conversion.Scope) error {
+func convert_api_PipelineStage_To_v1beta3_PipelineStage(in *buildapi.PipelineStage, out *apiv1beta3.PipelineStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildConfigStatus))(in)
+		defaulting.(func(*buildapi.PipelineStage))(in)
 	}
-	out.LastVersion = in.LastVersion
-	return nil
-}
-
-func convert_v1beta3_BuildList_To_api_BuildList(in *apiv1beta3.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildList))(in)
+	out.Name = in.Name
+	out.Type = apiv1beta3.PipelineStageType(in.Type)
+	if in.Build != nil {
+		out.Build = new(apiv1beta3.BuildStage)
+		if err := convert_api_BuildStage_To_v1beta3_BuildStage(in.Build, out.Build, s); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Deploy != nil {
+		out.Deploy = new(apiv1beta3.DeployStage)
+		if err := convert_api_DeployStage_To_v1beta3_DeployStage(in.Deploy, out.Deploy, s); err != nil {
+			return err
+		}
+	} else {
+		out.Deploy = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(apiv1beta3.VerifyStage)
+		if err := convert_api_VerifyStage_To_v1beta3_VerifyStage(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(apiv1beta3.PromoteStage)
+		if err := convert_api_PromoteStage_To_v1beta3_PromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func convert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus(in *buildapi.PipelineStageStatus, out *apiv1beta3.PipelineStageStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStageStatus))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1beta3.PipelineStagePhase(in.Phase)
+	out.Message = in.Message
+	if in.Reference != nil {
+		out.Reference = new(pkgapiv1beta3.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(in.Reference, out.Reference, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reference = nil
+	}
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func convert_api_PipelineStatus_To_v1beta3_PipelineStatus(in *buildapi.PipelineStatus, out *apiv1beta3.PipelineStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStatus))(in)
+	}
+	out.Phase = apiv1beta3.PipelinePhase(in.Phase)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func convert_api_PromoteStage_To_v1beta3_PromoteStage(in *buildapi.PromoteStage, out *apiv1beta3.PromoteStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PromoteStage))(in)
+	}
+	if in.From != nil {
+		out.From = new(pkgapiv1beta3.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1beta3.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SecretBuildSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_api_SecretKeySelector_To_v1beta3_SecretKeySelector(in *buildapi.SecretKeySelector, out *apiv1beta3.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SecretKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_SourceControlUser_To_v1beta3_SourceControlUser(in *buildapi.SourceControlUser, out *apiv1beta3.SourceControlUser, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SourceControlUser))(in)
+	}
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+func convert_api_SourceRevision_To_v1beta3_SourceRevision(in *buildapi.SourceRevision, out *apiv1beta3.SourceRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SourceRevision))(in)
+	}
+	out.Type = apiv1beta3.BuildSourceType(in.Type)
+	if in.Git != nil {
+		out.Git = new(apiv1beta3.GitSourceRevision)
+		if err := convert_api_GitSourceRevision_To_v1beta3_GitSourceRevision(in.Git, out.Git, s); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	return nil
+}
+
+func convert_api_VerifyStage_To_v1beta3_VerifyStage(in *buildapi.VerifyStage, out *apiv1beta3.VerifyStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.VerifyStage))(in)
+	}
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_api_EnvVar_To_v1beta3_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1beta3.WebHookTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.WebHookTrigger))(in)
+	}
+	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
+	return nil
+}
+
+func convert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource(in *apiv1beta3.BinaryBuildSource, out *buildapi.BinaryBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BinaryBuildSource))(in)
+	}
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func convert_v1beta3_BitbucketWebHookCause_To_api_BitbucketWebHookCause(in *apiv1beta3.BitbucketWebHookCause, out *buildapi.BitbucketWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BitbucketWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_Build_To_api_Build(in *apiv1beta3.Build, out *buildapi.Build, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.Build))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_BuildStatus_To_api_BuildStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildConfig_To_api_BuildConfig(in *apiv1beta3.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildConfig))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildConfigList_To_api_BuildConfigList(in *apiv1beta3.BuildConfigList, out *buildapi.BuildConfigList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildConfigList))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.BuildConfig, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1beta3_BuildConfig_To_api_BuildConfig(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1beta3.BuildConfigSpec, out *buildapi.BuildConfigSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildConfigSpec))(in)
+	}
+	if in.Triggers != nil {
+		out.Triggers = make([]buildapi.BuildTriggerPolicy, len(in.Triggers))
+		for i := range in.Triggers {
+			if err := s.Convert(&in.Triggers[i], &out.Triggers[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Triggers = nil
+	}
+	if err := convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
+		return err
+	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	return nil
+}
+
+func convert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1beta3.BuildConfigStatus, out *buildapi.BuildConfigStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildConfigStatus))(in)
+	}
+	out.LastVersion = in.LastVersion
+	return nil
+}
+
+func convert_v1beta3_BuildEnvVar_To_api_BuildEnvVar(in *apiv1beta3.BuildEnvVar, out *buildapi.BuildEnvVar, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildEnvVar))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(buildapi.BuildEnvVarSource)
+		if err := convert_v1beta3_BuildEnvVarSource_To_api_BuildEnvVarSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildEnvVarSource_To_api_BuildEnvVarSource(in *apiv1beta3.BuildEnvVarSource, out *buildapi.BuildEnvVarSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildEnvVarSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(buildapi.SecretKeySelector)
+		if err := convert_v1beta3_SecretKeySelector_To_api_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildList_To_api_BuildList(in *apiv1beta3.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildList))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.Build, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1beta3_Build_To_api_Build(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildLog_To_api_BuildLog(in *apiv1beta3.BuildLog, out *buildapi.BuildLog, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildLog))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildLogOptions_To_api_BuildLogOptions(in *apiv1beta3.BuildLogOptions, out *buildapi.BuildLogOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildLogOptions))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	return nil
+}
+
+func convert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *apiv1beta3.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildPostCommitSpec))(in)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
+func convert_v1beta3_BuildRequest_To_api_BuildRequest(in *apiv1beta3.BuildRequest, out *buildapi.BuildRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildRequest))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	if in.TriggeredByImage != nil {
+		out.TriggeredByImage = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.TriggeredByImage, out.TriggeredByImage, s); err != nil {
+			return err
+		}
+	} else {
+		out.TriggeredByImage = nil
+	}
+	if in.Binary != nil {
+		out.Binary = new(buildapi.BinaryBuildSource)
+		if err := convert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.From != nil {
+		out.From = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	if in.LastVersion != nil {
+		out.LastVersion = new(int)
+		*out.LastVersion = *in.LastVersion
+	} else {
+		out.LastVersion = nil
+	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]buildapi.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_v1beta3_BuildTriggerCause_To_api_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildSource_To_api_BuildSource(in *apiv1beta3.BuildSource, out *buildapi.BuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildSource))(in)
+	}
+	out.Type = buildapi.BuildSourceType(in.Type)
+	if in.Git != nil {
+		out.Git = new(buildapi.GitBuildSource)
+		if err := convert_v1beta3_GitBuildSource_To_api_GitBuildSource(in.Git, out.Git, s); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	if in.Binary != nil {
+		out.Binary = new(buildapi.BinaryBuildSource)
+		if err := convert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource(in.Binary, out.Binary, s); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]buildapi.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1beta3_ImageSource_To_api_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	out.ContextDir = in.ContextDir
+	if in.SourceSecret != nil {
+		out.SourceSecret = new(pkgapi.LocalObjectReference)
+		if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.SourceSecret, out.SourceSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.SourceSecret = nil
+	}
+	if in.Secrets != nil {
+		out.Secrets = make([]buildapi.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildSpec_To_api_BuildSpec(in *apiv1beta3.BuildSpec, out *buildapi.BuildSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildSpec))(in)
+	}
+	out.ServiceAccount = in.ServiceAccount
+	if err := convert_v1beta3_BuildSource_To_api_BuildSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	if err := convert_v1beta3_BuildStrategy_To_api_BuildStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.Output, &out.Output, 0); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildStage_To_api_BuildStage(in *apiv1beta3.BuildStage, out *buildapi.BuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildStage))(in)
+	}
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.BuildConfig, &out.BuildConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildStatus_To_api_BuildStatus(in *apiv1beta3.BuildStatus, out *buildapi.BuildStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildStatus))(in)
+	}
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	out.Cancelled = in.Cancelled
+	out.Reason = buildapi.StatusReason(in.Reason)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	if in.Config != nil {
+		out.Config = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.Config, out.Config, s); err != nil {
+			return err
+		}
+	} else {
+		out.Config = nil
+	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]buildapi.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := convert_v1beta3_BuildTriggerCause_To_api_BuildTriggerCause(&in.TriggeredBy[i], &out.TriggeredBy[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *apiv1beta3.BuildStrategy, out *buildapi.BuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildStrategy))(in)
+	}
+	out.Type = buildapi.BuildStrategyType(in.Type)
+	if in.DockerStrategy != nil {
+		if err := s.Convert(&in.DockerStrategy, &out.DockerStrategy, 0); err != nil {
+			return err
+		}
+	} else {
+		out.DockerStrategy = nil
+	}
+	if in.SourceStrategy != nil {
+		if err := s.Convert(&in.SourceStrategy, &out.SourceStrategy, 0); err != nil {
+			return err
+		}
+	} else {
+		out.SourceStrategy = nil
+	}
+	if in.CustomStrategy != nil {
+		if err := s.Convert(&in.CustomStrategy, &out.CustomStrategy, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CustomStrategy = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildTriggerCause_To_api_BuildTriggerCause(in *apiv1beta3.BuildTriggerCause, out *buildapi.BuildTriggerCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildTriggerCause))(in)
+	}
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(buildapi.GenericWebHookCause)
+		if err := convert_v1beta3_GenericWebHookCause_To_api_GenericWebHookCause(in.GenericWebHook, out.GenericWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(buildapi.GitHubWebHookCause)
+		if err := convert_v1beta3_GitHubWebHookCause_To_api_GitHubWebHookCause(in.GitHubWebHook, out.GitHubWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.GitLabWebHookCause)
+		if err := convert_v1beta3_GitLabWebHookCause_To_api_GitLabWebHookCause(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.BitbucketWebHookCause)
+		if err := convert_v1beta3_BitbucketWebHookCause_To_api_BitbucketWebHookCause(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(buildapi.ImageChangeCause)
+		if err := convert_v1beta3_ImageChangeCause_To_api_ImageChangeCause(in.ImageChangeBuild, out.ImageChangeBuild, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(buildapi.ManualCause)
+		if err := convert_v1beta3_ManualCause_To_api_ManualCause(in.Manual, out.Manual, s); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_DeployStage_To_api_DeployStage(in *apiv1beta3.DeployStage, out *buildapi.DeployStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.DeployStage))(in)
+	}
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.DeploymentConfig, &out.DeploymentConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_GenericWebHookCause_To_api_GenericWebHookCause(in *apiv1beta3.GenericWebHookCause, out *buildapi.GenericWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.GenericWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_GitBuildSource_To_api_GitBuildSource(in *apiv1beta3.GitBuildSource, out *buildapi.GitBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.GitBuildSource))(in)
+	}
+	out.URI = in.URI
+	out.Ref = in.Ref
+	out.HTTPProxy = in.HTTPProxy
+	out.HTTPSProxy = in.HTTPSProxy
+	return nil
+}
+
+func convert_v1beta3_GitHubWebHookCause_To_api_GitHubWebHookCause(in *apiv1beta3.GitHubWebHookCause, out *buildapi.GitHubWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.GitHubWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_GitLabWebHookCause_To_api_GitLabWebHookCause(in *apiv1beta3.GitLabWebHookCause, out *buildapi.GitLabWebHookCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.GitLabWebHookCause))(in)
+	}
+	if in.Revision != nil {
+		out.Revision = new(buildapi.SourceRevision)
+		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_GitSourceRevision_To_api_GitSourceRevision(in *apiv1beta3.GitSourceRevision, out *buildapi.GitSourceRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.GitSourceRevision))(in)
+	}
+	out.Commit = in.Commit
+	if err := convert_v1beta3_SourceControlUser_To_api_SourceControlUser(&in.Author, &out.Author, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_SourceControlUser_To_api_SourceControlUser(&in.Committer, &out.Committer, s); err != nil {
+		return err
+	}
+	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func convert_v1beta3_ImageChangeCause_To_api_ImageChangeCause(in *apiv1beta3.ImageChangeCause, out *buildapi.ImageChangeCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.ImageChangeCause))(in)
+	}
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		out.FromRef = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.FromRef, out.FromRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.FromRef = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_ImageChangeTrigger_To_api_ImageChangeTrigger(in *apiv1beta3.ImageChangeTrigger, out *buildapi.ImageChangeTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.ImageChangeTrigger))(in)
+	}
+	out.LastTriggeredImageID = in.LastTriggeredImageID
+	if in.From != nil {
+		out.From = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
 	}
-	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
-		return err
+	return nil
+}
+
+func convert_v1beta3_ImageSource_To_api_ImageSource(in *apiv1beta3.ImageSource, out *buildapi.ImageSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.ImageSource))(in)
 	}
-	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
 		return err
 	}
-	if in.Items != nil {
-		out.Items = make([]buildapi.Build, len(in.Items))
-		for i := range in.Items {
-			if err := convert_v1beta3_Build_To_api_Build(&in.Items[i], &out.Items[i], s); err != nil {
+	if in.Paths != nil {
+		out.Paths = make([]buildapi.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Items = nil
+		out.Paths = nil
+	}
+	if in.PullSecret != nil {
+		out.PullSecret = new(pkgapi.LocalObjectReference)
+		if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.PullSecret, out.PullSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullSecret = nil
 	}
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func convert_v1beta3_BuildLog_To_api_BuildLog(in *apiv1beta3.BuildLog, out *buildapi.BuildLog, s conversion.Scope) error {
+func convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in *apiv1beta3.ImageSourcePath, out *buildapi.ImageSourcePath, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildLog))(in)
-	}
-	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
-		return err
+		defaulting.(func(*apiv1beta3.ImageSourcePath))(in)
 	}
-	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
-		return err
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_v1beta3_ManualCause_To_api_ManualCause(in *apiv1beta3.ManualCause, out *buildapi.ManualCause, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.ManualCause))(in)
 	}
+	out.User = in.User
 	return nil
 }
 
-func convert_v1beta3_BuildLogOptions_To_api_BuildLogOptions(in *apiv1beta3.BuildLogOptions, out *buildapi.BuildLogOptions, s conversion.Scope) error {
+func convert_v1beta3_Pipeline_To_api_Pipeline(in *apiv1beta3.Pipeline, out *buildapi.Pipeline, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildLogOptions))(in)
+		defaulting.(func(*apiv1beta3.Pipeline))(in)
 	}
 	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	out.Follow = in.Follow
-	out.NoWait = in.NoWait
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_PipelineSpec_To_api_PipelineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_PipelineStatus_To_api_PipelineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-func convert_v1beta3_BuildRequest_To_api_BuildRequest(in *apiv1beta3.BuildRequest, out *buildapi.BuildRequest, s conversion.Scope) error {
+func convert_v1beta3_PipelineList_To_api_PipelineList(in *apiv1beta3.PipelineList, out *buildapi.PipelineList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildRequest))(in)
+		defaulting.(func(*apiv1beta3.PipelineList))(in)
 	}
 	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
 		return err
 	}
-	if in.Revision != nil {
-		out.Revision = new(buildapi.SourceRevision)
-		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
-			return err
+	if in.Items != nil {
+		out.Items = make([]buildapi.Pipeline, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1beta3_Pipeline_To_api_Pipeline(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Revision = nil
+		out.Items = nil
 	}
-	if in.TriggeredByImage != nil {
-		out.TriggeredByImage = new(pkgapi.ObjectReference)
-		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.TriggeredByImage, out.TriggeredByImage, s); err != nil {
-			return err
-		}
-	} else {
-		out.TriggeredByImage = nil
+	return nil
+}
+
+func convert_v1beta3_PipelineSpec_To_api_PipelineSpec(in *apiv1beta3.PipelineSpec, out *buildapi.PipelineSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.PipelineSpec))(in)
 	}
-	if in.From != nil {
-		out.From = new(pkgapi.ObjectReference)
-		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
-			return err
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_v1beta3_PipelineStage_To_api_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.From = nil
-	}
-	if in.LastVersion != nil {
-		out.LastVersion = new(int)
-		*out.LastVersion = *in.LastVersion
-	} else {
-		out.LastVersion = nil
+		out.Stages = nil
 	}
 	return nil
 }
 
-func convert_v1beta3_BuildSource_To_api_BuildSource(in *apiv1beta3.BuildSource, out *buildapi.BuildSource, s conversion.Scope) error {
+func convert_v1beta3_PipelineStage_To_api_PipelineStage(in *apiv1beta3.PipelineStage, out *buildapi.PipelineStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildSource))(in)
+		defaulting.(func(*apiv1beta3.PipelineStage))(in)
 	}
-	out.Type = buildapi.BuildSourceType(in.Type)
-	if in.Git != nil {
-		out.Git = new(buildapi.GitBuildSource)
-		if err := convert_v1beta3_GitBuildSource_To_api_GitBuildSource(in.Git, out.Git, s); err != nil {
+	out.Name = in.Name
+	out.Type = buildapi.PipelineStageType(in.Type)
+	if in.Build != nil {
+		out.Build = new(buildapi.BuildStage)
+		if err := convert_v1beta3_BuildStage_To_api_BuildStage(in.Build, out.Build, s); err != nil {
 			return err
 		}
 	} else {
-		out.Git = nil
+		out.Build = nil
 	}
-	out.ContextDir = in.ContextDir
-	if in.SourceSecret != nil {
-		out.SourceSecret = new(pkgapi.LocalObjectReference)
-		if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.SourceSecret, out.SourceSecret, s); err != nil {
+	if in.Deploy != nil {
+		out.Deploy = new(buildapi.DeployStage)
+		if err := convert_v1beta3_DeployStage_To_api_DeployStage(in.Deploy, out.Deploy, s); err != nil {
 			return err
 		}
 	} else {
-		out.SourceSecret = nil
-	}
-	return nil
-}
-
-func convert_v1beta3_BuildSpec_To_api_BuildSpec(in *apiv1beta3.BuildSpec, out *buildapi.BuildSpec, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildSpec))(in)
+		out.Deploy = nil
 	}
-	out.ServiceAccount = in.ServiceAccount
-	if err := convert_v1beta3_BuildSource_To_api_BuildSource(&in.Source, &out.Source, s); err != nil {
-		return err
-	}
-	if in.Revision != nil {
-		out.Revision = new(buildapi.SourceRevision)
-		if err := convert_v1beta3_SourceRevision_To_api_SourceRevision(in.Revision, out.Revision, s); err != nil {
+	if in.Verify != nil {
+		out.Verify = new(buildapi.VerifyStage)
+		if err := convert_v1beta3_VerifyStage_To_api_VerifyStage(in.Verify, out.Verify, s); err != nil {
 			return err
 		}
 	} else {
-		out.Revision = nil
+		out.Verify = nil
 	}
-	if err := convert_v1beta3_BuildStrategy_To_api_BuildStrategy(&in.Strategy, &out.Strategy, s); err != nil {
-		return err
-	}
-	if err := s.Convert(&in.Output, &out.Output, 0); err != nil {
-		return err
-	}
-	if err := convert_v1beta3_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
-		return err
+	if in.Promote != nil {
+		out.Promote = new(buildapi.PromoteStage)
+		if err := convert_v1beta3_PromoteStage_To_api_PromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
 	}
 	return nil
 }
 
-func convert_v1beta3_BuildStatus_To_api_BuildStatus(in *apiv1beta3.BuildStatus, out *buildapi.BuildStatus, s conversion.Scope) error {
+func convert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus(in *apiv1beta3.PipelineStageStatus, out *buildapi.PipelineStageStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildStatus))(in)
+		defaulting.(func(*apiv1beta3.PipelineStageStatus))(in)
 	}
-	out.Phase = buildapi.BuildPhase(in.Phase)
-	out.Cancelled = in.Cancelled
+	out.Name = in.Name
+	out.Phase = buildapi.PipelineStagePhase(in.Phase)
 	out.Message = in.Message
+	if in.Reference != nil {
+		out.Reference = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.Reference, out.Reference, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reference = nil
+	}
 	if in.StartTimestamp != nil {
 		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
 			return err
@@ -1229,86 +2377,79 @@ func convert_v1beta3_BuildStatus_To_api_BuildStatus(in *apiv1beta3.BuildStatus,
 	} else {
 		out.CompletionTimestamp = nil
 	}
-	out.Duration = in.Duration
-	if in.Config != nil {
-		out.Config = new(pkgapi.ObjectReference)
-		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.Config, out.Config, s); err != nil {
-			return err
-		}
-	} else {
-		out.Config = nil
-	}
 	return nil
 }
 
-func convert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *apiv1beta3.BuildStrategy, out *buildapi.BuildStrategy, s conversion.Scope) error {
+func convert_v1beta3_PipelineStatus_To_api_PipelineStatus(in *apiv1beta3.PipelineStatus, out *buildapi.PipelineStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.BuildStrategy))(in)
+		defaulting.(func(*apiv1beta3.PipelineStatus))(in)
 	}
-	out.Type = buildapi.BuildStrategyType(in.Type)
-	if in.DockerStrategy != nil {
-		if err := s.Convert(&in.DockerStrategy, &out.DockerStrategy, 0); err != nil {
+	out.Phase = buildapi.PipelinePhase(in.Phase)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
 			return err
 		}
 	} else {
-		out.DockerStrategy = nil
+		out.StartTimestamp = nil
 	}
-	if in.SourceStrategy != nil {
-		if err := s.Convert(&in.SourceStrategy, &out.SourceStrategy, 0); err != nil {
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
 			return err
 		}
 	} else {
-		out.SourceStrategy = nil
+		out.CompletionTimestamp = nil
 	}
-	if in.CustomStrategy != nil {
-		if err := s.Convert(&in.CustomStrategy, &out.CustomStrategy, 0); err != nil {
-			return err
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.CustomStrategy = nil
+		out.Stages = nil
 	}
 	return nil
 }
 
-func convert_v1beta3_GitBuildSource_To_api_GitBuildSource(in *apiv1beta3.GitBuildSource, out *buildapi.GitBuildSource, s conversion.Scope) error {
+func convert_v1beta3_PromoteStage_To_api_PromoteStage(in *apiv1beta3.PromoteStage, out *buildapi.PromoteStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.GitBuildSource))(in)
+		defaulting.(func(*apiv1beta3.PromoteStage))(in)
+	}
+	if in.From != nil {
+		out.From = new(pkgapi.ObjectReference)
+		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
 	}
-	out.URI = in.URI
-	out.Ref = in.Ref
-	out.HTTPProxy = in.HTTPProxy
-	out.HTTPSProxy = in.HTTPSProxy
 	return nil
 }
 
-func convert_v1beta3_GitSourceRevision_To_api_GitSourceRevision(in *apiv1beta3.GitSourceRevision, out *buildapi.GitSourceRevision, s conversion.Scope) error {
+func convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *apiv1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.GitSourceRevision))(in)
-	}
-	out.Commit = in.Commit
-	if err := convert_v1beta3_SourceControlUser_To_api_SourceControlUser(&in.Author, &out.Author, s); err != nil {
-		return err
+		defaulting.(func(*apiv1beta3.SecretBuildSource))(in)
 	}
-	if err := convert_v1beta3_SourceControlUser_To_api_SourceControlUser(&in.Committer, &out.Committer, s); err != nil {
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
 		return err
 	}
-	out.Message = in.Message
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func convert_v1beta3_ImageChangeTrigger_To_api_ImageChangeTrigger(in *apiv1beta3.ImageChangeTrigger, out *buildapi.ImageChangeTrigger, s conversion.Scope) error {
+func convert_v1beta3_SecretKeySelector_To_api_SecretKeySelector(in *apiv1beta3.SecretKeySelector, out *buildapi.SecretKeySelector, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*apiv1beta3.ImageChangeTrigger))(in)
+		defaulting.(func(*apiv1beta3.SecretKeySelector))(in)
 	}
-	out.LastTriggeredImageID = in.LastTriggeredImageID
-	if in.From != nil {
-		out.From = new(pkgapi.ObjectReference)
-		if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(in.From, out.From, s); err != nil {
-			return err
-		}
-	} else {
-		out.From = nil
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
 	}
+	out.Key = in.Key
 	return nil
 }
 
@@ -1337,11 +2478,46 @@ func convert_v1beta3_SourceRevision_To_api_SourceRevision(in *apiv1beta3.SourceR
 	return nil
 }
 
+func convert_v1beta3_VerifyStage_To_api_VerifyStage(in *apiv1beta3.VerifyStage, out *buildapi.VerifyStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.VerifyStage))(in)
+	}
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_v1beta3_EnvVar_To_api_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
 func convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *apiv1beta3.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
 	return nil
 }
 
@@ -1395,6 +2571,32 @@ func convert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbac
 	return nil
 }
 
+func convert_api_DeploymentLog_To_v1beta3_DeploymentLog(in *deployapi.DeploymentLog, out *deployapiv1beta3.DeploymentLog, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentLog))(in)
+	}
+	if err := convert_api_TypeMeta_To_v1beta3_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ListMeta_To_v1beta3_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *deployapi.DeploymentLogOptions, out *deployapiv1beta3.DeploymentLogOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentLogOptions))(in)
+	}
+	if err := convert_api_TypeMeta_To_v1beta3_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func convert_v1beta3_DeploymentConfigList_To_api_DeploymentConfigList(in *deployapiv1beta3.DeploymentConfigList, out *deployapi.DeploymentConfigList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentConfigList))(in)
@@ -1445,6 +2647,32 @@ func convert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbac
 	return nil
 }
 
+func convert_v1beta3_DeploymentLog_To_api_DeploymentLog(in *deployapiv1beta3.DeploymentLog, out *deployapi.DeploymentLog, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentLog))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ListMeta_To_api_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deployapiv1beta3.DeploymentLogOptions, out *deployapi.DeploymentLogOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentLogOptions))(in)
+	}
+	if err := convert_v1beta3_TypeMeta_To_api_TypeMeta(&in.TypeMeta, &out.TypeMeta, s); err != nil {
+		return err
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func convert_api_ImageList_To_v1beta3_ImageList(in *imageapi.ImageList, out *imageapiv1beta3.ImageList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.ImageList))(in)
@@ -3069,18 +4297,25 @@ func convert_v1beta3_TypeMeta_To_api_TypeMeta(in *pkgapiv1beta3.TypeMeta, out *p
 
 func init() {
 	err := pkgapi.Scheme.AddGeneratedConversionFuncs(
+		convert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource,
+		convert_api_BitbucketWebHookCause_To_v1beta3_BitbucketWebHookCause,
 		convert_api_BuildConfigList_To_v1beta3_BuildConfigList,
 		convert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec,
 		convert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus,
 		convert_api_BuildConfig_To_v1beta3_BuildConfig,
+		convert_api_BuildEnvVarSource_To_v1beta3_BuildEnvVarSource,
+		convert_api_BuildEnvVar_To_v1beta3_BuildEnvVar,
 		convert_api_BuildList_To_v1beta3_BuildList,
 		convert_api_BuildLogOptions_To_v1beta3_BuildLogOptions,
 		convert_api_BuildLog_To_v1beta3_BuildLog,
+		convert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec,
 		convert_api_BuildRequest_To_v1beta3_BuildRequest,
 		convert_api_BuildSource_To_v1beta3_BuildSource,
 		convert_api_BuildSpec_To_v1beta3_BuildSpec,
+		convert_api_BuildStage_To_v1beta3_BuildStage,
 		convert_api_BuildStatus_To_v1beta3_BuildStatus,
 		convert_api_BuildStrategy_To_v1beta3_BuildStrategy,
+		convert_api_BuildTriggerCause_To_v1beta3_BuildTriggerCause,
 		convert_api_Build_To_v1beta3_Build,
 		convert_api_ClusterNetworkList_To_v1beta3_ClusterNetworkList,
 		convert_api_ClusterNetwork_To_v1beta3_ClusterNetwork,
@@ -3090,12 +4325,18 @@ func init() {
 		convert_api_ClusterRoleBindingList_To_v1beta3_ClusterRoleBindingList,
 		convert_api_ClusterRoleList_To_v1beta3_ClusterRoleList,
 		convert_api_ClusterRole_To_v1beta3_ClusterRole,
+		convert_api_DeployStage_To_v1beta3_DeployStage,
 		convert_api_DeploymentConfigList_To_v1beta3_DeploymentConfigList,
 		convert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbackSpec,
 		convert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback,
+		convert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions,
+		convert_api_DeploymentLog_To_v1beta3_DeploymentLog,
 		convert_api_EnvVarSource_To_v1beta3_EnvVarSource,
 		convert_api_EnvVar_To_v1beta3_EnvVar,
+		convert_api_GenericWebHookCause_To_v1beta3_GenericWebHookCause,
 		convert_api_GitBuildSource_To_v1beta3_GitBuildSource,
+		convert_api_GitHubWebHookCause_To_v1beta3_GitHubWebHookCause,
+		convert_api_GitLabWebHookCause_To_v1beta3_GitLabWebHookCause,
 		convert_api_GitSourceRevision_To_v1beta3_GitSourceRevision,
 		convert_api_GroupList_To_v1beta3_GroupList,
 		convert_api_Group_To_v1beta3_Group,
@@ -3103,12 +4344,16 @@ func init() {
 		convert_api_HostSubnet_To_v1beta3_HostSubnet,
 		convert_api_IdentityList_To_v1beta3_IdentityList,
 		convert_api_Identity_To_v1beta3_Identity,
+		convert_api_ImageChangeCause_To_v1beta3_ImageChangeCause,
 		convert_api_ImageChangeTrigger_To_v1beta3_ImageChangeTrigger,
 		convert_api_ImageList_To_v1beta3_ImageList,
+		convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath,
+		convert_api_ImageSource_To_v1beta3_ImageSource,
 		convert_api_ImageStreamList_To_v1beta3_ImageStreamList,
 		convert_api_IsPersonalSubjectAccessReview_To_v1beta3_IsPersonalSubjectAccessReview,
 		convert_api_ListMeta_To_v1beta3_ListMeta,
 		convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference,
+		convert_api_ManualCause_To_v1beta3_ManualCause,
 		convert_api_NetNamespaceList_To_v1beta3_NetNamespaceList,
 		convert_api_NetNamespace_To_v1beta3_NetNamespace,
 		convert_api_OAuthAccessTokenList_To_v1beta3_OAuthAccessTokenList,
@@ -3123,6 +4368,12 @@ func init() {
 		convert_api_ObjectMeta_To_v1beta3_ObjectMeta,
 		convert_api_ObjectReference_To_v1beta3_ObjectReference,
 		convert_api_Parameter_To_v1beta3_Parameter,
+		convert_api_PipelineList_To_v1beta3_PipelineList,
+		convert_api_PipelineSpec_To_v1beta3_PipelineSpec,
+		convert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus,
+		convert_api_PipelineStage_To_v1beta3_PipelineStage,
+		convert_api_PipelineStatus_To_v1beta3_PipelineStatus,
+		convert_api_Pipeline_To_v1beta3_Pipeline,
 		convert_api_PolicyBindingList_To_v1beta3_PolicyBindingList,
 		convert_api_PolicyList_To_v1beta3_PolicyList,
 		convert_api_ProjectList_To_v1beta3_ProjectList,
@@ -3130,12 +4381,15 @@ func init() {
 		convert_api_ProjectSpec_To_v1beta3_ProjectSpec,
 		convert_api_ProjectStatus_To_v1beta3_ProjectStatus,
 		convert_api_Project_To_v1beta3_Project,
+		convert_api_PromoteStage_To_v1beta3_PromoteStage,
 		convert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview,
 		convert_api_ResourceRequirements_To_v1beta3_ResourceRequirements,
 		convert_api_RoleBindingList_To_v1beta3_RoleBindingList,
 		convert_api_RoleList_To_v1beta3_RoleList,
 		convert_api_Role_To_v1beta3_Role,
 		convert_api_RouteList_To_v1beta3_RouteList,
+		convert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
+		convert_api_SecretKeySelector_To_v1beta3_SecretKeySelector,
 		convert_api_SourceControlUser_To_v1beta3_SourceControlUser,
 		convert_api_SourceRevision_To_v1beta3_SourceRevision,
 		convert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewResponse,
@@ -3144,19 +4398,27 @@ func init() {
 		convert_api_UserIdentityMapping_To_v1beta3_UserIdentityMapping,
 		convert_api_UserList_To_v1beta3_UserList,
 		convert_api_User_To_v1beta3_User,
+		convert_api_VerifyStage_To_v1beta3_VerifyStage,
 		convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger,
+		convert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource,
+		convert_v1beta3_BitbucketWebHookCause_To_api_BitbucketWebHookCause,
 		convert_v1beta3_BuildConfigList_To_api_BuildConfigList,
 		convert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec,
 		convert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus,
 		convert_v1beta3_BuildConfig_To_api_BuildConfig,
+		convert_v1beta3_BuildEnvVarSource_To_api_BuildEnvVarSource,
+		convert_v1beta3_BuildEnvVar_To_api_BuildEnvVar,
 		convert_v1beta3_BuildList_To_api_BuildList,
 		convert_v1beta3_BuildLogOptions_To_api_BuildLogOptions,
 		convert_v1beta3_BuildLog_To_api_BuildLog,
+		convert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec,
 		convert_v1beta3_BuildRequest_To_api_BuildRequest,
 		convert_v1beta3_BuildSource_To_api_BuildSource,
 		convert_v1beta3_BuildSpec_To_api_BuildSpec,
+		convert_v1beta3_BuildStage_To_api_BuildStage,
 		convert_v1beta3_BuildStatus_To_api_BuildStatus,
 		convert_v1beta3_BuildStrategy_To_api_BuildStrategy,
+		convert_v1beta3_BuildTriggerCause_To_api_BuildTriggerCause,
 		convert_v1beta3_Build_To_api_Build,
 		convert_v1beta3_ClusterNetworkList_To_api_ClusterNetworkList,
 		convert_v1beta3_ClusterNetwork_To_api_ClusterNetwork,
@@ -3166,12 +4428,18 @@ func init() {
 		convert_v1beta3_ClusterRoleBindingList_To_api_ClusterRoleBindingList,
 		convert_v1beta3_ClusterRoleList_To_api_ClusterRoleList,
 		convert_v1beta3_ClusterRole_To_api_ClusterRole,
+		convert_v1beta3_DeployStage_To_api_DeployStage,
 		convert_v1beta3_DeploymentConfigList_To_api_DeploymentConfigList,
 		convert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		convert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
+		convert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions,
+		convert_v1beta3_DeploymentLog_To_api_DeploymentLog,
 		convert_v1beta3_EnvVarSource_To_api_EnvVarSource,
 		convert_v1beta3_EnvVar_To_api_EnvVar,
+		convert_v1beta3_GenericWebHookCause_To_api_GenericWebHookCause,
 		convert_v1beta3_GitBuildSource_To_api_GitBuildSource,
+		convert_v1beta3_GitHubWebHookCause_To_api_GitHubWebHookCause,
+		convert_v1beta3_GitLabWebHookCause_To_api_GitLabWebHookCause,
 		convert_v1beta3_GitSourceRevision_To_api_GitSourceRevision,
 		convert_v1beta3_GroupList_To_api_GroupList,
 		convert_v1beta3_Group_To_api_Group,
@@ -3179,12 +4447,16 @@ func init() {
 		convert_v1beta3_HostSubnet_To_api_HostSubnet,
 		convert_v1beta3_IdentityList_To_api_IdentityList,
 		convert_v1beta3_Identity_To_api_Identity,
+		convert_v1beta3_ImageChangeCause_To_api_ImageChangeCause,
 		convert_v1beta3_ImageChangeTrigger_To_api_ImageChangeTrigger,
 		convert_v1beta3_ImageList_To_api_ImageList,
+		convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath,
+		convert_v1beta3_ImageSource_To_api_ImageSource,
 		convert_v1beta3_ImageStreamList_To_api_ImageStreamList,
 		convert_v1beta3_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
 		convert_v1beta3_ListMeta_To_api_ListMeta,
 		convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference,
+		convert_v1beta3_ManualCause_To_api_ManualCause,
 		convert_v1beta3_NetNamespaceList_To_api_NetNamespaceList,
 		convert_v1beta3_NetNamespace_To_api_NetNamespace,
 		convert_v1beta3_OAuthAccessTokenList_To_api_OAuthAccessTokenList,
@@ -3199,6 +4471,12 @@ func init() {
 		convert_v1beta3_ObjectMeta_To_api_ObjectMeta,
 		convert_v1beta3_ObjectReference_To_api_ObjectReference,
 		convert_v1beta3_Parameter_To_api_Parameter,
+		convert_v1beta3_PipelineList_To_api_PipelineList,
+		convert_v1beta3_PipelineSpec_To_api_PipelineSpec,
+		convert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus,
+		convert_v1beta3_PipelineStage_To_api_PipelineStage,
+		convert_v1beta3_PipelineStatus_To_api_PipelineStatus,
+		convert_v1beta3_Pipeline_To_api_Pipeline,
 		convert_v1beta3_PolicyBindingList_To_api_PolicyBindingList,
 		convert_v1beta3_PolicyList_To_api_PolicyList,
 		convert_v1beta3_ProjectList_To_api_ProjectList,
@@ -3206,12 +4484,15 @@ func init() {
 		convert_v1beta3_ProjectSpec_To_api_ProjectSpec,
 		convert_v1beta3_ProjectStatus_To_api_ProjectStatus,
 		convert_v1beta3_Project_To_api_Project,
+		convert_v1beta3_PromoteStage_To_api_PromoteStage,
 		convert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview,
 		convert_v1beta3_ResourceRequirements_To_api_ResourceRequirements,
 		convert_v1beta3_RoleBindingList_To_api_RoleBindingList,
 		convert_v1beta3_RoleList_To_api_RoleList,
 		convert_v1beta3_Role_To_api_Role,
 		convert_v1beta3_RouteList_To_api_RouteList,
+		convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
+		convert_v1beta3_SecretKeySelector_To_api_SecretKeySelector,
 		convert_v1beta3_SourceControlUser_To_api_SourceControlUser,
 		convert_v1beta3_SourceRevision_To_api_SourceRevision,
 		convert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
@@ -3220,6 +4501,7 @@ func init() {
 		convert_v1beta3_UserIdentityMapping_To_api_UserIdentityMapping,
 		convert_v1beta3_UserList_To_api_UserList,
 		convert_v1beta3_User_To_api_User,
+		convert_v1beta3_VerifyStage_To_api_VerifyStage,
 		convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger,
 	)
 	if err != nil {
@@ -626,6 +626,23 @@ func deepCopy_v1beta3_SubjectAccessReviewResponse(in v1beta3.SubjectAccessReview
 	return nil
 }
 
+func deepCopy_v1beta3_BinaryBuildSource(in apiv1beta3.BinaryBuildSource, out *apiv1beta3.BinaryBuildSource, c *conversion.Cloner) error {
+	out.AsFile = in.AsFile
+	return nil
+}
+
+func deepCopy_v1beta3_BitbucketWebHookCause(in apiv1beta3.BitbucketWebHookCause, out *apiv1beta3.BitbucketWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := deepCopy_v1beta3_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_Build(in apiv1beta3.Build, out *apiv1beta3.Build, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -704,6 +721,19 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 	if err := deepCopy_v1beta3_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = in.RunPolicy
 	return nil
 }
 
@@ -712,6 +742,32 @@ func deepCopy_v1beta3_BuildConfigStatus(in apiv1beta3.BuildConfigStatus, out *ap
 	return nil
 }
 
+func deepCopy_v1beta3_BuildEnvVar(in apiv1beta3.BuildEnvVar, out *apiv1beta3.BuildEnvVar, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(apiv1beta3.BuildEnvVarSource)
+		if err := deepCopy_v1beta3_BuildEnvVarSource(*in.ValueFrom, out.ValueFrom, c); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildEnvVarSource(in apiv1beta3.BuildEnvVarSource, out *apiv1beta3.BuildEnvVarSource, c *conversion.Cloner) error {
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(apiv1beta3.SecretKeySelector)
+		if err := deepCopy_v1beta3_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_BuildList(in apiv1beta3.BuildList, out *apiv1beta3.BuildList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -783,6 +839,27 @@ func deepCopy_v1beta3_BuildOutput(in apiv1beta3.BuildOutput, out *apiv1beta3.Bui
 	return nil
 }
 
+func deepCopy_v1beta3_BuildPostCommitSpec(in apiv1beta3.BuildPostCommitSpec, out *apiv1beta3.BuildPostCommitSpec, c *conversion.Cloner) error {
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
+	}
+	out.Script = in.Script
+	return nil
+}
+
 func deepCopy_v1beta3_BuildRequest(in apiv1beta3.BuildRequest, out *apiv1beta3.BuildRequest, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -811,6 +888,14 @@ func deepCopy_v1beta3_BuildRequest(in apiv1beta3.BuildRequest, out *apiv1beta3.B
 	} else {
 		out.TriggeredByImage = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1beta3.BinaryBuildSource)
+		if err := deepCopy_v1beta3_BinaryBuildSource(*in.Binary, out.Binary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
 			return err
@@ -826,6 +911,16 @@ func deepCopy_v1beta3_BuildRequest(in apiv1beta3.BuildRequest, out *apiv1beta3.B
 	} else {
 		out.LastVersion = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1beta3.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := deepCopy_v1beta3_BuildTriggerCause(in.TriggeredBy[i], &out.TriggeredBy[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -839,6 +934,30 @@ func deepCopy_v1beta3_BuildSource(in apiv1beta3.BuildSource, out *apiv1beta3.Bui
 	} else {
 		out.Git = nil
 	}
+	if in.Binary != nil {
+		out.Binary = new(apiv1beta3.BinaryBuildSource)
+		if err := deepCopy_v1beta3_BinaryBuildSource(*in.Binary, out.Binary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Binary = nil
+	}
+	if in.Dockerfile != nil {
+		out.Dockerfile = new(string)
+		*out.Dockerfile = *in.Dockerfile
+	} else {
+		out.Dockerfile = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]apiv1beta3.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1beta3_ImageSource(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		if newVal, err := c.DeepCopy(in.SourceSecret); err != nil {
@@ -849,6 +968,16 @@ func deepCopy_v1beta3_BuildSource(in apiv1beta3.BuildSource, out *apiv1beta3.Bui
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1beta3.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := deepCopy_v1beta3_SecretBuildSource(in.Secrets[i], &out.Secrets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -876,12 +1005,31 @@ func deepCopy_v1beta3_BuildSpec(in apiv1beta3.BuildSpec, out *apiv1beta3.BuildSp
 	} else {
 		out.Resources = newVal.(pkgapiv1beta3.ResourceRequirements)
 	}
+	if err := deepCopy_v1beta3_BuildPostCommitSpec(in.PostCommit, &out.PostCommit, c); err != nil {
+		return err
+	}
+	if in.CompletionDeadlineSeconds != nil {
+		out.CompletionDeadlineSeconds = new(int64)
+		*out.CompletionDeadlineSeconds = *in.CompletionDeadlineSeconds
+	} else {
+		out.CompletionDeadlineSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildStage(in apiv1beta3.BuildStage, out *apiv1beta3.BuildStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.BuildConfig); err != nil {
+		return err
+	} else {
+		out.BuildConfig = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
 	return nil
 }
 
 func deepCopy_v1beta3_BuildStatus(in apiv1beta3.BuildStatus, out *apiv1beta3.BuildStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
 	out.Cancelled = in.Cancelled
+	out.Reason = in.Reason
 	out.Message = in.Message
 	if in.StartTimestamp != nil {
 		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
@@ -911,6 +1059,16 @@ func deepCopy_v1beta3_BuildStatus(in apiv1beta3.BuildStatus, out *apiv1beta3.Bui
 	} else {
 		out.Config = nil
 	}
+	if in.TriggeredBy != nil {
+		out.TriggeredBy = make([]apiv1beta3.BuildTriggerCause, len(in.TriggeredBy))
+		for i := range in.TriggeredBy {
+			if err := deepCopy_v1beta3_BuildTriggerCause(in.TriggeredBy[i], &out.TriggeredBy[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredBy = nil
+	}
 	return nil
 }
 
@@ -943,6 +1101,59 @@ func deepCopy_v1beta3_BuildStrategy(in apiv1beta3.BuildStrategy, out *apiv1beta3
 	return nil
 }
 
+func deepCopy_v1beta3_BuildTriggerCause(in apiv1beta3.BuildTriggerCause, out *apiv1beta3.BuildTriggerCause, c *conversion.Cloner) error {
+	out.Message = in.Message
+	if in.GenericWebHook != nil {
+		out.GenericWebHook = new(apiv1beta3.GenericWebHookCause)
+		if err := deepCopy_v1beta3_GenericWebHookCause(*in.GenericWebHook, out.GenericWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GenericWebHook = nil
+	}
+	if in.GitHubWebHook != nil {
+		out.GitHubWebHook = new(apiv1beta3.GitHubWebHookCause)
+		if err := deepCopy_v1beta3_GitHubWebHookCause(*in.GitHubWebHook, out.GitHubWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitHubWebHook = nil
+	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.GitLabWebHookCause)
+		if err := deepCopy_v1beta3_GitLabWebHookCause(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.BitbucketWebHookCause)
+		if err := deepCopy_v1beta3_BitbucketWebHookCause(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.ImageChangeBuild != nil {
+		out.ImageChangeBuild = new(apiv1beta3.ImageChangeCause)
+		if err := deepCopy_v1beta3_ImageChangeCause(*in.ImageChangeBuild, out.ImageChangeBuild, c); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeBuild = nil
+	}
+	if in.Manual != nil {
+		out.Manual = new(apiv1beta3.ManualCause)
+		if err := deepCopy_v1beta3_ManualCause(*in.Manual, out.Manual, c); err != nil {
+			return err
+		}
+	} else {
+		out.Manual = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *apiv1beta3.BuildTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.GitHubWebHook != nil {
@@ -961,6 +1172,22 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := deepCopy_v1beta3_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1004,6 +1231,15 @@ func deepCopy_v1beta3_CustomBuildStrategy(in apiv1beta3.CustomBuildStrategy, out
 	return nil
 }
 
+func deepCopy_v1beta3_DeployStage(in apiv1beta3.DeployStage, out *apiv1beta3.DeployStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.DeploymentConfig); err != nil {
+		return err
+	} else {
+		out.DeploymentConfig = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out *apiv1beta3.DockerBuildStrategy, c *conversion.Cloner) error {
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
@@ -1025,12 +1261,10 @@ func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out
 	}
 	out.NoCache = in.NoCache
 	if in.Env != nil {
-		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
+		out.Env = make([]apiv1beta3.BuildEnvVar, len(in.Env))
 		for i := range in.Env {
-			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+			if err := deepCopy_v1beta3_BuildEnvVar(in.Env[i], &out.Env[i], c); err != nil {
 				return err
-			} else {
-				out.Env[i] = newVal.(pkgapiv1beta3.EnvVar)
 			}
 		}
 	} else {
@@ -1040,6 +1274,18 @@ func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out
 	return nil
 }
 
+func deepCopy_v1beta3_GenericWebHookCause(in apiv1beta3.GenericWebHookCause, out *apiv1beta3.GenericWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := deepCopy_v1beta3_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_GitBuildSource(in apiv1beta3.GitBuildSource, out *apiv1beta3.GitBuildSource, c *conversion.Cloner) error {
 	out.URI = in.URI
 	out.Ref = in.Ref
@@ -1048,6 +1294,30 @@ func deepCopy_v1beta3_GitBuildSource(in apiv1beta3.GitBuildSource, out *apiv1bet
 	return nil
 }
 
+func deepCopy_v1beta3_GitHubWebHookCause(in apiv1beta3.GitHubWebHookCause, out *apiv1beta3.GitHubWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := deepCopy_v1beta3_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_GitLabWebHookCause(in apiv1beta3.GitLabWebHookCause, out *apiv1beta3.GitLabWebHookCause, c *conversion.Cloner) error {
+	if in.Revision != nil {
+		out.Revision = new(apiv1beta3.SourceRevision)
+		if err := deepCopy_v1beta3_SourceRevision(*in.Revision, out.Revision, c); err != nil {
+			return err
+		}
+	} else {
+		out.Revision = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_GitSourceRevision(in apiv1beta3.GitSourceRevision, out *apiv1beta3.GitSourceRevision, c *conversion.Cloner) error {
 	out.Commit = in.Commit
 	if err := deepCopy_v1beta3_SourceControlUser(in.Author, &out.Author, c); err != nil {
@@ -1057,6 +1327,21 @@ func deepCopy_v1beta3_GitSourceRevision(in apiv1beta3.GitSourceRevision, out *ap
 		return err
 	}
 	out.Message = in.Message
+	out.PushedRef = in.PushedRef
+	return nil
+}
+
+func deepCopy_v1beta3_ImageChangeCause(in apiv1beta3.ImageChangeCause, out *apiv1beta3.ImageChangeCause, c *conversion.Cloner) error {
+	out.ImageID = in.ImageID
+	if in.FromRef != nil {
+		if newVal, err := c.DeepCopy(in.FromRef); err != nil {
+			return err
+		} else {
+			out.FromRef = newVal.(*pkgapiv1beta3.ObjectReference)
+		}
+	} else {
+		out.FromRef = nil
+	}
 	return nil
 }
 
@@ -1074,12 +1359,22 @@ func deepCopy_v1beta3_ImageChangeTrigger(in apiv1beta3.ImageChangeTrigger, out *
 	return nil
 }
 
-func deepCopy_v1beta3_SourceBuildStrategy(in apiv1beta3.SourceBuildStrategy, out *apiv1beta3.SourceBuildStrategy, c *conversion.Cloner) error {
+func deepCopy_v1beta3_ImageSource(in apiv1beta3.ImageSource, out *apiv1beta3.ImageSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
 	} else {
 		out.From = newVal.(pkgapiv1beta3.ObjectReference)
 	}
+	if in.Paths != nil {
+		out.Paths = make([]apiv1beta3.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := deepCopy_v1beta3_ImageSourcePath(in.Paths[i], &out.Paths[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
 	if in.PullSecret != nil {
 		if newVal, err := c.DeepCopy(in.PullSecret); err != nil {
 			return err
@@ -1089,64 +1384,436 @@ func deepCopy_v1beta3_SourceBuildStrategy(in apiv1beta3.SourceBuildStrategy, out
 	} else {
 		out.PullSecret = nil
 	}
-	if in.Env != nil {
-		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
-		for i := range in.Env {
-			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
-				return err
-			} else {
-				out.Env[i] = newVal.(pkgapiv1beta3.EnvVar)
-			}
-		}
-	} else {
-		out.Env = nil
-	}
-	out.Scripts = in.Scripts
-	out.Incremental = in.Incremental
-	out.ForcePull = in.ForcePull
+	out.LastTriggeredImageID = in.LastTriggeredImageID
 	return nil
 }
 
-func deepCopy_v1beta3_SourceControlUser(in apiv1beta3.SourceControlUser, out *apiv1beta3.SourceControlUser, c *conversion.Cloner) error {
-	out.Name = in.Name
-	out.Email = in.Email
+func deepCopy_v1beta3_ImageSourcePath(in apiv1beta3.ImageSourcePath, out *apiv1beta3.ImageSourcePath, c *conversion.Cloner) error {
+	out.SourcePath = in.SourcePath
+	out.DestinationDir = in.DestinationDir
 	return nil
 }
 
-func deepCopy_v1beta3_SourceRevision(in apiv1beta3.SourceRevision, out *apiv1beta3.SourceRevision, c *conversion.Cloner) error {
-	out.Type = in.Type
-	if in.Git != nil {
-		out.Git = new(apiv1beta3.GitSourceRevision)
-		if err := deepCopy_v1beta3_GitSourceRevision(*in.Git, out.Git, c); err != nil {
-			return err
-		}
-	} else {
-		out.Git = nil
-	}
+func deepCopy_v1beta3_ManualCause(in apiv1beta3.ManualCause, out *apiv1beta3.ManualCause, c *conversion.Cloner) error {
+	out.User = in.User
 	return nil
 }
 
-func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1beta3.WebHookTrigger, c *conversion.Cloner) error {
-	out.Secret = in.Secret
+func deepCopy_v1beta3_Pipeline(in apiv1beta3.Pipeline, out *apiv1beta3.Pipeline, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1beta3.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
+	}
+	if err := deepCopy_v1beta3_PipelineSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1beta3_PipelineStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
 	return nil
 }
 
-func deepCopy_v1beta3_CustomDeploymentStrategyParams(in deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
-	out.Image = in.Image
-	if in.Environment != nil {
-		out.Environment = make([]pkgapiv1beta3.EnvVar, len(in.Environment))
-		for i := range in.Environment {
-			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+func deepCopy_v1beta3_PipelineList(in apiv1beta3.PipelineList, out *apiv1beta3.PipelineList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1beta3.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(pkgapiv1beta3.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]apiv1beta3.Pipeline, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1beta3_Pipeline(in.Items[i], &out.Items[i], c); err != nil {
 				return err
-			} else {
-				out.Environment[i] = newVal.(pkgapiv1beta3.EnvVar)
 			}
 		}
 	} else {
-		out.Environment = nil
+		out.Items = nil
 	}
-	if in.Command != nil {
-		out.Command = make([]string, len(in.Command))
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineSpec(in apiv1beta3.PipelineSpec, out *apiv1beta3.PipelineSpec, c *conversion.Cloner) error {
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1beta3_PipelineStage(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineStage(in apiv1beta3.PipelineStage, out *apiv1beta3.PipelineStage, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	if in.Build != nil {
+		out.Build = new(apiv1beta3.BuildStage)
+		if err := deepCopy_v1beta3_BuildStage(*in.Build, out.Build, c); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Deploy != nil {
+		out.Deploy = new(apiv1beta3.DeployStage)
+		if err := deepCopy_v1beta3_DeployStage(*in.Deploy, out.Deploy, c); err != nil {
+			return err
+		}
+	} else {
+		out.Deploy = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(apiv1beta3.VerifyStage)
+		if err := deepCopy_v1beta3_VerifyStage(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(apiv1beta3.PromoteStage)
+		if err := deepCopy_v1beta3_PromoteStage(*in.Promote, out.Promote, c); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineStageStatus(in apiv1beta3.PipelineStageStatus, out *apiv1beta3.PipelineStageStatus, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.Reference != nil {
+		if newVal, err := c.DeepCopy(in.Reference); err != nil {
+			return err
+		} else {
+			out.Reference = newVal.(*pkgapiv1beta3.ObjectReference)
+		}
+	} else {
+		out.Reference = nil
+	}
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineStatus(in apiv1beta3.PipelineStatus, out *apiv1beta3.PipelineStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*util.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1beta3_PipelineStageStatus(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PromoteStage(in apiv1beta3.PromoteStage, out *apiv1beta3.PromoteStage, c *conversion.Cloner) error {
+	if in.From != nil {
+		if newVal, err := c.DeepCopy(in.From); err != nil {
+			return err
+		} else {
+			out.From = newVal.(*pkgapiv1beta3.ObjectReference)
+		}
+	} else {
+		out.From = nil
+	}
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_SecretBuildSource(in apiv1beta3.SecretBuildSource, out *apiv1beta3.SecretBuildSource, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func deepCopy_v1beta3_SecretKeySelector(in apiv1beta3.SecretKeySelector, out *apiv1beta3.SecretKeySelector, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.LocalObjectReference); err != nil {
+		return err
+	} else {
+		out.LocalObjectReference = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func deepCopy_v1beta3_SourceBuildStrategy(in apiv1beta3.SourceBuildStrategy, out *apiv1beta3.SourceBuildStrategy, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.PullSecret != nil {
+		if newVal, err := c.DeepCopy(in.PullSecret); err != nil {
+			return err
+		} else {
+			out.PullSecret = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.PullSecret = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1beta3.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	out.Scripts = in.Scripts
+	out.Incremental = in.Incremental
+	out.ForcePull = in.ForcePull
+	return nil
+}
+
+func deepCopy_v1beta3_SourceControlUser(in apiv1beta3.SourceControlUser, out *apiv1beta3.SourceControlUser, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+func deepCopy_v1beta3_SourceRevision(in apiv1beta3.SourceRevision, out *apiv1beta3.SourceRevision, c *conversion.Cloner) error {
+	out.Type = in.Type
+	if in.Git != nil {
+		out.Git = new(apiv1beta3.GitSourceRevision)
+		if err := deepCopy_v1beta3_GitSourceRevision(*in.Git, out.Git, c); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_VerifyStage(in apiv1beta3.VerifyStage, out *apiv1beta3.VerifyStage, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1beta3.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1beta3.WebHookTrigger, c *conversion.Cloner) error {
+	out.Secret = in.Secret
+	if in.Refs != nil {
+		out.Refs = make([]string, len(in.Refs))
+		for i := range in.Refs {
+			out.Refs[i] = in.Refs[i]
+		}
+	} else {
+		out.Refs = nil
+	}
+	out.BuildPushedRef = in.BuildPushedRef
+	return nil
+}
+
+func deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(in deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryDeploymentStrategyParams(in deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1beta3.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_v1beta3_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.MaxContainerRestarts != nil {
+		out.MaxContainerRestarts = new(int)
+		*out.MaxContainerRestarts = *in.MaxContainerRestarts
+	} else {
+		out.MaxContainerRestarts = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryStep(in deployapiv1beta3.CanaryStep, out *deployapiv1beta3.CanaryStep, c *conversion.Cloner) error {
+	if in.Percent != nil {
+		out.Percent = new(int)
+		*out.Percent = *in.Percent
+	} else {
+		out.Percent = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = new(int)
+		*out.Replicas = *in.Replicas
+	} else {
+		out.Replicas = nil
+	}
+	if in.PauseSeconds != nil {
+		out.PauseSeconds = new(int64)
+		*out.PauseSeconds = *in.PauseSeconds
+	} else {
+		out.PauseSeconds = nil
+	}
+	out.ManualApproval = in.ManualApproval
+	return nil
+}
+
+func deepCopy_v1beta3_CustomDeploymentStrategyParams(in deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Environment != nil {
+		out.Environment = make([]pkgapiv1beta3.EnvVar, len(in.Environment))
+		for i := range in.Environment {
+			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+				return err
+			} else {
+				out.Environment[i] = newVal.(pkgapiv1beta3.EnvVar)
+			}
+		}
+	} else {
+		out.Environment = nil
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
 		for i := range in.Command {
 			out.Command[i] = in.Command[i]
 		}
@@ -1261,6 +1928,14 @@ func deepCopy_v1beta3_DeploymentConfigSpec(in deployapiv1beta3.DeploymentConfigS
 	} else {
 		out.Triggers = nil
 	}
+	out.Paused = in.Paused
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	out.Replicas = in.Replicas
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
@@ -1312,6 +1987,32 @@ func deepCopy_v1beta3_DeploymentDetails(in deployapiv1beta3.DeploymentDetails, o
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentLog(in deployapiv1beta3.DeploymentLog, out *deployapiv1beta3.DeploymentLog, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1beta3.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(pkgapiv1beta3.ListMeta)
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentLogOptions(in deployapiv1beta3.DeploymentLogOptions, out *deployapiv1beta3.DeploymentLogOptions, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(pkgapiv1beta3.TypeMeta)
+	}
+	out.Follow = in.Follow
+	out.NoWait = in.NoWait
+	out.Version = in.Version
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy, out *deployapiv1beta3.DeploymentStrategy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.CustomParams != nil {
@@ -1338,6 +2039,22 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1beta3.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1beta3.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1400,6 +2117,14 @@ func deepCopy_v1beta3_ExecNewPodHook(in deployapiv1beta3.ExecNewPodHook, out *de
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	if in.Volumes != nil {
+		out.Volumes = make([]string, len(in.Volumes))
+		for i := range in.Volumes {
+			out.Volumes[i] = in.Volumes[i]
+		}
+	} else {
+		out.Volumes = nil
+	}
 	return nil
 }
 
@@ -1413,6 +2138,16 @@ func deepCopy_v1beta3_LifecycleHook(in deployapiv1beta3.LifecycleHook, out *depl
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapiv1beta3.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := deepCopy_v1beta3_TagImageHook(in.TagImages[i], &out.TagImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -1425,6 +2160,14 @@ func deepCopy_v1beta3_RecreateDeploymentStrategyParams(in deployapiv1beta3.Recre
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1461,6 +2204,25 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.MaxUnavailable != nil {
+		if newVal, err := c.DeepCopy(in.MaxUnavailable); err != nil {
+			return err
+		} else {
+			out.MaxUnavailable = newVal.(*util.IntOrString)
+		}
+	} else {
+		out.MaxUnavailable = nil
+	}
+	if in.MaxSurge != nil {
+		if newVal, err := c.DeepCopy(in.MaxSurge); err != nil {
+			return err
+		} else {
+			out.MaxSurge = newVal.(*util.IntOrString)
+		}
+	} else {
+		out.MaxSurge = nil
+	}
+	out.MinReadySeconds = in.MinReadySeconds
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1469,6 +2231,14 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1480,6 +2250,16 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	return nil
 }
 
+func deepCopy_v1beta3_TagImageHook(in deployapiv1beta3.TagImageHook, out *deployapiv1beta3.TagImageHook, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_Image(in imageapiv1beta3.Image, out *imageapiv1beta3.Image, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2442,30 +3222,58 @@ func init() {
 		deepCopy_v1beta3_RoleList,
 		deepCopy_v1beta3_SubjectAccessReview,
 		deepCopy_v1beta3_SubjectAccessReviewResponse,
+		deepCopy_v1beta3_BinaryBuildSource,
+		deepCopy_v1beta3_BitbucketWebHookCause,
 		deepCopy_v1beta3_Build,
 		deepCopy_v1beta3_BuildConfig,
 		deepCopy_v1beta3_BuildConfigList,
 		deepCopy_v1beta3_BuildConfigSpec,
 		deepCopy_v1beta3_BuildConfigStatus,
+		deepCopy_v1beta3_BuildEnvVar,
+		deepCopy_v1beta3_BuildEnvVarSource,
 		deepCopy_v1beta3_BuildList,
 		deepCopy_v1beta3_BuildLog,
 		deepCopy_v1beta3_BuildLogOptions,
 		deepCopy_v1beta3_BuildOutput,
+		deepCopy_v1beta3_BuildPostCommitSpec,
 		deepCopy_v1beta3_BuildRequest,
 		deepCopy_v1beta3_BuildSource,
 		deepCopy_v1beta3_BuildSpec,
+		deepCopy_v1beta3_BuildStage,
 		deepCopy_v1beta3_BuildStatus,
 		deepCopy_v1beta3_BuildStrategy,
+		deepCopy_v1beta3_BuildTriggerCause,
 		deepCopy_v1beta3_BuildTriggerPolicy,
 		deepCopy_v1beta3_CustomBuildStrategy,
+		deepCopy_v1beta3_DeployStage,
 		deepCopy_v1beta3_DockerBuildStrategy,
+		deepCopy_v1beta3_GenericWebHookCause,
 		deepCopy_v1beta3_GitBuildSource,
+		deepCopy_v1beta3_GitHubWebHookCause,
+		deepCopy_v1beta3_GitLabWebHookCause,
 		deepCopy_v1beta3_GitSourceRevision,
+		deepCopy_v1beta3_ImageChangeCause,
 		deepCopy_v1beta3_ImageChangeTrigger,
+		deepCopy_v1beta3_ImageSource,
+		deepCopy_v1beta3_ImageSourcePath,
+		deepCopy_v1beta3_ManualCause,
+		deepCopy_v1beta3_Pipeline,
+		deepCopy_v1beta3_PipelineList,
+		deepCopy_v1beta3_PipelineSpec,
+		deepCopy_v1beta3_PipelineStage,
+		deepCopy_v1beta3_PipelineStageStatus,
+		deepCopy_v1beta3_PipelineStatus,
+		deepCopy_v1beta3_PromoteStage,
+		deepCopy_v1beta3_SecretBuildSource,
+		deepCopy_v1beta3_SecretKeySelector,
 		deepCopy_v1beta3_SourceBuildStrategy,
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
+		deepCopy_v1beta3_VerifyStage,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_BlueGreenDeploymentStrategyParams,
+		deepCopy_v1beta3_CanaryDeploymentStrategyParams,
+		deepCopy_v1beta3_CanaryStep,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
@@ -2476,6 +3284,8 @@ func init() {
 		deepCopy_v1beta3_DeploymentConfigSpec,
 		deepCopy_v1beta3_DeploymentConfigStatus,
 		deepCopy_v1beta3_DeploymentDetails,
+		deepCopy_v1beta3_DeploymentLog,
+		deepCopy_v1beta3_DeploymentLogOptions,
 		deepCopy_v1beta3_DeploymentStrategy,
 		deepCopy_v1beta3_DeploymentTriggerImageChangeParams,
 		deepCopy_v1beta3_DeploymentTriggerPolicy,
@@ -2483,6 +3293,7 @@ func init() {
 		deepCopy_v1beta3_LifecycleHook,
 		deepCopy_v1beta3_RecreateDeploymentStrategyParams,
 		deepCopy_v1beta3_RollingDeploymentStrategyParams,
+		deepCopy_v1beta3_TagImageHook,
 		deepCopy_v1beta3_Image,
 		deepCopy_v1beta3_ImageList,
 		deepCopy_v1beta3_ImageStream,
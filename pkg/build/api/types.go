@@ -16,6 +16,12 @@ const (
 	BuildNumberAnnotation = "openshift.io/build.number"
 	// BuildLabel is the key of a Pod label whose value is the Name of a Build which is run.
 	BuildLabel = "openshift.io/build.name"
+	// BuildRunPolicyAnnotation is an annotation whose value is the RunPolicy of the
+	// BuildConfig the Build was created from.
+	BuildRunPolicyAnnotation = "openshift.io/build.run-policy"
+	// PipelineLabel is the key of a Pod label whose value is the Name of the Pipeline
+	// which runs the Pod.
+	PipelineLabel = "openshift.io/pipeline.name"
 )
 
 // Build encapsulates the inputs needed to produce a new deployable image, as well as
@@ -53,6 +59,16 @@ type BuildSpec struct {
 
 	// Compute resource requirements to execute the build
 	Resources kapi.ResourceRequirements
+
+	// PostCommit is a build hook executed in a container from the freshly built
+	// image, before it is pushed. A non-zero exit of the hook fails the build.
+	PostCommit BuildPostCommitSpec
+
+	// CompletionDeadlineSeconds is an optional duration in seconds, counted from
+	// the time when a build pod gets scheduled in the system, that the build may
+	// be active on a node before the system actively tries to terminate the build;
+	// value must be a positive integer.
+	CompletionDeadlineSeconds *int64
 }
 
 // BuildStatus contains the status of a build
@@ -63,6 +79,10 @@ type BuildStatus struct {
 	// Cancelled describes if a cancelling event was triggered for the build.
 	Cancelled bool
 
+	// Reason is a brief CamelCase string that describes any failure and is meant
+	// for machine parsing and tidy display in the CLI.
+	Reason StatusReason
+
 	// Message is a human-readable message indicating details about why the build has this status
 	Message string
 
@@ -82,6 +102,9 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference
+
+	// TriggeredBy describes which triggers started the Build.
+	TriggeredBy []BuildTriggerCause
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -112,6 +135,55 @@ const (
 	BuildPhaseCancelled BuildPhase = "Cancelled"
 )
 
+// StatusReason is a brief CamelCase string that describes a temporary or
+// permanent build error condition, meant for machine parsing and tidy display
+// in the CLI.
+type StatusReason string
+
+// Valid values for StatusReason.
+const (
+	// StatusReasonCannotCreateBuildPod indicates that the build controller
+	// could not create the pod that runs the build, for example because the
+	// output image stream does not exist.
+	StatusReasonCannotCreateBuildPod StatusReason = "CannotCreateBuildPod"
+
+	// StatusReasonFetchSourceFailed indicates that the source of the build
+	// could not be retrieved.
+	StatusReasonFetchSourceFailed StatusReason = "FetchSourceFailed"
+
+	// StatusReasonPullBuilderImageFailed indicates that the builder image could
+	// not be pulled.
+	StatusReasonPullBuilderImageFailed StatusReason = "PullBuilderImageFailed"
+
+	// StatusReasonAssembleFailed indicates that assembling the output image
+	// failed, for example because the assemble script or a Dockerfile
+	// instruction exited with an error.
+	StatusReasonAssembleFailed StatusReason = "AssembleFailed"
+
+	// StatusReasonPostCommitHookFailed indicates that the post commit hook
+	// exited with an error.
+	StatusReasonPostCommitHookFailed StatusReason = "PostCommitHookFailed"
+
+	// StatusReasonPushImageFailed indicates that the output image could not be
+	// pushed to the registry.
+	StatusReasonPushImageFailed StatusReason = "PushImageFailed"
+
+	// StatusReasonBuildPodDeleted indicates that the pod running the build was
+	// deleted before the build completed.
+	StatusReasonBuildPodDeleted StatusReason = "BuildPodDeleted"
+
+	// StatusReasonCancelled indicates that the build was cancelled.
+	StatusReasonCancelled StatusReason = "Cancelled"
+
+	// StatusReasonTimeout indicates that the build exceeded its completion
+	// deadline and was terminated.
+	StatusReasonTimeout StatusReason = "Timeout"
+
+	// StatusReasonGenericBuildFailed indicates that the build failed for a
+	// reason the builder did not report.
+	StatusReasonGenericBuildFailed StatusReason = "GenericBuildFailed"
+)
+
 // BuildSourceType is the type of SCM used
 type BuildSourceType string
 
@@ -119,6 +191,16 @@ type BuildSourceType string
 const (
 	//BuildSourceGit is a Git SCM
 	BuildSourceGit BuildSourceType = "Git"
+
+	// BuildSourceBinary indicates the build will accept a binary file as input.
+	BuildSourceBinary BuildSourceType = "Binary"
+
+	// BuildSourceDockerfile indicates the build uses a Dockerfile defined inline in the
+	// build source instead of one from a repository.
+	BuildSourceDockerfile BuildSourceType = "Dockerfile"
+
+	// BuildSourceImage indicates the build takes its source only from other images.
+	BuildSourceImage BuildSourceType = "Image"
 )
 
 // BuildSource is the SCM used for the build
@@ -129,6 +211,21 @@ type BuildSource struct {
 	// Git contains optional information about git build source
 	Git *GitBuildSource
 
+	// Binary contains optional information about a binary build source. The binary input
+	// (a directory, a single file or an archive) is provided by the client when the build
+	// is started and is streamed into the build pod instead of being cloned from an SCM.
+	Binary *BinaryBuildSource
+
+	// Dockerfile is the raw contents of a Dockerfile which should be built. When this option is
+	// specified with a Git source, the Dockerfile replaces the one in the context dir of the
+	// repository. When used alone, it is written into an otherwise empty build context.
+	Dockerfile *string
+
+	// Images describes a set of images and the paths within them that are copied into
+	// the build directory before the build runs. A BuildConfig is rebuilt whenever an
+	// ImageStreamTag referenced here is updated.
+	Images []ImageSource
+
 	// ContextDir specifies the sub-directory where the source code for the application exists.
 	// This allows to have buildable sources in directory other than root of
 	// repository.
@@ -140,6 +237,66 @@ type BuildSource struct {
 	// data's key represent the authentication method to be used and value is
 	// the base64 encoded credentials. Supported auth methods are: ssh-privatekey.
 	SourceSecret *kapi.LocalObjectReference
+
+	// Secrets is a list of secrets whose files are copied into the build directory
+	// before the build runs and kept out of every layer of the built image. They allow
+	// the build to use credentials, e.g. for private Maven or npm repositories,
+	// without baking them into the output image.
+	Secrets []SecretBuildSource
+}
+
+// BinaryBuildSource describes a binary input that is provided when a build is started.
+type BinaryBuildSource struct {
+	// AsFile indicates that the provided binary input should be considered a single file
+	// within the build input. For example, specifying "webapp.war" would place the provided
+	// binary as "webapp.war" in the root of the build context. If left empty, the input is
+	// treated as an archive and its contents are extracted as the build context.
+	AsFile string
+}
+
+// ImageSource describes an image and the paths within it that are used as source for
+// the build.
+type ImageSource struct {
+	// From is a reference to an ImageStreamTag, ImageStreamImage, or DockerImage to
+	// copy source from.
+	From kapi.ObjectReference
+
+	// Paths is a list of source and destination paths to copy from the image.
+	Paths []ImageSourcePath
+
+	// PullSecret is a reference to a secret to be used to pull the image from a registry.
+	// If the image is pulled from the OpenShift registry, this field does not need to be set.
+	PullSecret *kapi.LocalObjectReference
+
+	// LastTriggeredImageID is used internally by the ImageChangeController to save the
+	// last image of an ImageStreamTag From used for a build of the BuildConfig.
+	LastTriggeredImageID string
+}
+
+// ImageSourcePath describes a path to be copied from a source image and its destination
+// within the build directory.
+type ImageSourcePath struct {
+	// SourcePath is the absolute path of the file or directory inside the image to copy
+	// to the build directory. If the path ends in "/." the contents of the directory are
+	// copied instead of the directory itself.
+	SourcePath string
+
+	// DestinationDir is the directory, relative to the root of the build directory, where
+	// the files copied from the image are placed.
+	DestinationDir string
+}
+
+// SecretBuildSource describes a secret and the directory where its files are placed
+// for the build.
+type SecretBuildSource struct {
+	// Secret is a reference to an existing secret in the namespace of the build.
+	Secret kapi.LocalObjectReference
+
+	// DestinationDir is the directory, relative to the context dir of the build, where
+	// the files of the secret are placed. The built image is squashed into a single
+	// layer without the copies of the files of the secret, wherever the build copied
+	// the build directory to.
+	DestinationDir string
 }
 
 // SourceRevision is the revision or commit information from the source for the build
@@ -164,6 +321,11 @@ type GitSourceRevision struct {
 
 	// Message is the description of a specific commit
 	Message string
+
+	// PushedRef is the branch or tag the commit was pushed to when it differs
+	// from the ref configured in the build source. The build checks out this
+	// ref instead of the configured one.
+	PushedRef string
 }
 
 // GitBuildSource defines the parameters of a Git SCM
@@ -268,13 +430,44 @@ type DockerBuildStrategy struct {
 	// --no-cache=true flag
 	NoCache bool
 
-	// Env contains additional environment variables you want to pass into a builder container
-	Env []kapi.EnvVar
+	// Env contains additional environment variables you want to pass into a builder container.
+	// The variables are also added to the Dockerfile as ENV instructions right after its
+	// FROM instruction, so they are visible to all the instructions of the build.
+	Env []BuildEnvVar
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
 	ForcePull bool
 }
 
+// BuildEnvVar represents an environment variable of a build. Its value is either
+// given literally or resolved from a key of a secret when the build runs.
+type BuildEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string
+
+	// Value is the literal value of the environment variable.
+	Value string
+
+	// ValueFrom is the source of the value of the environment variable. It cannot be
+	// used if Value is not empty.
+	ValueFrom *BuildEnvVarSource
+}
+
+// BuildEnvVarSource represents the source of the value of a build environment variable.
+type BuildEnvVarSource struct {
+	// SecretKeyRef selects a key of a secret in the namespace of the build.
+	SecretKeyRef *SecretKeySelector
+}
+
+// SecretKeySelector selects a key of a secret.
+type SecretKeySelector struct {
+	// The name of the secret in the namespace of the build.
+	kapi.LocalObjectReference
+
+	// Key is the key of the secret to select.
+	Key string
+}
+
 // SourceBuildStrategy defines input parameters specific to an Source build.
 type SourceBuildStrategy struct {
 	// From is reference to an DockerImage, ImageStream, ImageStreamTag, or ImageStreamImage from which
@@ -315,6 +508,30 @@ type BuildOutput struct {
 	PushSecret *kapi.LocalObjectReference
 }
 
+// BuildPostCommitSpec holds a build post commit hook specification. The hook
+// executes a command in a temporary container running the build output image,
+// immediately after the last layer of the image is committed and before the
+// image is pushed to a registry. The build fails if the command exits with a
+// non-zero status, and the image is not pushed.
+//
+// When Script is set, it is executed with "/bin/sh -ic" and Args, if any, are
+// passed to the script as positional parameters. When Command is set, it
+// replaces the image entrypoint and Args are passed to it. When only Args is
+// set, the Args are passed to the default entrypoint of the image.
+// Script and Command are mutually exclusive.
+type BuildPostCommitSpec struct {
+	// Command is the command to run. It may not be specified with Script.
+	Command []string
+
+	// Args is a list of arguments that are provided to either Command, Script or
+	// the Docker image's default entrypoint.
+	Args []string
+
+	// Script is a shell script to be run with `/bin/sh -ic`. It may not be
+	// specified with Command.
+	Script string
+}
+
 // BuildConfigLabel is the key of a Build label whose value is the ID of a BuildConfig
 // on which the Build is based.
 const BuildConfigLabel = "buildconfig"
@@ -339,8 +556,42 @@ type BuildConfigSpec struct {
 
 	// BuildSpec is the desired build specification
 	BuildSpec
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to
+	// retain. Older successful builds of the BuildConfig are deleted, with their
+	// pods, when a build finishes. If nil, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int
+
+	// FailedBuildsHistoryLimit is the number of old failed, errored and cancelled
+	// builds to retain. Older ones are deleted, with their pods, when a build
+	// finishes. If nil, all failed builds are retained.
+	FailedBuildsHistoryLimit *int
+
+	// RunPolicy describes how new builds created from this BuildConfig are
+	// scheduled relative to the builds that are already running. If empty,
+	// builds run in parallel.
+	RunPolicy BuildRunPolicy
 }
 
+// BuildRunPolicy defines the behaviour of how new builds are started.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel starts new builds immediately after they are
+	// created. Builds are executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial queues new builds in the New phase until the
+	// previous build of the BuildConfig completes. Builds run one at a time
+	// in the order they were created.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly runs builds one at a time like Serial,
+	// but cancels queued builds that have not started yet whenever a newer
+	// build is created, so only the latest queued build runs.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -351,6 +602,15 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string
+
+	// Refs is a list of glob patterns matched against the names of the pushed
+	// branches and tags, e.g. "feature/*" or "v*". When empty, only pushes to
+	// the ref configured in the build source trigger a build.
+	Refs []string
+
+	// BuildPushedRef builds the pushed branch or tag instead of the ref
+	// configured in the build source.
+	BuildPushedRef bool
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -377,6 +637,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger
 }
@@ -395,6 +661,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
@@ -466,6 +740,10 @@ type BuildRequest struct {
 	// TriggeredByImage is the Image that triggered this build.
 	TriggeredByImage *kapi.ObjectReference
 
+	// Binary indicates that the build will be provided with binary input when it is started.
+	// The source of the resulting build is replaced by this binary source.
+	Binary *BinaryBuildSource
+
 	// From is the reference to the ImageStreamTag that triggered the build.
 	From *kapi.ObjectReference
 
@@ -473,8 +751,97 @@ type BuildRequest struct {
 	// to generate the build. If the BuildConfig in the generator doesn't match, a build will
 	// not be generated.
 	LastVersion *int
+
+	// TriggeredBy describes which triggers started the Build. It may only be set by
+	// internal clients; otherwise the generator records that the Build was started
+	// manually by the requesting user.
+	TriggeredBy []BuildTriggerCause
+}
+
+// BuildTriggerCause holds information about why a Build was started. Exactly
+// one of the cause specific fields is set, except for a change of the
+// BuildConfig which is only described by the Message.
+type BuildTriggerCause struct {
+	// Message is a human readable description of the cause.
+	Message string
+
+	// GenericWebHook holds the data of a Build started by a generic webhook.
+	GenericWebHook *GenericWebHookCause
+
+	// GitHubWebHook holds the data of a Build started by a GitHub webhook.
+	GitHubWebHook *GitHubWebHookCause
+
+	// GitLabWebHook holds the data of a Build started by a GitLab webhook.
+	GitLabWebHook *GitLabWebHookCause
+
+	// BitbucketWebHook holds the data of a Build started by a Bitbucket webhook.
+	BitbucketWebHook *BitbucketWebHookCause
+
+	// ImageChangeBuild holds the data of a Build started by a new image.
+	ImageChangeBuild *ImageChangeCause
+
+	// Manual holds the data of a Build started on request of a user.
+	Manual *ManualCause
+}
+
+// GenericWebHookCause holds information about a generic webhook that started a Build.
+type GenericWebHookCause struct {
+	// Revision is the source revision sent with the webhook, if any.
+	Revision *SourceRevision
+}
+
+// GitHubWebHookCause holds information about a GitHub webhook that started a Build.
+type GitHubWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision
+}
+
+// GitLabWebHookCause holds information about a GitLab webhook that started a Build.
+type GitLabWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision
 }
 
+// BitbucketWebHookCause holds information about a Bitbucket webhook that started a Build.
+type BitbucketWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision
+}
+
+// ImageChangeCause holds information about the image that started a Build.
+type ImageChangeCause struct {
+	// ImageID is the pull spec of the new image.
+	ImageID string
+
+	// FromRef is the ImageStreamTag the new image was tagged into.
+	FromRef *kapi.ObjectReference
+}
+
+// ManualCause holds information about the user who started a Build.
+type ManualCause struct {
+	// User is the name of the user who requested the Build.
+	User string
+}
+
+const (
+	// BuildTriggerCauseManualMsg is the message of a Build started on request of a user.
+	BuildTriggerCauseManualMsg = "Manually triggered"
+	// BuildTriggerCauseConfigMsg is the message of a Build started by a change of its BuildConfig.
+	BuildTriggerCauseConfigMsg = "Build configuration change"
+	// BuildTriggerCauseImageMsg is the message of a Build started by a new image.
+	BuildTriggerCauseImageMsg = "Image change"
+	// BuildTriggerCauseGithubMsg is the message of a Build started by a GitHub webhook.
+	BuildTriggerCauseGithubMsg = "GitHub WebHook"
+	// BuildTriggerCauseGenericMsg is the message of a Build started by a generic webhook.
+	BuildTriggerCauseGenericMsg = "Generic WebHook"
+	// BuildTriggerCauseGitLabMsg is the message of a Build started by a GitLab webhook.
+	BuildTriggerCauseGitLabMsg = "GitLab WebHook"
+	// BuildTriggerCauseBitbucketMsg is the message of a Build started by a Bitbucket webhook.
+	BuildTriggerCauseBitbucketMsg = "Bitbucket WebHook"
+	// BuildTriggerCausePipelineMsg is the message of a Build started by a Pipeline.
+	BuildTriggerCausePipelineMsg = "Pipeline"
+)
+
 // BuildLogOptions is the REST options for a build log
 type BuildLogOptions struct {
 	kapi.TypeMeta
@@ -487,3 +854,177 @@ type BuildLogOptions struct {
 	// is not available yet. Otherwise the server will wait until the build has started.
 	NoWait bool
 }
+
+// Pipeline runs an ordered list of stages across BuildConfigs and DeploymentConfigs.
+// A stage starts once the stage before it completed; when a stage fails, the
+// stages after it are skipped, so a promotion only happens if everything before
+// it succeeded.
+type Pipeline struct {
+	kapi.TypeMeta
+	kapi.ObjectMeta
+
+	// Spec describes the stages of the pipeline.
+	Spec PipelineSpec
+
+	// Status is the current state of the pipeline and of each of its stages.
+	Status PipelineStatus
+}
+
+// PipelineSpec describes the stages of a pipeline.
+type PipelineSpec struct {
+	// Stages are run in order.
+	Stages []PipelineStage
+}
+
+// PipelineStageType is the type of a pipeline stage.
+type PipelineStageType string
+
+const (
+	// PipelineStageBuild starts a Build from a BuildConfig and waits for it to complete.
+	PipelineStageBuild PipelineStageType = "Build"
+	// PipelineStageDeploy waits for a new deployment of a DeploymentConfig to complete.
+	PipelineStageDeploy PipelineStageType = "Deploy"
+	// PipelineStageVerify runs a pod and waits for it to succeed.
+	PipelineStageVerify PipelineStageType = "Verify"
+	// PipelineStagePromote tags an image into an ImageStreamTag.
+	PipelineStagePromote PipelineStageType = "Promote"
+)
+
+// PipelineStage is a step of a pipeline. Exactly the field matching its Type must be set.
+type PipelineStage struct {
+	// Name identifies the stage within the pipeline.
+	Name string
+
+	// Type is the type of the stage.
+	Type PipelineStageType
+
+	// Build holds the parameters of a Build stage.
+	Build *BuildStage
+
+	// Deploy holds the parameters of a Deploy stage.
+	Deploy *DeployStage
+
+	// Verify holds the parameters of a Verify stage.
+	Verify *VerifyStage
+
+	// Promote holds the parameters of a Promote stage.
+	Promote *PromoteStage
+}
+
+// BuildStage starts a Build from a BuildConfig.
+type BuildStage struct {
+	// BuildConfig is the BuildConfig to start a Build from, in the namespace of the pipeline.
+	BuildConfig kapi.LocalObjectReference
+}
+
+// DeployStage waits for a new deployment of a DeploymentConfig. If the image built by
+// an earlier Build stage triggers the DeploymentConfig automatically, the stage waits
+// for that deployment. Otherwise it starts a new deployment.
+type DeployStage struct {
+	// DeploymentConfig is the DeploymentConfig to deploy, in the namespace of the pipeline.
+	DeploymentConfig kapi.LocalObjectReference
+}
+
+// VerifyStage runs a pod which must succeed for the pipeline to continue.
+type VerifyStage struct {
+	// Image is the image to run.
+	Image string
+
+	// Command is the command to run, overriding the entrypoint of the image.
+	Command []string
+
+	// Env contains the environment of the command.
+	Env []kapi.EnvVar
+}
+
+// PromoteStage tags an image into an ImageStreamTag.
+type PromoteStage struct {
+	// From is the ImageStreamTag whose current image is promoted. If it is not set, the
+	// output of the closest earlier Build stage is promoted. It must be in the namespace
+	// of the pipeline.
+	From *kapi.ObjectReference
+
+	// To is the ImageStreamTag the image is tagged into. It must be in the namespace of
+	// the pipeline.
+	To kapi.ObjectReference
+}
+
+// PipelinePhase represents the state of a pipeline.
+type PipelinePhase string
+
+const (
+	// PipelinePhaseNew is the state of a pipeline which has not started yet.
+	PipelinePhaseNew PipelinePhase = "New"
+	// PipelinePhaseRunning is the state of a pipeline running its stages.
+	PipelinePhaseRunning PipelinePhase = "Running"
+	// PipelinePhaseComplete is the state of a pipeline all stages of which completed.
+	PipelinePhaseComplete PipelinePhase = "Complete"
+	// PipelinePhaseFailed is the state of a pipeline one stage of which failed.
+	PipelinePhaseFailed PipelinePhase = "Failed"
+)
+
+// PipelineStagePhase represents the state of a pipeline stage.
+type PipelineStagePhase string
+
+const (
+	// PipelineStagePhasePending is the state of a stage waiting for the stages before it.
+	PipelineStagePhasePending PipelineStagePhase = "Pending"
+	// PipelineStagePhaseRunning is the state of a stage waiting for what it started.
+	PipelineStagePhaseRunning PipelineStagePhase = "Running"
+	// PipelineStagePhaseComplete is the state of a stage which succeeded.
+	PipelineStagePhaseComplete PipelineStagePhase = "Complete"
+	// PipelineStagePhaseFailed is the state of a stage which failed.
+	PipelineStagePhaseFailed PipelineStagePhase = "Failed"
+	// PipelineStagePhaseSkipped is the state of a stage which was not run because an
+	// earlier stage failed.
+	PipelineStagePhaseSkipped PipelineStagePhase = "Skipped"
+)
+
+// PipelineStatus is the current state of a pipeline.
+type PipelineStatus struct {
+	// Phase is the state of the pipeline.
+	Phase PipelinePhase
+
+	// Message describes why the pipeline failed.
+	Message string
+
+	// StartTimestamp is the time the pipeline started.
+	StartTimestamp *util.Time
+
+	// CompletionTimestamp is the time the pipeline completed or failed.
+	CompletionTimestamp *util.Time
+
+	// Stages holds the state of each stage, in the order of the stages of the spec.
+	Stages []PipelineStageStatus
+}
+
+// PipelineStageStatus is the current state of a pipeline stage.
+type PipelineStageStatus struct {
+	// Name is the name of the stage.
+	Name string
+
+	// Phase is the state of the stage.
+	Phase PipelineStagePhase
+
+	// Message describes the outcome of the stage.
+	Message string
+
+	// Reference is the object the stage started or waits for: a Build, the
+	// ReplicationController of a deployment or a Pod.
+	Reference *kapi.ObjectReference
+
+	// StartTimestamp is the time the stage started.
+	StartTimestamp *util.Time
+
+	// CompletionTimestamp is the time the stage completed, failed or was skipped.
+	CompletionTimestamp *util.Time
+}
+
+// PipelineList is a collection of Pipelines.
+type PipelineList struct {
+	kapi.TypeMeta
+	kapi.ListMeta
+
+	// Items is a list of pipelines
+	Items []Pipeline
+}
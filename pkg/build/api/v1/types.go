@@ -42,6 +42,16 @@ type BuildSpec struct {
 
 	// Compute resource requirements to execute the build
 	Resources kapi.ResourceRequirements `json:"resources,omitempty" description:"the desired compute resources the build should have"`
+
+	// PostCommit is a build hook executed in a container from the freshly built
+	// image, before it is pushed. A non-zero exit of the hook fails the build.
+	PostCommit BuildPostCommitSpec `json:"postCommit,omitempty" description:"a hook executed in a container from the built image before it is pushed; the build fails if the hook exits with a non-zero status"`
+
+	// CompletionDeadlineSeconds is an optional duration in seconds, counted from
+	// the time when a build pod gets scheduled in the system, that the build may
+	// be active on a node before the system actively tries to terminate the build;
+	// value must be a positive integer.
+	CompletionDeadlineSeconds *int64 `json:"completionDeadlineSeconds,omitempty" description:"optional duration in seconds, counted from the time the build pod is started, that the build may be active on a node before the system actively tries to terminate it; value must be a positive integer"`
 }
 
 // BuildStatus contains the status of a build
@@ -52,6 +62,10 @@ type BuildStatus struct {
 	// Cancelled describes if a cancelling event was triggered for the build.
 	Cancelled bool `json:"cancelled,omitempty" description:"describes if a canceling event was triggered for the build"`
 
+	// Reason is a brief CamelCase string that describes any failure and is meant
+	// for machine parsing and tidy display in the CLI.
+	Reason StatusReason `json:"reason,omitempty" description:"brief CamelCase string that describes any failure and is meant for machine parsing and tidy display in the CLI"`
+
 	// Message is a human-readable message indicating details about why the build has this status
 	Message string `json:"message,omitempty" description:"human-readable message indicating details about why the build has this status"`
 
@@ -71,6 +85,9 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference `json:"config,omitempty" description:"reference to build config from which this build was derived"`
+
+	// TriggeredBy describes which triggers started the Build.
+	TriggeredBy []BuildTriggerCause `json:"triggeredBy,omitempty" description:"describes which triggers started the build"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -101,6 +118,55 @@ const (
 	BuildPhaseCancelled BuildPhase = "Cancelled"
 )
 
+// StatusReason is a brief CamelCase string that describes a temporary or
+// permanent build error condition, meant for machine parsing and tidy display
+// in the CLI.
+type StatusReason string
+
+// Valid values for StatusReason.
+const (
+	// StatusReasonCannotCreateBuildPod indicates that the build controller
+	// could not create the pod that runs the build, for example because the
+	// output image stream does not exist.
+	StatusReasonCannotCreateBuildPod StatusReason = "CannotCreateBuildPod"
+
+	// StatusReasonFetchSourceFailed indicates that the source of the build
+	// could not be retrieved.
+	StatusReasonFetchSourceFailed StatusReason = "FetchSourceFailed"
+
+	// StatusReasonPullBuilderImageFailed indicates that the builder image could
+	// not be pulled.
+	StatusReasonPullBuilderImageFailed StatusReason = "PullBuilderImageFailed"
+
+	// StatusReasonAssembleFailed indicates that assembling the output image
+	// failed, for example because the assemble script or a Dockerfile
+	// instruction exited with an error.
+	StatusReasonAssembleFailed StatusReason = "AssembleFailed"
+
+	// StatusReasonPostCommitHookFailed indicates that the post commit hook
+	// exited with an error.
+	StatusReasonPostCommitHookFailed StatusReason = "PostCommitHookFailed"
+
+	// StatusReasonPushImageFailed indicates that the output image could not be
+	// pushed to the registry.
+	StatusReasonPushImageFailed StatusReason = "PushImageFailed"
+
+	// StatusReasonBuildPodDeleted indicates that the pod running the build was
+	// deleted before the build completed.
+	StatusReasonBuildPodDeleted StatusReason = "BuildPodDeleted"
+
+	// StatusReasonCancelled indicates that the build was cancelled.
+	StatusReasonCancelled StatusReason = "Cancelled"
+
+	// StatusReasonTimeout indicates that the build exceeded its completion
+	// deadline and was terminated.
+	StatusReasonTimeout StatusReason = "Timeout"
+
+	// StatusReasonGenericBuildFailed indicates that the build failed for a
+	// reason the builder did not report.
+	StatusReasonGenericBuildFailed StatusReason = "GenericBuildFailed"
+)
+
 // BuildSourceType is the type of SCM used
 type BuildSourceType string
 
@@ -108,6 +174,16 @@ type BuildSourceType string
 const (
 	//BuildSourceGit is a Git SCM
 	BuildSourceGit BuildSourceType = "Git"
+
+	// BuildSourceBinary indicates the build will accept a binary file as input.
+	BuildSourceBinary BuildSourceType = "Binary"
+
+	// BuildSourceDockerfile indicates the build uses a Dockerfile defined inline in the
+	// build source instead of one from a repository.
+	BuildSourceDockerfile BuildSourceType = "Dockerfile"
+
+	// BuildSourceImage indicates the build takes its source only from other images.
+	BuildSourceImage BuildSourceType = "Image"
 )
 
 // BuildSource is the SCM used for the build
@@ -118,6 +194,21 @@ type BuildSource struct {
 	// Git contains optional information about git build source
 	Git *GitBuildSource `json:"git,omitempty" description:"optional information about git build source"`
 
+	// Binary contains optional information about a binary build source. The binary input
+	// (a directory, a single file or an archive) is provided by the client when the build
+	// is started and is streamed into the build pod instead of being cloned from an SCM.
+	Binary *BinaryBuildSource `json:"binary,omitempty" description:"optional information about a binary build source provided when the build is started"`
+
+	// Dockerfile is the raw contents of a Dockerfile which should be built. When this option is
+	// specified with a Git source, the Dockerfile replaces the one in the context dir of the
+	// repository. When used alone, it is written into an otherwise empty build context.
+	Dockerfile *string `json:"dockerfile,omitempty" description:"the contents of a Dockerfile to build; replaces any Dockerfile in the source repository"`
+
+	// Images describes a set of images and the paths within them that are copied into
+	// the build directory before the build runs. A BuildConfig is rebuilt whenever an
+	// ImageStreamTag referenced here is updated.
+	Images []ImageSource `json:"images,omitempty" description:"a set of images and paths within them to copy into the build directory"`
+
 	// ContextDir specifies the sub-directory where the source code for the application exists.
 	// This allows to have buildable sources in directory other than root of
 	// repository.
@@ -129,6 +220,66 @@ type BuildSource struct {
 	// data's key represent the authentication method to be used and value is
 	// the base64 encoded credentials. Supported auth methods are: ssh-privatekey.
 	SourceSecret *kapi.LocalObjectReference `json:"sourceSecret,omitempty" description:"supported auth methods are: ssh-privatekey"`
+
+	// Secrets is a list of secrets whose files are copied into the build directory
+	// before the build runs and kept out of every layer of the built image. They allow
+	// the build to use credentials, e.g. for private Maven or npm repositories,
+	// without baking them into the output image.
+	Secrets []SecretBuildSource `json:"secrets,omitempty" description:"list of secrets whose files are made available to the build; the built image is squashed into a single layer without them"`
+}
+
+// BinaryBuildSource describes a binary input that is provided when a build is started.
+type BinaryBuildSource struct {
+	// AsFile indicates that the provided binary input should be considered a single file
+	// within the build input. For example, specifying "webapp.war" would place the provided
+	// binary as "webapp.war" in the root of the build context. If left empty, the input is
+	// treated as an archive and its contents are extracted as the build context.
+	AsFile string `json:"asFile,omitempty" description:"if set, the binary input is treated as a single file with this name instead of an archive"`
+}
+
+// ImageSource describes an image and the paths within it that are used as source for
+// the build.
+type ImageSource struct {
+	// From is a reference to an ImageStreamTag, ImageStreamImage, or DockerImage to
+	// copy source from.
+	From kapi.ObjectReference `json:"from" description:"reference to an ImageStreamTag, ImageStreamImage, or DockerImage to copy source from"`
+
+	// Paths is a list of source and destination paths to copy from the image.
+	Paths []ImageSourcePath `json:"paths" description:"paths to copy from the image"`
+
+	// PullSecret is a reference to a secret to be used to pull the image from a registry.
+	// If the image is pulled from the OpenShift registry, this field does not need to be set.
+	PullSecret *kapi.LocalObjectReference `json:"pullSecret,omitempty" description:"supported type: dockercfg"`
+
+	// LastTriggeredImageID is used internally by the ImageChangeController to save the
+	// last image of an ImageStreamTag From used for a build of the BuildConfig.
+	LastTriggeredImageID string `json:"lastTriggeredImageID,omitempty" description:"used internally to save last used image ID for build"`
+}
+
+// ImageSourcePath describes a path to be copied from a source image and its destination
+// within the build directory.
+type ImageSourcePath struct {
+	// SourcePath is the absolute path of the file or directory inside the image to copy
+	// to the build directory. If the path ends in "/." the contents of the directory are
+	// copied instead of the directory itself.
+	SourcePath string `json:"sourcePath" description:"absolute path of the file or directory inside the image to copy"`
+
+	// DestinationDir is the directory, relative to the root of the build directory, where
+	// the files copied from the image are placed.
+	DestinationDir string `json:"destinationDir" description:"relative directory within the build directory where the copied files are placed"`
+}
+
+// SecretBuildSource describes a secret and the directory where its files are placed
+// for the build.
+type SecretBuildSource struct {
+	// Secret is a reference to an existing secret in the namespace of the build.
+	Secret kapi.LocalObjectReference `json:"secret" description:"reference to a secret in the namespace of the build"`
+
+	// DestinationDir is the directory, relative to the context dir of the build, where
+	// the files of the secret are placed. The built image is squashed into a single
+	// layer without the copies of the files of the secret, wherever the build copied
+	// the build directory to.
+	DestinationDir string `json:"destinationDir,omitempty" description:"relative directory within the context dir of the build where the files of the secret are placed"`
 }
 
 // SourceRevision is the revision or commit information from the source for the build
@@ -153,6 +304,11 @@ type GitSourceRevision struct {
 
 	// Message is the description of a specific commit
 	Message string `json:"message,omitempty" description:"description of a specific commit"`
+
+	// PushedRef is the branch or tag the commit was pushed to when it differs
+	// from the ref configured in the build source. The build checks out this
+	// ref instead of the configured one.
+	PushedRef string `json:"pushedRef,omitempty" description:"branch or tag the commit was pushed to, built instead of the ref of the build source"`
 }
 
 // GitBuildSource defines the parameters of a Git SCM
@@ -251,13 +407,44 @@ type DockerBuildStrategy struct {
 	// --no-cache=true flag
 	NoCache bool `json:"noCache,omitempty" description:"if true, indicates that the Docker build must be executed with the --no-cache=true flag"`
 
-	// Env contains additional environment variables you want to pass into a builder container
-	Env []kapi.EnvVar `json:"env,omitempty" description:"additional environment variables you want to pass into a builder container"`
+	// Env contains additional environment variables you want to pass into a builder container.
+	// The variables are also added to the Dockerfile as ENV instructions right after its
+	// FROM instruction, so they are visible to all the instructions of the build.
+	Env []BuildEnvVar `json:"env,omitempty" description:"additional environment variables you want to pass into a builder container and to add to the Dockerfile after its FROM instruction"`
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
 	ForcePull bool `json:"forcePull,omitempty" description:"forces the source build to pull the image if true"`
 }
 
+// BuildEnvVar represents an environment variable of a build. Its value is either
+// given literally or resolved from a key of a secret when the build runs.
+type BuildEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string `json:"name" description:"name of the environment variable"`
+
+	// Value is the literal value of the environment variable.
+	Value string `json:"value,omitempty" description:"value of the environment variable"`
+
+	// ValueFrom is the source of the value of the environment variable. It cannot be
+	// used if Value is not empty.
+	ValueFrom *BuildEnvVarSource `json:"valueFrom,omitempty" description:"source of the value of the environment variable; cannot be used if value is not empty"`
+}
+
+// BuildEnvVarSource represents the source of the value of a build environment variable.
+type BuildEnvVarSource struct {
+	// SecretKeyRef selects a key of a secret in the namespace of the build.
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty" description:"selects a key of a secret in the namespace of the build"`
+}
+
+// SecretKeySelector selects a key of a secret.
+type SecretKeySelector struct {
+	// The name of the secret in the namespace of the build.
+	kapi.LocalObjectReference `json:",inline"`
+
+	// Key is the key of the secret to select.
+	Key string `json:"key" description:"key of the secret to select"`
+}
+
 // SourceBuildStrategy defines input parameters specific to an Source build.
 type SourceBuildStrategy struct {
 	// From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which
@@ -298,6 +485,30 @@ type BuildOutput struct {
 	PushSecret *kapi.LocalObjectReference `json:"pushSecret,omitempty" description:"supported type: dockercfg"`
 }
 
+// BuildPostCommitSpec holds a build post commit hook specification. The hook
+// executes a command in a temporary container running the build output image,
+// immediately after the last layer of the image is committed and before the
+// image is pushed to a registry. The build fails if the command exits with a
+// non-zero status, and the image is not pushed.
+//
+// When Script is set, it is executed with "/bin/sh -ic" and Args, if any, are
+// passed to the script as positional parameters. When Command is set, it
+// replaces the image entrypoint and Args are passed to it. When only Args is
+// set, the Args are passed to the default entrypoint of the image.
+// Script and Command are mutually exclusive.
+type BuildPostCommitSpec struct {
+	// Command is the command to run. It may not be specified with Script.
+	Command []string `json:"command,omitempty" description:"the command to run, replacing the image entrypoint; may not be specified with script"`
+
+	// Args is a list of arguments that are provided to either Command, Script or
+	// the Docker image's default entrypoint.
+	Args []string `json:"args,omitempty" description:"arguments provided to the command, the script or the default entrypoint of the image"`
+
+	// Script is a shell script to be run with `/bin/sh -ic`. It may not be
+	// specified with Command.
+	Script string `json:"script,omitempty" description:"a shell script run with /bin/sh -ic; may not be specified with command"`
+}
+
 // BuildConfigLabel is the key of a Build label whose value is the ID of a BuildConfig
 // on which the Build is based.
 const BuildConfigLabel = "buildconfig"
@@ -322,8 +533,42 @@ type BuildConfigSpec struct {
 
 	// BuildSpec is the desired build specification
 	BuildSpec `json:",inline" description:"the desired build specification"`
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to
+	// retain. Older successful builds of the BuildConfig are deleted, with their
+	// pods, when a build finishes. If nil, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int `json:"successfulBuildsHistoryLimit,omitempty" description:"the number of old successful builds to retain; if not set, all successful builds are retained"`
+
+	// FailedBuildsHistoryLimit is the number of old failed, errored and cancelled
+	// builds to retain. Older ones are deleted, with their pods, when a build
+	// finishes. If nil, all failed builds are retained.
+	FailedBuildsHistoryLimit *int `json:"failedBuildsHistoryLimit,omitempty" description:"the number of old failed, errored and cancelled builds to retain; if not set, all failed builds are retained"`
+
+	// RunPolicy describes how new builds created from this BuildConfig are
+	// scheduled relative to the builds that are already running. If empty,
+	// builds run in parallel.
+	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty" description:"how new builds are scheduled relative to running builds: Parallel, Serial or SerialLatestOnly; defaults to Parallel"`
 }
 
+// BuildRunPolicy defines the behaviour of how new builds are started.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel starts new builds immediately after they are
+	// created. Builds are executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial queues new builds in the New phase until the
+	// previous build of the BuildConfig completes. Builds run one at a time
+	// in the order they were created.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly runs builds one at a time like Serial,
+	// but cancels queued builds that have not started yet whenever a newer
+	// build is created, so only the latest queued build runs.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -334,6 +579,15 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string `json:"secret,omitempty" description:"secret used to validate requests"`
+
+	// Refs is a list of glob patterns matched against the names of the pushed
+	// branches and tags, e.g. "feature/*" or "v*". When empty, only pushes to
+	// the ref configured in the build source trigger a build.
+	Refs []string `json:"refs,omitempty" description:"glob patterns of the pushed branches and tags that trigger a build; defaults to the ref of the build source"`
+
+	// BuildPushedRef builds the pushed branch or tag instead of the ref
+	// configured in the build source.
+	BuildPushedRef bool `json:"buildPushedRef,omitempty" description:"build the pushed branch or tag instead of the ref of the build source"`
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -360,6 +614,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger `json:"generic,omitempty" description:"parameters for a Generic webhook type of trigger"`
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger `json:"gitlab,omitempty" description:"parameters for a GitLab webhook type of trigger"`
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger `json:"bitbucket,omitempty" description:"parameters for a Bitbucket webhook type of trigger"`
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger `json:"imageChange,omitempty" description:"parameters for an ImageChange type of trigger"`
 }
@@ -378,6 +638,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
@@ -438,6 +706,10 @@ type BuildRequest struct {
 	// TriggeredByImage is the Image that triggered this build.
 	TriggeredByImage *kapi.ObjectReference `json:"triggeredByImage,omitempty" description:"image that triggered this build"`
 
+	// Binary indicates that the build will be provided with binary input when it is started.
+	// The source of the resulting build is replaced by this binary source.
+	Binary *BinaryBuildSource `json:"binary,omitempty" description:"binary input that will be provided when the build is started"`
+
 	// From is the reference to the ImageStreamTag that triggered the build.
 	From *kapi.ObjectReference `json:"from,omitempty" description:"ImageStreamTag that triggered this build"`
 
@@ -445,6 +717,76 @@ type BuildRequest struct {
 	// to generate the build. If the BuildConfig in the generator doesn't match, a build will
 	// not be generated.
 	LastVersion *int `json:"lastVersion,omitempty" description:"LastVersion of the BuildConfig that triggered this build"`
+
+	// TriggeredBy describes which triggers started the Build. It may only be set by
+	// internal clients; otherwise the generator records that the Build was started
+	// manually by the requesting user.
+	TriggeredBy []BuildTriggerCause `json:"triggeredBy,omitempty" description:"describes which triggers started the build; only honored for internal clients, otherwise the build is recorded as started by the requesting user"`
+}
+
+// BuildTriggerCause holds information about why a Build was started. Exactly
+// one of the cause specific fields is set, except for a change of the
+// BuildConfig which is only described by the Message.
+type BuildTriggerCause struct {
+	// Message is a human readable description of the cause.
+	Message string `json:"message,omitempty" description:"human readable description of the cause"`
+
+	// GenericWebHook holds the data of a Build started by a generic webhook.
+	GenericWebHook *GenericWebHookCause `json:"genericWebHook,omitempty" description:"data of a build started by a generic webhook"`
+
+	// GitHubWebHook holds the data of a Build started by a GitHub webhook.
+	GitHubWebHook *GitHubWebHookCause `json:"githubWebHook,omitempty" description:"data of a build started by a GitHub webhook"`
+
+	// GitLabWebHook holds the data of a Build started by a GitLab webhook.
+	GitLabWebHook *GitLabWebHookCause `json:"gitlabWebHook,omitempty" description:"data of a build started by a GitLab webhook"`
+
+	// BitbucketWebHook holds the data of a Build started by a Bitbucket webhook.
+	BitbucketWebHook *BitbucketWebHookCause `json:"bitbucketWebHook,omitempty" description:"data of a build started by a Bitbucket webhook"`
+
+	// ImageChangeBuild holds the data of a Build started by a new image.
+	ImageChangeBuild *ImageChangeCause `json:"imageChangeBuild,omitempty" description:"data of a build started by a new image"`
+
+	// Manual holds the data of a Build started on request of a user.
+	Manual *ManualCause `json:"manual,omitempty" description:"data of a build started on request of a user"`
+}
+
+// GenericWebHookCause holds information about a generic webhook that started a Build.
+type GenericWebHookCause struct {
+	// Revision is the source revision sent with the webhook, if any.
+	Revision *SourceRevision `json:"revision,omitempty" description:"source revision sent with the webhook"`
+}
+
+// GitHubWebHookCause holds information about a GitHub webhook that started a Build.
+type GitHubWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision `json:"revision,omitempty" description:"source revision of the pushed commit"`
+}
+
+// GitLabWebHookCause holds information about a GitLab webhook that started a Build.
+type GitLabWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision `json:"revision,omitempty" description:"source revision of the pushed commit"`
+}
+
+// BitbucketWebHookCause holds information about a Bitbucket webhook that started a Build.
+type BitbucketWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision `json:"revision,omitempty" description:"source revision of the pushed commit"`
+}
+
+// ImageChangeCause holds information about the image that started a Build.
+type ImageChangeCause struct {
+	// ImageID is the pull spec of the new image.
+	ImageID string `json:"imageID,omitempty" description:"pull spec of the new image"`
+
+	// FromRef is the ImageStreamTag the new image was tagged into.
+	FromRef *kapi.ObjectReference `json:"fromRef,omitempty" description:"ImageStreamTag the new image was tagged into"`
+}
+
+// ManualCause holds information about the user who started a Build.
+type ManualCause struct {
+	// User is the name of the user who requested the Build.
+	User string `json:"user,omitempty" description:"name of the user who requested the build"`
 }
 
 // BuildLogOptions is the REST options for a build log
@@ -459,3 +801,177 @@ type BuildLogOptions struct {
 	// is not available yet. Otherwise the server will wait until the build has started.
 	NoWait bool `json:"nowait,omitempty" description:"if true indicates that the server should not wait for a log to be available before returning; defaults to false"`
 }
+
+// Pipeline runs an ordered list of stages across BuildConfigs and DeploymentConfigs.
+// A stage starts once the stage before it completed; when a stage fails, the
+// stages after it are skipped, so a promotion only happens if everything before
+// it succeeded.
+type Pipeline struct {
+	kapi.TypeMeta   `json:",inline"`
+	kapi.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec describes the stages of the pipeline.
+	Spec PipelineSpec `json:"spec" description:"stages of the pipeline"`
+
+	// Status is the current state of the pipeline and of each of its stages.
+	Status PipelineStatus `json:"status,omitempty" description:"current state of the pipeline and of each of its stages"`
+}
+
+// PipelineSpec describes the stages of a pipeline.
+type PipelineSpec struct {
+	// Stages are run in order.
+	Stages []PipelineStage `json:"stages" description:"stages of the pipeline, run in order"`
+}
+
+// PipelineStageType is the type of a pipeline stage.
+type PipelineStageType string
+
+const (
+	// PipelineStageBuild starts a Build from a BuildConfig and waits for it to complete.
+	PipelineStageBuild PipelineStageType = "Build"
+	// PipelineStageDeploy waits for a new deployment of a DeploymentConfig to complete.
+	PipelineStageDeploy PipelineStageType = "Deploy"
+	// PipelineStageVerify runs a pod and waits for it to succeed.
+	PipelineStageVerify PipelineStageType = "Verify"
+	// PipelineStagePromote tags an image into an ImageStreamTag.
+	PipelineStagePromote PipelineStageType = "Promote"
+)
+
+// PipelineStage is a step of a pipeline. Exactly the field matching its Type must be set.
+type PipelineStage struct {
+	// Name identifies the stage within the pipeline.
+	Name string `json:"name" description:"name of the stage, unique within the pipeline"`
+
+	// Type is the type of the stage.
+	Type PipelineStageType `json:"type" description:"type of the stage: Build, Deploy, Verify or Promote"`
+
+	// Build holds the parameters of a Build stage.
+	Build *BuildStage `json:"build,omitempty" description:"parameters of a Build stage"`
+
+	// Deploy holds the parameters of a Deploy stage.
+	Deploy *DeployStage `json:"deploy,omitempty" description:"parameters of a Deploy stage"`
+
+	// Verify holds the parameters of a Verify stage.
+	Verify *VerifyStage `json:"verify,omitempty" description:"parameters of a Verify stage"`
+
+	// Promote holds the parameters of a Promote stage.
+	Promote *PromoteStage `json:"promote,omitempty" description:"parameters of a Promote stage"`
+}
+
+// BuildStage starts a Build from a BuildConfig.
+type BuildStage struct {
+	// BuildConfig is the BuildConfig to start a Build from, in the namespace of the pipeline.
+	BuildConfig kapi.LocalObjectReference `json:"buildConfig" description:"build config to start a build from"`
+}
+
+// DeployStage waits for a new deployment of a DeploymentConfig. If the image built by
+// an earlier Build stage triggers the DeploymentConfig automatically, the stage waits
+// for that deployment. Otherwise it starts a new deployment.
+type DeployStage struct {
+	// DeploymentConfig is the DeploymentConfig to deploy, in the namespace of the pipeline.
+	DeploymentConfig kapi.LocalObjectReference `json:"deploymentConfig" description:"deployment config to deploy"`
+}
+
+// VerifyStage runs a pod which must succeed for the pipeline to continue.
+type VerifyStage struct {
+	// Image is the image to run.
+	Image string `json:"image" description:"image to run"`
+
+	// Command is the command to run, overriding the entrypoint of the image.
+	Command []string `json:"command,omitempty" description:"command to run, overriding the entrypoint of the image"`
+
+	// Env contains the environment of the command.
+	Env []kapi.EnvVar `json:"env,omitempty" description:"environment of the command"`
+}
+
+// PromoteStage tags an image into an ImageStreamTag.
+type PromoteStage struct {
+	// From is the ImageStreamTag whose current image is promoted. If it is not set, the
+	// output of the closest earlier Build stage is promoted. It must be in the namespace
+	// of the pipeline.
+	From *kapi.ObjectReference `json:"from,omitempty" description:"image stream tag in the namespace of the pipeline whose image is promoted; defaults to the output of the closest earlier build stage"`
+
+	// To is the ImageStreamTag the image is tagged into. It must be in the namespace of
+	// the pipeline.
+	To kapi.ObjectReference `json:"to" description:"image stream tag in the namespace of the pipeline the image is tagged into"`
+}
+
+// PipelinePhase represents the state of a pipeline.
+type PipelinePhase string
+
+const (
+	// PipelinePhaseNew is the state of a pipeline which has not started yet.
+	PipelinePhaseNew PipelinePhase = "New"
+	// PipelinePhaseRunning is the state of a pipeline running its stages.
+	PipelinePhaseRunning PipelinePhase = "Running"
+	// PipelinePhaseComplete is the state of a pipeline all stages of which completed.
+	PipelinePhaseComplete PipelinePhase = "Complete"
+	// PipelinePhaseFailed is the state of a pipeline one stage of which failed.
+	PipelinePhaseFailed PipelinePhase = "Failed"
+)
+
+// PipelineStagePhase represents the state of a pipeline stage.
+type PipelineStagePhase string
+
+const (
+	// PipelineStagePhasePending is the state of a stage waiting for the stages before it.
+	PipelineStagePhasePending PipelineStagePhase = "Pending"
+	// PipelineStagePhaseRunning is the state of a stage waiting for what it started.
+	PipelineStagePhaseRunning PipelineStagePhase = "Running"
+	// PipelineStagePhaseComplete is the state of a stage which succeeded.
+	PipelineStagePhaseComplete PipelineStagePhase = "Complete"
+	// PipelineStagePhaseFailed is the state of a stage which failed.
+	PipelineStagePhaseFailed PipelineStagePhase = "Failed"
+	// PipelineStagePhaseSkipped is the state of a stage which was not run because an
+	// earlier stage failed.
+	PipelineStagePhaseSkipped PipelineStagePhase = "Skipped"
+)
+
+// PipelineStatus is the current state of a pipeline.
+type PipelineStatus struct {
+	// Phase is the state of the pipeline.
+	Phase PipelinePhase `json:"phase" description:"state of the pipeline: New, Running, Complete or Failed"`
+
+	// Message describes why the pipeline failed.
+	Message string `json:"message,omitempty" description:"human-readable explanation of the failure of the pipeline"`
+
+	// StartTimestamp is the time the pipeline started.
+	StartTimestamp *util.Time `json:"startTimestamp,omitempty" description:"server time when the pipeline started"`
+
+	// CompletionTimestamp is the time the pipeline completed or failed.
+	CompletionTimestamp *util.Time `json:"completionTimestamp,omitempty" description:"server time when the pipeline completed or failed"`
+
+	// Stages holds the state of each stage, in the order of the stages of the spec.
+	Stages []PipelineStageStatus `json:"stages,omitempty" description:"state of each stage, in the order of the stages of the spec"`
+}
+
+// PipelineStageStatus is the current state of a pipeline stage.
+type PipelineStageStatus struct {
+	// Name is the name of the stage.
+	Name string `json:"name" description:"name of the stage"`
+
+	// Phase is the state of the stage.
+	Phase PipelineStagePhase `json:"phase" description:"state of the stage: Pending, Running, Complete, Failed or Skipped"`
+
+	// Message describes the outcome of the stage.
+	Message string `json:"message,omitempty" description:"human-readable explanation of the outcome of the stage"`
+
+	// Reference is the object the stage started or waits for: a Build, the
+	// ReplicationController of a deployment or a Pod.
+	Reference *kapi.ObjectReference `json:"reference,omitempty" description:"build, deployment or pod the stage started or waits for"`
+
+	// StartTimestamp is the time the stage started.
+	StartTimestamp *util.Time `json:"startTimestamp,omitempty" description:"server time when the stage started"`
+
+	// CompletionTimestamp is the time the stage completed, failed or was skipped.
+	CompletionTimestamp *util.Time `json:"completionTimestamp,omitempty" description:"server time when the stage completed, failed or was skipped"`
+}
+
+// PipelineList is a collection of Pipelines.
+type PipelineList struct {
+	kapi.TypeMeta `json:",inline"`
+	kapi.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of pipelines
+	Items []Pipeline `json:"items" description:"list of pipelines"`
+}
@@ -102,6 +102,10 @@ func convert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *BuildTrigg
 		out.Type = newer.GenericWebHookBuildTriggerType
 	case GitHubWebHookBuildTriggerType:
 		out.Type = newer.GitHubWebHookBuildTriggerType
+	case GitLabWebHookBuildTriggerType:
+		out.Type = newer.GitLabWebHookBuildTriggerType
+	case BitbucketWebHookBuildTriggerType:
+		out.Type = newer.BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -117,6 +121,10 @@ func convert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *newer.Buil
 		out.Type = GenericWebHookBuildTriggerType
 	case newer.GitHubWebHookBuildTriggerType:
 		out.Type = GitHubWebHookBuildTriggerType
+	case newer.GitLabWebHookBuildTriggerType:
+		out.Type = GitLabWebHookBuildTriggerType
+	case newer.BitbucketWebHookBuildTriggerType:
+		out.Type = BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -13,6 +13,8 @@ func init() {
 		&BuildLog{},
 		&BuildRequest{},
 		&BuildLogOptions{},
+		&Pipeline{},
+		&PipelineList{},
 	)
 }
 
@@ -23,3 +25,5 @@ func (*BuildConfigList) IsAnAPIObject() {}
 func (*BuildLog) IsAnAPIObject()        {}
 func (*BuildRequest) IsAnAPIObject()    {}
 func (*BuildLogOptions) IsAnAPIObject() {}
+func (*Pipeline) IsAnAPIObject()        {}
+func (*PipelineList) IsAnAPIObject()    {}
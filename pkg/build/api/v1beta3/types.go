@@ -42,6 +42,13 @@ type BuildSpec struct {
 
 	// Compute resource requirements to execute the build
 	Resources kapi.ResourceRequirements `json:"resources,omitempty" description:"the desired compute resources the build should have"`
+
+	// PostCommit is a build hook executed in a container from the built image.
+	PostCommit BuildPostCommitSpec `json:"postCommit,omitempty"`
+
+	// CompletionDeadlineSeconds is an optional duration in seconds the build may be active
+	// on a node before the system actively tries to terminate it.
+	CompletionDeadlineSeconds *int64 `json:"completionDeadlineSeconds,omitempty"`
 }
 
 // BuildStatus contains the status of a build
@@ -52,6 +59,9 @@ type BuildStatus struct {
 	// Cancelled describes if a cancelling event was triggered for the build.
 	Cancelled bool `json:"cancelled,omitempty"`
 
+	// Reason is a brief CamelCase string that describes any failure.
+	Reason StatusReason `json:"reason,omitempty"`
+
 	// A human readable message indicating details about why the build has this status
 	Message string `json:"message,omitempty"`
 
@@ -71,6 +81,9 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference `json:"config,omitempty"`
+
+	// TriggeredBy describes which triggers started the Build.
+	TriggeredBy []BuildTriggerCause `json:"triggeredBy,omitempty"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -101,6 +114,42 @@ const (
 	BuildPhaseCancelled BuildPhase = "Cancelled"
 )
 
+// StatusReason is a brief CamelCase string that describes a build error condition.
+type StatusReason string
+
+// Valid values for StatusReason.
+const (
+	// StatusReasonCannotCreateBuildPod indicates that the build pod could not be created.
+	StatusReasonCannotCreateBuildPod StatusReason = "CannotCreateBuildPod"
+
+	// StatusReasonFetchSourceFailed indicates that the build source could not be retrieved.
+	StatusReasonFetchSourceFailed StatusReason = "FetchSourceFailed"
+
+	// StatusReasonPullBuilderImageFailed indicates that the builder image could not be pulled.
+	StatusReasonPullBuilderImageFailed StatusReason = "PullBuilderImageFailed"
+
+	// StatusReasonAssembleFailed indicates that assembling the output image failed.
+	StatusReasonAssembleFailed StatusReason = "AssembleFailed"
+
+	// StatusReasonPostCommitHookFailed indicates that the post commit hook failed.
+	StatusReasonPostCommitHookFailed StatusReason = "PostCommitHookFailed"
+
+	// StatusReasonPushImageFailed indicates that the output image could not be pushed.
+	StatusReasonPushImageFailed StatusReason = "PushImageFailed"
+
+	// StatusReasonBuildPodDeleted indicates that the build pod was deleted.
+	StatusReasonBuildPodDeleted StatusReason = "BuildPodDeleted"
+
+	// StatusReasonCancelled indicates that the build was cancelled.
+	StatusReasonCancelled StatusReason = "Cancelled"
+
+	// StatusReasonTimeout indicates that the build exceeded its completion deadline.
+	StatusReasonTimeout StatusReason = "Timeout"
+
+	// StatusReasonGenericBuildFailed indicates that the build failed for an unreported reason.
+	StatusReasonGenericBuildFailed StatusReason = "GenericBuildFailed"
+)
+
 // BuildSourceType is the type of SCM used
 type BuildSourceType string
 
@@ -108,6 +157,16 @@ type BuildSourceType string
 const (
 	//BuildSourceGit is a Git SCM
 	BuildSourceGit BuildSourceType = "Git"
+
+	// BuildSourceBinary indicates the build will accept a binary file as input.
+	BuildSourceBinary BuildSourceType = "Binary"
+
+	// BuildSourceDockerfile indicates the build uses a Dockerfile defined inline in the
+	// build source instead of one from a repository.
+	BuildSourceDockerfile BuildSourceType = "Dockerfile"
+
+	// BuildSourceImage indicates the build takes its source only from other images.
+	BuildSourceImage BuildSourceType = "Image"
 )
 
 // BuildSource is the SCM used for the build
@@ -115,6 +174,17 @@ type BuildSource struct {
 	Type BuildSourceType `json:"type"`
 	Git  *GitBuildSource `json:"git,omitempty"`
 
+	// Binary contains optional information about a binary build source provided
+	// when the build is started.
+	Binary *BinaryBuildSource `json:"binary,omitempty"`
+
+	// Dockerfile is the raw contents of a Dockerfile which should be built.
+	Dockerfile *string `json:"dockerfile,omitempty"`
+
+	// Images describes a set of images and the paths within them that are copied into
+	// the build directory before the build runs.
+	Images []ImageSource `json:"images,omitempty"`
+
 	// Specify the sub-directory where the source code for the application exists.
 	// This allows to have buildable sources in directory other than root of
 	// repository.
@@ -126,6 +196,40 @@ type BuildSource struct {
 	// data's key represent the authentication method to be used and value is
 	// the base64 encoded credentials. Supported auth methods are: ssh-privatekey.
 	SourceSecret *kapi.LocalObjectReference `json:"sourceSecret,omitempty" description:"supported auth methods are: ssh-privatekey"`
+
+	// Secrets is a list of secrets whose files are made available to the build.
+	Secrets []SecretBuildSource `json:"secrets,omitempty"`
+}
+
+// BinaryBuildSource describes a binary input that is provided when a build is started.
+type BinaryBuildSource struct {
+	// AsFile indicates that the provided binary input should be considered a single file
+	// with this name within the build input. If left empty, the input is treated as an
+	// archive and its contents are extracted as the build context.
+	AsFile string `json:"asFile,omitempty"`
+}
+
+// ImageSource describes an image and the paths within it that are used as source for
+// the build.
+type ImageSource struct {
+	From                 kapi.ObjectReference       `json:"from"`
+	Paths                []ImageSourcePath          `json:"paths"`
+	PullSecret           *kapi.LocalObjectReference `json:"pullSecret,omitempty"`
+	LastTriggeredImageID string                     `json:"lastTriggeredImageID,omitempty"`
+}
+
+// ImageSourcePath describes a path to be copied from a source image and its destination
+// within the build directory.
+type ImageSourcePath struct {
+	SourcePath     string `json:"sourcePath"`
+	DestinationDir string `json:"destinationDir"`
+}
+
+// SecretBuildSource describes a secret and the directory where its files are placed
+// for the build.
+type SecretBuildSource struct {
+	Secret         kapi.LocalObjectReference `json:"secret"`
+	DestinationDir string                    `json:"destinationDir,omitempty"`
 }
 
 // SourceRevision is the revision or commit information from the source for the build
@@ -147,6 +251,10 @@ type GitSourceRevision struct {
 
 	// Message is the description of a specific commit
 	Message string `json:"message,omitempty"`
+
+	// PushedRef is the branch or tag the commit was pushed to when it differs
+	// from the ref configured in the build source.
+	PushedRef string `json:"pushedRef,omitempty"`
 }
 
 // GitBuildSource defines the parameters of a Git SCM
@@ -243,12 +351,31 @@ type DockerBuildStrategy struct {
 	NoCache bool `json:"noCache,omitempty"`
 
 	// Env contains additional environment variables you want to pass into a builder container
-	Env []kapi.EnvVar `json:"env,omitempty" description:"additional environment variables you want to pass into a builder container"`
+	Env []BuildEnvVar `json:"env,omitempty" description:"additional environment variables you want to pass into a builder container"`
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
 	ForcePull bool `json:"forcePull,omitempty" description:"forces the source build to pull the image if true"`
 }
 
+// BuildEnvVar represents an environment variable of a build, either given literally
+// or resolved from a key of a secret.
+type BuildEnvVar struct {
+	Name      string             `json:"name"`
+	Value     string             `json:"value,omitempty"`
+	ValueFrom *BuildEnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// BuildEnvVarSource represents the source of the value of a build environment variable.
+type BuildEnvVarSource struct {
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// SecretKeySelector selects a key of a secret.
+type SecretKeySelector struct {
+	kapi.LocalObjectReference `json:",inline"`
+	Key                       string `json:"key"`
+}
+
 // SourceBuildStrategy defines input parameters specific to an Source build.
 type SourceBuildStrategy struct {
 	// From is reference to an ImageStreamTag, or ImageStreamImage from which
@@ -288,6 +415,18 @@ type BuildOutput struct {
 	PushSecret *kapi.LocalObjectReference `json:"pushSecret,omitempty" description:"supported type: dockercfg"`
 }
 
+// BuildPostCommitSpec holds a build post commit hook specification.
+type BuildPostCommitSpec struct {
+	// Command is the command to run. It may not be specified with Script.
+	Command []string `json:"command,omitempty"`
+
+	// Args is a list of arguments provided to Command, Script or the image entrypoint.
+	Args []string `json:"args,omitempty"`
+
+	// Script is a shell script to be run with `/bin/sh -ic`.
+	Script string `json:"script,omitempty"`
+}
+
 // BuildConfigLabel is the key of a Build label whose value is the ID of a BuildConfig
 // on which the Build is based.
 const BuildConfigLabel = "buildconfig"
@@ -311,8 +450,32 @@ type BuildConfigSpec struct {
 	Triggers []BuildTriggerPolicy `json:"triggers"`
 
 	BuildSpec `json:",inline"`
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to retain.
+	SuccessfulBuildsHistoryLimit *int `json:"successfulBuildsHistoryLimit,omitempty"`
+
+	// FailedBuildsHistoryLimit is the number of old failed builds to retain.
+	FailedBuildsHistoryLimit *int `json:"failedBuildsHistoryLimit,omitempty"`
+
+	// RunPolicy describes how new builds are scheduled relative to running builds.
+	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty"`
 }
 
+// BuildRunPolicy defines the behaviour of how new builds are started.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel starts new builds immediately.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial runs builds one at a time in creation order.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly runs builds one at a time and cancels
+	// queued builds when a newer one is created.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -323,6 +486,14 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string `json:"secret,omitempty"`
+
+	// Refs is a list of glob patterns matched against the names of the pushed
+	// branches and tags.
+	Refs []string `json:"refs,omitempty"`
+
+	// BuildPushedRef builds the pushed branch or tag instead of the ref
+	// configured in the build source.
+	BuildPushedRef bool `json:"buildPushedRef,omitempty"`
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -349,6 +520,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger `json:"generic,omitempty"`
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger `json:"gitlab,omitempty"`
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger `json:"bitbucket,omitempty"`
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger `json:"imageChange,omitempty"`
 }
@@ -365,6 +542,14 @@ const (
 	// generic webhook invocations
 	GenericWebHookBuildTriggerType BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "gitlab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType BuildTriggerType = "imageChange"
@@ -420,6 +605,9 @@ type BuildRequest struct {
 	// TriggeredByImage is the Image that triggered this build.
 	TriggeredByImage *kapi.ObjectReference `json:"triggeredByImage,omitempty"`
 
+	// Binary indicates that the build will be provided with binary input when it is started.
+	Binary *BinaryBuildSource `json:"binary,omitempty"`
+
 	// From is the reference to the ImageStreamTag that triggered the build.
 	From *kapi.ObjectReference `json:"from,omitempty" description:"ImageStreamTag that triggered this build"`
 
@@ -427,6 +615,72 @@ type BuildRequest struct {
 	// to generate the build. If the BuildConfig in the generator doesn't match, a build will
 	// not be generated.
 	LastVersion *int `json:"lastVersion,omitempty" description:"LastVersion of the BuildConfig that triggered this build"`
+
+	// TriggeredBy describes which triggers started the Build.
+	TriggeredBy []BuildTriggerCause `json:"triggeredBy,omitempty"`
+}
+
+// BuildTriggerCause holds information about why a Build was started.
+type BuildTriggerCause struct {
+	// Message is a human readable description of the cause.
+	Message string `json:"message,omitempty"`
+
+	// GenericWebHook holds the data of a Build started by a generic webhook.
+	GenericWebHook *GenericWebHookCause `json:"genericWebHook,omitempty"`
+
+	// GitHubWebHook holds the data of a Build started by a GitHub webhook.
+	GitHubWebHook *GitHubWebHookCause `json:"githubWebHook,omitempty"`
+
+	// GitLabWebHook holds the data of a Build started by a GitLab webhook.
+	GitLabWebHook *GitLabWebHookCause `json:"gitlabWebHook,omitempty"`
+
+	// BitbucketWebHook holds the data of a Build started by a Bitbucket webhook.
+	BitbucketWebHook *BitbucketWebHookCause `json:"bitbucketWebHook,omitempty"`
+
+	// ImageChangeBuild holds the data of a Build started by a new image.
+	ImageChangeBuild *ImageChangeCause `json:"imageChangeBuild,omitempty"`
+
+	// Manual holds the data of a Build started on request of a user.
+	Manual *ManualCause `json:"manual,omitempty"`
+}
+
+// GenericWebHookCause holds information about a generic webhook that started a Build.
+type GenericWebHookCause struct {
+	// Revision is the source revision sent with the webhook.
+	Revision *SourceRevision `json:"revision,omitempty"`
+}
+
+// GitHubWebHookCause holds information about a GitHub webhook that started a Build.
+type GitHubWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision `json:"revision,omitempty"`
+}
+
+// GitLabWebHookCause holds information about a GitLab webhook that started a Build.
+type GitLabWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision `json:"revision,omitempty"`
+}
+
+// BitbucketWebHookCause holds information about a Bitbucket webhook that started a Build.
+type BitbucketWebHookCause struct {
+	// Revision is the source revision of the pushed commit.
+	Revision *SourceRevision `json:"revision,omitempty"`
+}
+
+// ImageChangeCause holds information about the image that started a Build.
+type ImageChangeCause struct {
+	// ImageID is the pull spec of the new image.
+	ImageID string `json:"imageID,omitempty"`
+
+	// FromRef is the ImageStreamTag the new image was tagged into.
+	FromRef *kapi.ObjectReference `json:"fromRef,omitempty"`
+}
+
+// ManualCause holds information about the user who started a Build.
+type ManualCause struct {
+	// User is the name of the user who requested the Build.
+	User string `json:"user,omitempty"`
 }
 
 // BuildLogOptions is the REST options for a build log
@@ -441,3 +695,106 @@ type BuildLogOptions struct {
 	// is not available yet. Otherwise the server will wait until the build has started.
 	NoWait bool `json:"nowait,omitempty" description:"if true indicates that the server should not wait for a log to be available before returning; defaults to false"`
 }
+
+// Pipeline runs an ordered list of stages across BuildConfigs and DeploymentConfigs.
+type Pipeline struct {
+	kapi.TypeMeta   `json:",inline"`
+	kapi.ObjectMeta `json:"metadata,omitempty"`
+	Spec            PipelineSpec   `json:"spec"`
+	Status          PipelineStatus `json:"status,omitempty"`
+}
+
+// PipelineSpec describes the stages of a pipeline.
+type PipelineSpec struct {
+	Stages []PipelineStage `json:"stages"`
+}
+
+// PipelineStageType is the type of a pipeline stage.
+type PipelineStageType string
+
+const (
+	PipelineStageBuild   PipelineStageType = "Build"
+	PipelineStageDeploy  PipelineStageType = "Deploy"
+	PipelineStageVerify  PipelineStageType = "Verify"
+	PipelineStagePromote PipelineStageType = "Promote"
+)
+
+// PipelineStage is a step of a pipeline.
+type PipelineStage struct {
+	Name    string            `json:"name"`
+	Type    PipelineStageType `json:"type"`
+	Build   *BuildStage       `json:"build,omitempty"`
+	Deploy  *DeployStage      `json:"deploy,omitempty"`
+	Verify  *VerifyStage      `json:"verify,omitempty"`
+	Promote *PromoteStage     `json:"promote,omitempty"`
+}
+
+// BuildStage starts a Build from a BuildConfig.
+type BuildStage struct {
+	BuildConfig kapi.LocalObjectReference `json:"buildConfig"`
+}
+
+// DeployStage waits for a new deployment of a DeploymentConfig.
+type DeployStage struct {
+	DeploymentConfig kapi.LocalObjectReference `json:"deploymentConfig"`
+}
+
+// VerifyStage runs a pod which must succeed for the pipeline to continue.
+type VerifyStage struct {
+	Image   string        `json:"image"`
+	Command []string      `json:"command,omitempty"`
+	Env     []kapi.EnvVar `json:"env,omitempty"`
+}
+
+// PromoteStage tags an image into an ImageStreamTag.
+type PromoteStage struct {
+	From *kapi.ObjectReference `json:"from,omitempty"`
+	To   kapi.ObjectReference  `json:"to"`
+}
+
+// PipelinePhase represents the state of a pipeline.
+type PipelinePhase string
+
+const (
+	PipelinePhaseNew      PipelinePhase = "New"
+	PipelinePhaseRunning  PipelinePhase = "Running"
+	PipelinePhaseComplete PipelinePhase = "Complete"
+	PipelinePhaseFailed   PipelinePhase = "Failed"
+)
+
+// PipelineStagePhase represents the state of a pipeline stage.
+type PipelineStagePhase string
+
+const (
+	PipelineStagePhasePending  PipelineStagePhase = "Pending"
+	PipelineStagePhaseRunning  PipelineStagePhase = "Running"
+	PipelineStagePhaseComplete PipelineStagePhase = "Complete"
+	PipelineStagePhaseFailed   PipelineStagePhase = "Failed"
+	PipelineStagePhaseSkipped  PipelineStagePhase = "Skipped"
+)
+
+// PipelineStatus is the current state of a pipeline.
+type PipelineStatus struct {
+	Phase               PipelinePhase         `json:"phase"`
+	Message             string                `json:"message,omitempty"`
+	StartTimestamp      *util.Time            `json:"startTimestamp,omitempty"`
+	CompletionTimestamp *util.Time            `json:"completionTimestamp,omitempty"`
+	Stages              []PipelineStageStatus `json:"stages,omitempty"`
+}
+
+// PipelineStageStatus is the current state of a pipeline stage.
+type PipelineStageStatus struct {
+	Name                string                `json:"name"`
+	Phase               PipelineStagePhase    `json:"phase"`
+	Message             string                `json:"message,omitempty"`
+	Reference           *kapi.ObjectReference `json:"reference,omitempty"`
+	StartTimestamp      *util.Time            `json:"startTimestamp,omitempty"`
+	CompletionTimestamp *util.Time            `json:"completionTimestamp,omitempty"`
+}
+
+// PipelineList is a collection of Pipelines.
+type PipelineList struct {
+	kapi.TypeMeta `json:",inline"`
+	kapi.ListMeta `json:"metadata,omitempty"`
+	Items         []Pipeline `json:"items"`
+}
@@ -3,6 +3,8 @@ package validation
 import (
 	"fmt"
 	"net/url"
+	"path"
+	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
@@ -75,6 +77,18 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) fielderrors.ValidationErr
 	}
 
 	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec).Prefix("spec")...)
+
+	if config.Spec.SuccessfulBuildsHistoryLimit != nil && *config.Spec.SuccessfulBuildsHistoryLimit < 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.successfulBuildsHistoryLimit", *config.Spec.SuccessfulBuildsHistoryLimit, "must be greater than or equal to 0"))
+	}
+	if config.Spec.FailedBuildsHistoryLimit != nil && *config.Spec.FailedBuildsHistoryLimit < 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.failedBuildsHistoryLimit", *config.Spec.FailedBuildsHistoryLimit, "must be greater than or equal to 0"))
+	}
+	switch config.Spec.RunPolicy {
+	case "", buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+	default:
+		allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("spec.runPolicy", config.Spec.RunPolicy, []string{string(buildapi.BuildRunPolicyParallel), string(buildapi.BuildRunPolicySerial), string(buildapi.BuildRunPolicySerialLatestOnly)}))
+	}
 	return allErrs
 }
 
@@ -94,6 +108,9 @@ func ValidateBuildRequest(request *buildapi.BuildRequest) fielderrors.Validation
 	if request.Revision != nil {
 		allErrs = append(allErrs, validateRevision(request.Revision).Prefix("revision")...)
 	}
+	if request.Binary != nil {
+		allErrs = append(allErrs, validateBinarySource(request.Binary).Prefix("binary")...)
+	}
 	return allErrs
 }
 
@@ -110,24 +127,99 @@ func validateBuildSpec(spec *buildapi.BuildSpec) fielderrors.ValidationErrorList
 		}
 	}
 
+	if spec.Source.Dockerfile != nil && spec.Strategy.Type != buildapi.DockerBuildStrategyType {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("source.dockerfile", "", "may only be used with the Docker strategy"))
+	}
+
 	allErrs = append(allErrs, validateOutput(&spec.Output).Prefix("output")...)
 	allErrs = append(allErrs, validateStrategy(&spec.Strategy).Prefix("strategy")...)
+	allErrs = append(allErrs, validatePostCommit(spec.PostCommit).Prefix("postCommit")...)
+
+	if spec.CompletionDeadlineSeconds != nil && *spec.CompletionDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("completionDeadlineSeconds", *spec.CompletionDeadlineSeconds, "must be a positive integer"))
+	}
 
 	// TODO: validate resource requirements (prereq: https://github.com/GoogleCloudPlatform/kubernetes/pull/7059)
 	return allErrs
 }
 
+func validatePostCommit(spec buildapi.BuildPostCommitSpec) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if len(spec.Script) != 0 && len(spec.Command) != 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("script", spec.Script, "cannot be specified together with command"))
+	}
+	return allErrs
+}
+
 func validateSource(input *buildapi.BuildSource) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
-	if input.Type != buildapi.BuildSourceGit {
-		allErrs = append(allErrs, fielderrors.NewFieldRequired("type"))
+	switch input.Type {
+	case buildapi.BuildSourceBinary:
+		if input.Binary == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("binary"))
+		} else {
+			allErrs = append(allErrs, validateBinarySource(input.Binary).Prefix("binary")...)
+		}
+	case buildapi.BuildSourceDockerfile:
+		if input.Dockerfile == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("dockerfile"))
+		}
+	case buildapi.BuildSourceImage:
+		if len(input.Images) == 0 {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("images"))
+		}
+	default:
+		if input.Type != buildapi.BuildSourceGit {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("type"))
+		}
+		if input.Git == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("git"))
+		} else {
+			allErrs = append(allErrs, validateGitSource(input.Git).Prefix("git")...)
+		}
+	}
+	if input.Dockerfile != nil {
+		allErrs = append(allErrs, validateDockerfile(*input.Dockerfile)...)
 	}
-	if input.Git == nil {
-		allErrs = append(allErrs, fielderrors.NewFieldRequired("git"))
-	} else {
-		allErrs = append(allErrs, validateGitSource(input.Git).Prefix("git")...)
+	for i := range input.Images {
+		allErrs = append(allErrs, validateImageSource(&input.Images[i]).PrefixIndex(i).Prefix("images")...)
 	}
 	allErrs = append(allErrs, validateSecretRef(input.SourceSecret).Prefix("sourceSecret")...)
+	secretNames := util.NewStringSet()
+	for i := range input.Secrets {
+		secret := &input.Secrets[i]
+		allErrs = append(allErrs, validateSecretBuildSource(secret).PrefixIndex(i).Prefix("secrets")...)
+		if secretNames.Has(secret.Secret.Name) {
+			allErrs = append(allErrs, fielderrors.NewFieldDuplicate(fmt.Sprintf("secrets[%d].secret.name", i), secret.Secret.Name))
+		}
+		secretNames.Insert(secret.Secret.Name)
+	}
+	return allErrs
+}
+
+func validateSecretBuildSource(secret *buildapi.SecretBuildSource) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if len(secret.Secret.Name) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("secret.name"))
+	}
+	destination := path.Clean(secret.DestinationDir)
+	if path.IsAbs(destination) || destination == ".." || strings.HasPrefix(destination, "../") {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("destinationDir", secret.DestinationDir, "destinationDir must be a relative path within the build directory"))
+	}
+	return allErrs
+}
+
+// maxDockerfileLengthBytes is the largest inline Dockerfile accepted in a build source.
+const maxDockerfileLengthBytes = 60 * 1000
+
+func validateDockerfile(dockerfile string) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	switch {
+	case len(strings.TrimSpace(dockerfile)) == 0:
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("dockerfile"))
+	case len(dockerfile) > maxDockerfileLengthBytes:
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("dockerfile", "", fmt.Sprintf("must be smaller than %d bytes", maxDockerfileLengthBytes)))
+	}
 	return allErrs
 }
 
@@ -158,6 +250,45 @@ func validateGitSource(git *buildapi.GitBuildSource) fielderrors.ValidationError
 	return allErrs
 }
 
+func validateBinarySource(binary *buildapi.BinaryBuildSource) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if len(binary.AsFile) == 0 {
+		return allErrs
+	}
+	if strings.ContainsAny(binary.AsFile, "/\\") || binary.AsFile == "." || binary.AsFile == ".." {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("asFile", binary.AsFile, "asFile must be a file name and may not contain a path"))
+	}
+	return allErrs
+}
+
+func validateImageSource(imageSource *buildapi.ImageSource) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validateFromImageReference(&imageSource.From).Prefix("from")...)
+	allErrs = append(allErrs, validateSecretRef(imageSource.PullSecret).Prefix("pullSecret")...)
+	if len(imageSource.Paths) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("paths"))
+	}
+	for i := range imageSource.Paths {
+		allErrs = append(allErrs, validateImageSourcePath(&imageSource.Paths[i]).PrefixIndex(i).Prefix("paths")...)
+	}
+	return allErrs
+}
+
+func validateImageSourcePath(imagePath *buildapi.ImageSourcePath) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	switch {
+	case len(imagePath.SourcePath) == 0:
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("sourcePath"))
+	case !path.IsAbs(imagePath.SourcePath):
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("sourcePath", imagePath.SourcePath, "sourcePath must be an absolute path"))
+	}
+	destination := path.Clean(imagePath.DestinationDir)
+	if path.IsAbs(destination) || destination == ".." || strings.HasPrefix(destination, "../") {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("destinationDir", imagePath.DestinationDir, "destinationDir must be a relative path within the build directory"))
+	}
+	return allErrs
+}
+
 func validateRevision(revision *buildapi.SourceRevision) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
 	if len(revision.Type) == 0 {
@@ -292,6 +423,42 @@ func validateDockerStrategy(strategy *buildapi.DockerBuildStrategy) fielderrors.
 	}
 
 	allErrs = append(allErrs, validateSecretRef(strategy.PullSecret).Prefix("pullSecret")...)
+	allErrs = append(allErrs, validateBuildEnv(strategy.Env).Prefix("env")...)
+	return allErrs
+}
+
+func validateBuildEnv(vars []buildapi.BuildEnvVar) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	for i, ev := range vars {
+		vErrs := fielderrors.ValidationErrorList{}
+		if len(ev.Name) == 0 {
+			vErrs = append(vErrs, fielderrors.NewFieldRequired("name"))
+		} else if !util.IsCIdentifier(ev.Name) {
+			vErrs = append(vErrs, fielderrors.NewFieldInvalid("name", ev.Name, "must be a C identifier (matching regex "+util.CIdentifierFmt+"): e.g. \"my_name\" or \"MyName\""))
+		}
+		if ev.ValueFrom != nil {
+			vErrs = append(vErrs, validateBuildEnvVarSource(ev).Prefix("valueFrom")...)
+		}
+		allErrs = append(allErrs, vErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validateBuildEnvVarSource(ev buildapi.BuildEnvVar) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if ev.ValueFrom.SecretKeyRef == nil {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("secretKeyRef"))
+		return allErrs
+	}
+	if len(ev.ValueFrom.SecretKeyRef.Name) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("secretKeyRef.name"))
+	}
+	if len(ev.ValueFrom.SecretKeyRef.Key) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("secretKeyRef.key"))
+	}
+	if len(ev.Value) != 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("", "", "sources cannot be specified when value is not empty"))
+	}
 	return allErrs
 }
 
@@ -330,6 +497,18 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy) fielderrors.Validatio
 		} else {
 			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook).Prefix("generic")...)
 		}
+	case buildapi.GitLabWebHookBuildTriggerType:
+		if trigger.GitLabWebHook == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("gitlab"))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GitLabWebHook).Prefix("gitlab")...)
+		}
+	case buildapi.BitbucketWebHookBuildTriggerType:
+		if trigger.BitbucketWebHook == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("bitbucket"))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.BitbucketWebHook).Prefix("bitbucket")...)
+		}
 	case buildapi.ImageChangeBuildTriggerType:
 		if trigger.ImageChange == nil {
 			allErrs = append(allErrs, fielderrors.NewFieldRequired("imageChange"))
@@ -360,6 +539,132 @@ func validateWebHook(webHook *buildapi.WebHookTrigger) fielderrors.ValidationErr
 	if len(webHook.Secret) == 0 {
 		allErrs = append(allErrs, fielderrors.NewFieldRequired("secret"))
 	}
+	for i, pattern := range webHook.Refs {
+		if len(pattern) == 0 {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired(fmt.Sprintf("refs[%d]", i)))
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid(fmt.Sprintf("refs[%d]", i), pattern, "must be a valid glob pattern"))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePipeline tests required fields for a Pipeline.
+func ValidatePipeline(pipeline *buildapi.Pipeline) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMeta(&pipeline.ObjectMeta, true, validation.NameIsDNSSubdomain).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePipelineSpec(&pipeline.Spec, pipeline.Namespace).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidatePipelineUpdate tests an update of a Pipeline. The stages of a pipeline
+// cannot change once it has been created.
+func ValidatePipelineUpdate(pipeline *buildapi.Pipeline, older *buildapi.Pipeline) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&pipeline.ObjectMeta, &older.ObjectMeta).Prefix("metadata")...)
+
+	allErrs = append(allErrs, ValidatePipeline(pipeline)...)
+
+	if !kapi.Semantic.DeepEqual(pipeline.Spec, older.Spec) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec", pipeline.Spec, "spec is immutable"))
+	}
+	return allErrs
+}
+
+var validPipelineStageTypes = []string{
+	string(buildapi.PipelineStageBuild),
+	string(buildapi.PipelineStageDeploy),
+	string(buildapi.PipelineStageVerify),
+	string(buildapi.PipelineStagePromote),
+}
+
+func validatePipelineSpec(spec *buildapi.PipelineSpec, namespace string) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if len(spec.Stages) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("stages"))
+		return allErrs
+	}
+	names := util.NewStringSet()
+	hasBuild := false
+	for i := range spec.Stages {
+		stage := &spec.Stages[i]
+		stageErrs := validatePipelineStage(stage, hasBuild, namespace)
+		if len(stage.Name) > 0 {
+			if names.Has(stage.Name) {
+				stageErrs = append(stageErrs, fielderrors.NewFieldDuplicate("name", stage.Name))
+			}
+			names.Insert(stage.Name)
+		}
+		allErrs = append(allErrs, stageErrs.PrefixIndex(i).Prefix("stages")...)
+		hasBuild = hasBuild || stage.Type == buildapi.PipelineStageBuild
+	}
+	return allErrs
+}
+
+// validatePipelineStage validates a stage. afterBuild is true if an earlier stage
+// of the pipeline is a Build stage. Promote stages may only reference ImageStreamTags
+// in namespace, the namespace of the pipeline.
+func validatePipelineStage(stage *buildapi.PipelineStage, afterBuild bool, namespace string) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if len(stage.Name) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("name"))
+	} else if !util.IsDNS1123Label(stage.Name) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("name", stage.Name, "name must be a DNS label"))
+	}
+
+	switch stage.Type {
+	case buildapi.PipelineStageBuild:
+		if stage.Build == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("build"))
+		} else if len(stage.Build.BuildConfig.Name) == 0 {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("build.buildConfig.name"))
+		}
+	case buildapi.PipelineStageDeploy:
+		if stage.Deploy == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("deploy"))
+		} else if len(stage.Deploy.DeploymentConfig.Name) == 0 {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("deploy.deploymentConfig.name"))
+		}
+	case buildapi.PipelineStageVerify:
+		if stage.Verify == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("verify"))
+		} else if len(stage.Verify.Image) == 0 {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("verify.image"))
+		}
+	case buildapi.PipelineStagePromote:
+		if stage.Promote == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("promote"))
+			break
+		}
+		if stage.Promote.From != nil {
+			allErrs = append(allErrs, validateImageStreamTagReference(stage.Promote.From, namespace).Prefix("promote.from")...)
+		} else if !afterBuild {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("promote.from"))
+		}
+		allErrs = append(allErrs, validateImageStreamTagReference(&stage.Promote.To, namespace).Prefix("promote.to")...)
+	case "":
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("type"))
+	default:
+		allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("type", stage.Type, validPipelineStageTypes))
+	}
+	return allErrs
+}
+
+func validateImageStreamTagReference(ref *kapi.ObjectReference, namespace string) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if ref.Kind != "ImageStreamTag" {
+		allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("kind", ref.Kind, []string{"ImageStreamTag"}))
+	}
+	if len(ref.Namespace) > 0 && ref.Namespace != namespace {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("namespace", ref.Namespace, "ImageStreamTag must be in the namespace of the pipeline"))
+	}
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("name"))
+	} else if _, _, ok := imageapi.SplitImageStreamTag(ref.Name); !ok {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("name", ref.Name, "ImageStreamTag must be of the form <stream_name>:<tag>"))
+	}
 	return allErrs
 }
 
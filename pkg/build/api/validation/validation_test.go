@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -218,6 +219,87 @@ func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	}
 }
 
+func TestBuildConfigValidationFailureHistoryLimits(t *testing.T) {
+	negative := -1
+	buildConfig := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type: buildapi.BuildSourceGit,
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type:           buildapi.DockerBuildStrategyType,
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+			},
+			SuccessfulBuildsHistoryLimit: &negative,
+			FailedBuildsHistoryLimit:     &negative,
+		},
+	}
+	errors := ValidateBuildConfig(buildConfig)
+	if len(errors) != 2 {
+		t.Fatalf("Unexpected validation errors %v", errors)
+	}
+	for i, field := range []string{"spec.successfulBuildsHistoryLimit", "spec.failedBuildsHistoryLimit"} {
+		err := errors[i].(*fielderrors.ValidationError)
+		if err.Type != fielderrors.ValidationErrorTypeInvalid || err.Field != field {
+			t.Errorf("Unexpected error %v, expected an invalid %s", err, field)
+		}
+	}
+}
+
+func TestBuildConfigValidationRunPolicy(t *testing.T) {
+	buildConfig := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type: buildapi.BuildSourceGit,
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type:           buildapi.DockerBuildStrategyType,
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+			},
+		},
+	}
+	for _, policy := range []buildapi.BuildRunPolicy{"", buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly} {
+		buildConfig.Spec.RunPolicy = policy
+		if errors := ValidateBuildConfig(buildConfig); len(errors) != 0 {
+			t.Errorf("Unexpected validation errors for run policy %q: %v", policy, errors)
+		}
+	}
+
+	buildConfig.Spec.RunPolicy = "Sometimes"
+	errors := ValidateBuildConfig(buildConfig)
+	if len(errors) != 1 {
+		t.Fatalf("Unexpected validation errors %v", errors)
+	}
+	err := errors[0].(*fielderrors.ValidationError)
+	if err.Type != fielderrors.ValidationErrorTypeNotSupported || err.Field != "spec.runPolicy" {
+		t.Errorf("Unexpected error %v, expected an unsupported spec.runPolicy", err)
+	}
+}
+
 func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -503,6 +585,14 @@ func TestValidateBuildRequest(t *testing.T) {
 	testCases := map[string]*buildapi.BuildRequest{
 		string(fielderrors.ValidationErrorTypeRequired) + "metadata.namespace": {ObjectMeta: kapi.ObjectMeta{Name: "requestName"}},
 		string(fielderrors.ValidationErrorTypeRequired) + "metadata.name":      {ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault}},
+		string(fielderrors.ValidationErrorTypeInvalid) + "binary.asFile": {
+			ObjectMeta: kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			Binary:     &buildapi.BinaryBuildSource{AsFile: ".."},
+		},
+		"": {
+			ObjectMeta: kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			Binary:     &buildapi.BinaryBuildSource{AsFile: "app.war"},
+		},
 	}
 
 	for desc, tc := range testCases {
@@ -524,6 +614,7 @@ func TestValidateBuildRequest(t *testing.T) {
 }
 
 func TestValidateSource(t *testing.T) {
+	longDockerfile := strings.Repeat("RUN echo\n", 10000)
 	errorCases := map[string]*buildapi.BuildSource{
 		string(fielderrors.ValidationErrorTypeRequired) + "git.uri": {
 			Type: buildapi.BuildSourceGit,
@@ -537,6 +628,89 @@ func TestValidateSource(t *testing.T) {
 				URI: "::",
 			},
 		},
+		string(fielderrors.ValidationErrorTypeRequired) + "binary": {
+			Type: buildapi.BuildSourceBinary,
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "binary.asFile": {
+			Type: buildapi.BuildSourceBinary,
+			Binary: &buildapi.BinaryBuildSource{
+				AsFile: "deployments/app.war",
+			},
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "dockerfile": {
+			Type: buildapi.BuildSourceDockerfile,
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "dockerfile": {
+			Type:       buildapi.BuildSourceDockerfile,
+			Dockerfile: &longDockerfile,
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "images": {
+			Type: buildapi.BuildSourceImage,
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "images[0].paths": {
+			Type: buildapi.BuildSourceImage,
+			Images: []buildapi.ImageSource{
+				{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "artifacts:latest"}},
+			},
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "images[0].from.kind": {
+			Type: buildapi.BuildSourceImage,
+			Images: []buildapi.ImageSource{
+				{
+					From:  kapi.ObjectReference{Kind: "Image", Name: "artifacts"},
+					Paths: []buildapi.ImageSourcePath{{SourcePath: "/opt/app/app.war"}},
+				},
+			},
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "images[0].paths[0].sourcePath": {
+			Type: buildapi.BuildSourceImage,
+			Images: []buildapi.ImageSource{
+				{
+					From:  kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/artifacts"},
+					Paths: []buildapi.ImageSourcePath{{SourcePath: "opt/app/app.war"}},
+				},
+			},
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "images[0].paths[0].destinationDir": {
+			Type: buildapi.BuildSourceGit,
+			Git: &buildapi.GitBuildSource{
+				URI: "http://github.com/my/repository",
+			},
+			Images: []buildapi.ImageSource{
+				{
+					From:  kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/artifacts"},
+					Paths: []buildapi.ImageSourcePath{{SourcePath: "/opt/app/app.war", DestinationDir: "../deployments"}},
+				},
+			},
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "secrets[0].secret.name": {
+			Type: buildapi.BuildSourceGit,
+			Git: &buildapi.GitBuildSource{
+				URI: "http://github.com/my/repository",
+			},
+			Secrets: []buildapi.SecretBuildSource{
+				{DestinationDir: "settings"},
+			},
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "secrets[0].destinationDir": {
+			Type: buildapi.BuildSourceGit,
+			Git: &buildapi.GitBuildSource{
+				URI: "http://github.com/my/repository",
+			},
+			Secrets: []buildapi.SecretBuildSource{
+				{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: "/root/.m2"},
+			},
+		},
+		string(fielderrors.ValidationErrorTypeDuplicate) + "secrets[1].secret.name": {
+			Type: buildapi.BuildSourceGit,
+			Git: &buildapi.GitBuildSource{
+				URI: "http://github.com/my/repository",
+			},
+			Secrets: []buildapi.SecretBuildSource{
+				{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: "settings"},
+				{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: "other"},
+			},
+		},
 	}
 	for desc, config := range errorCases {
 		errors := validateSource(config)
@@ -551,7 +725,61 @@ func TestValidateSource(t *testing.T) {
 	}
 }
 
+func TestValidateDockerStrategyEnv(t *testing.T) {
+	secretRef := func(name, key string) *buildapi.BuildEnvVarSource {
+		return &buildapi.BuildEnvVarSource{
+			SecretKeyRef: &buildapi.SecretKeySelector{
+				LocalObjectReference: kapi.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+	errorCases := map[string][]buildapi.BuildEnvVar{
+		string(fielderrors.ValidationErrorTypeRequired) + "env[0].name": {
+			{Value: "value"},
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "env[1].name": {
+			{Name: "VALID", Value: "value"},
+			{Name: "NOT-VALID", Value: "value"},
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "env[0].valueFrom.secretKeyRef": {
+			{Name: "TOKEN", ValueFrom: &buildapi.BuildEnvVarSource{}},
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "env[0].valueFrom.secretKeyRef.name": {
+			{Name: "TOKEN", ValueFrom: secretRef("", "token")},
+		},
+		string(fielderrors.ValidationErrorTypeRequired) + "env[0].valueFrom.secretKeyRef.key": {
+			{Name: "TOKEN", ValueFrom: secretRef("credentials", "")},
+		},
+		string(fielderrors.ValidationErrorTypeInvalid) + "env[0].valueFrom": {
+			{Name: "TOKEN", Value: "value", ValueFrom: secretRef("credentials", "token")},
+		},
+	}
+	for desc, env := range errorCases {
+		errors := validateDockerStrategy(&buildapi.DockerBuildStrategy{Env: env})
+		if len(errors) != 1 {
+			t.Errorf("%s: Unexpected validation result: %v", desc, errors)
+			continue
+		}
+		err := errors[0].(*fielderrors.ValidationError)
+		errDesc := string(err.Type) + err.Field
+		if desc != errDesc {
+			t.Errorf("Unexpected validation result for %s: expected %s, got %s", err.Field, desc, errDesc)
+		}
+	}
+
+	valid := []buildapi.BuildEnvVar{
+		{Name: "DEPLOY_ENV", Value: "staging"},
+		{Name: "TOKEN", ValueFrom: secretRef("credentials", "token")},
+	}
+	if errors := validateDockerStrategy(&buildapi.DockerBuildStrategy{Env: valid}); len(errors) != 0 {
+		t.Errorf("Unexpected validation errors: %v", errors)
+	}
+}
+
 func TestValidateBuildSpec(t *testing.T) {
+	dockerfile := "FROM centos7\n"
+	zero := int64(0)
 	errorCases := []struct {
 		err string
 		*buildapi.BuildSpec
@@ -856,6 +1084,81 @@ func TestValidateBuildSpec(t *testing.T) {
 				},
 			},
 		},
+		// 13
+		// invalid because an inline Dockerfile is only supported
+		// by the Docker strategy
+		{
+			string(fielderrors.ValidationErrorTypeInvalid) + "source.dockerfile",
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type:       buildapi.BuildSourceDockerfile,
+					Dockerfile: &dockerfile,
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type: buildapi.SourceBuildStrategyType,
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "reponame",
+						},
+					},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+			},
+		},
+		// 14
+		// invalid because the post commit hook specifies both
+		// a script and a command
+		{
+			string(fielderrors.ValidationErrorTypeInvalid) + "postCommit.script",
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type:       buildapi.BuildSourceDockerfile,
+					Dockerfile: &dockerfile,
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type:           buildapi.DockerBuildStrategyType,
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+				PostCommit: buildapi.BuildPostCommitSpec{
+					Command: []string{"rake", "test"},
+					Script:  "rake test",
+				},
+			},
+		},
+		// 15
+		// invalid because the completion deadline is not positive
+		{
+			string(fielderrors.ValidationErrorTypeInvalid) + "completionDeadlineSeconds",
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type:       buildapi.BuildSourceDockerfile,
+					Dockerfile: &dockerfile,
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type:           buildapi.DockerBuildStrategyType,
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+				CompletionDeadlineSeconds: &zero,
+			},
+		},
 	}
 
 	for count, config := range errorCases {
@@ -872,6 +1175,7 @@ func TestValidateBuildSpec(t *testing.T) {
 }
 
 func TestValidateBuildSpecSuccess(t *testing.T) {
+	dockerfile := "FROM centos7\n"
 	testCases := []struct {
 		*buildapi.BuildSpec
 	}{
@@ -974,6 +1278,74 @@ func TestValidateBuildSpecSuccess(t *testing.T) {
 				},
 			},
 		},
+		// 4
+		{
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type: buildapi.BuildSourceBinary,
+					Binary: &buildapi.BinaryBuildSource{
+						AsFile: "app.war",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type: buildapi.SourceBuildStrategyType,
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "reponame",
+						},
+					},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+			},
+		},
+		// 5
+		{
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type:       buildapi.BuildSourceDockerfile,
+					Dockerfile: &dockerfile,
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type:           buildapi.DockerBuildStrategyType,
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+			},
+		},
+		// 6
+		{
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Type:       buildapi.BuildSourceDockerfile,
+					Dockerfile: &dockerfile,
+				},
+				Strategy: buildapi.BuildStrategy{
+					Type:           buildapi.DockerBuildStrategyType,
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+				PostCommit: buildapi.BuildPostCommitSpec{
+					Script: "bundle exec rake test",
+					Args:   []string{"--verbose"},
+				},
+			},
+		},
 	}
 
 	for count, config := range testCases {
@@ -1040,6 +1412,48 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("generic")},
 		},
+		"GitHub trigger with an empty ref pattern": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+					Refs:   []string{"feature/*", ""},
+				},
+			},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("github.refs[1]")},
+		},
+		"GitHub trigger with an invalid ref pattern": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+					Refs:   []string{"feature/["},
+				},
+			},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldInvalid("github.refs[0]", "feature/[", "")},
+		},
+		"GitLab type with no gitlab webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GitLabWebHookBuildTriggerType},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("gitlab")},
+		},
+		"GitLab trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:          buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("gitlab.secret")},
+		},
+		"Bitbucket type with no bitbucket webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.BitbucketWebHookBuildTriggerType},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("bitbucket")},
+		},
+		"Bitbucket trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:             buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("bitbucket.secret")},
+		},
 		"ImageChange trigger without params": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1062,6 +1476,32 @@ func TestValidateTrigger(t *testing.T) {
 				},
 			},
 		},
+		"valid GitLab trigger": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+		},
+		"valid GitHub trigger with ref patterns": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:         "secret101",
+					Refs:           []string{"master", "feature/*", "v[0-9]*"},
+					BuildPushedRef: true,
+				},
+			},
+		},
+		"valid Bitbucket trigger": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+		},
 		"valid ImageChange trigger": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1103,3 +1543,122 @@ func TestValidateTrigger(t *testing.T) {
 		}
 	}
 }
+
+func TestValidatePipeline(t *testing.T) {
+	buildStage := buildapi.PipelineStage{
+		Name:  "build",
+		Type:  buildapi.PipelineStageBuild,
+		Build: &buildapi.BuildStage{BuildConfig: kapi.LocalObjectReference{Name: "frontend"}},
+	}
+	promoteStage := buildapi.PipelineStage{
+		Name:    "promote",
+		Type:    buildapi.PipelineStagePromote,
+		Promote: &buildapi.PromoteStage{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "frontend:prod"}},
+	}
+	pipeline := func(stages ...buildapi.PipelineStage) *buildapi.Pipeline {
+		return &buildapi.Pipeline{
+			ObjectMeta: kapi.ObjectMeta{Name: "release", Namespace: "default"},
+			Spec:       buildapi.PipelineSpec{Stages: stages},
+		}
+	}
+
+	valid := pipeline(
+		buildStage,
+		buildapi.PipelineStage{
+			Name:   "deploy",
+			Type:   buildapi.PipelineStageDeploy,
+			Deploy: &buildapi.DeployStage{DeploymentConfig: kapi.LocalObjectReference{Name: "frontend"}},
+		},
+		buildapi.PipelineStage{
+			Name:   "verify",
+			Type:   buildapi.PipelineStageVerify,
+			Verify: &buildapi.VerifyStage{Image: "tests", Command: []string{"run-tests"}},
+		},
+		promoteStage,
+	)
+	if errs := ValidatePipeline(valid); len(errs) != 0 {
+		t.Errorf("Unexpected validation errors: %v", errs)
+	}
+
+	errorCases := map[string]*buildapi.Pipeline{
+		string(fielderrors.ValidationErrorTypeRequired) + "spec.stages": pipeline(),
+		string(fielderrors.ValidationErrorTypeDuplicate) + "spec.stages[1].name": pipeline(
+			buildStage, buildStage,
+		),
+		string(fielderrors.ValidationErrorTypeInvalid) + "spec.stages[0].name": pipeline(
+			buildapi.PipelineStage{Name: "Build_It", Type: buildapi.PipelineStageBuild, Build: buildStage.Build},
+		),
+		string(fielderrors.ValidationErrorTypeNotSupported) + "spec.stages[0].type": pipeline(
+			buildapi.PipelineStage{Name: "test", Type: "Test"},
+		),
+		string(fielderrors.ValidationErrorTypeRequired) + "spec.stages[0].build.buildConfig.name": pipeline(
+			buildapi.PipelineStage{Name: "build", Type: buildapi.PipelineStageBuild, Build: &buildapi.BuildStage{}},
+		),
+		string(fielderrors.ValidationErrorTypeRequired) + "spec.stages[0].deploy": pipeline(
+			buildapi.PipelineStage{Name: "deploy", Type: buildapi.PipelineStageDeploy},
+		),
+		string(fielderrors.ValidationErrorTypeRequired) + "spec.stages[0].verify.image": pipeline(
+			buildapi.PipelineStage{Name: "verify", Type: buildapi.PipelineStageVerify, Verify: &buildapi.VerifyStage{}},
+		),
+		string(fielderrors.ValidationErrorTypeRequired) + "spec.stages[0].promote.from": pipeline(
+			promoteStage,
+		),
+		string(fielderrors.ValidationErrorTypeInvalid) + "spec.stages[1].promote.to.name": pipeline(
+			buildStage,
+			buildapi.PipelineStage{
+				Name:    "promote",
+				Type:    buildapi.PipelineStagePromote,
+				Promote: &buildapi.PromoteStage{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "frontend"}},
+			},
+		),
+		string(fielderrors.ValidationErrorTypeInvalid) + "spec.stages[1].promote.to.namespace": pipeline(
+			buildStage,
+			buildapi.PipelineStage{
+				Name:    "promote",
+				Type:    buildapi.PipelineStagePromote,
+				Promote: &buildapi.PromoteStage{To: kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "prod", Name: "frontend:prod"}},
+			},
+		),
+		string(fielderrors.ValidationErrorTypeInvalid) + "spec.stages[0].promote.from.namespace": pipeline(
+			buildapi.PipelineStage{
+				Name: "promote",
+				Type: buildapi.PipelineStagePromote,
+				Promote: &buildapi.PromoteStage{
+					From: &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "frontend:latest"},
+					To:   kapi.ObjectReference{Kind: "ImageStreamTag", Name: "frontend:prod"},
+				},
+			},
+		),
+		string(fielderrors.ValidationErrorTypeNotSupported) + "spec.stages[1].promote.from.kind": pipeline(
+			buildStage,
+			buildapi.PipelineStage{
+				Name: "promote",
+				Type: buildapi.PipelineStagePromote,
+				Promote: &buildapi.PromoteStage{
+					From: &kapi.ObjectReference{Kind: "DockerImage", Name: "frontend:latest"},
+					To:   kapi.ObjectReference{Kind: "ImageStreamTag", Name: "frontend:prod"},
+				},
+			},
+		),
+	}
+	for desc, p := range errorCases {
+		errors := ValidatePipeline(p)
+		if len(errors) != 1 {
+			t.Errorf("%s: Unexpected validation result: %v", desc, errors)
+			continue
+		}
+		err := errors[0].(*fielderrors.ValidationError)
+		errDesc := string(err.Type) + err.Field
+		if desc != errDesc {
+			t.Errorf("Unexpected validation result for %s: expected %s, got %s", err.Field, desc, errDesc)
+		}
+	}
+
+	updated := pipeline(buildStage, promoteStage)
+	updated.ResourceVersion = "1"
+	older := pipeline(buildStage)
+	older.ResourceVersion = "1"
+	if errs := ValidatePipelineUpdate(updated, older); len(errs) != 1 {
+		t.Errorf("Expected the stages to be immutable, got: %v", errs)
+	}
+}
@@ -14,9 +14,14 @@ import (
 	bld "github.com/openshift/origin/pkg/build/builder"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
 	"github.com/openshift/origin/pkg/build/builder/cmd/scmauth"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	dockerutil "github.com/openshift/origin/pkg/cmd/util/docker"
 )
 
+// terminationMessagePath is the default path of the file the kubelet reads the
+// termination message of the build container from.
+const terminationMessagePath = "/dev/termination-log"
+
 type builder interface {
 	Build() error
 }
@@ -54,11 +59,14 @@ func run(builderFactory factoryFunc, scmAuths []scmauth.SCMAuth) {
 	}
 	if build.Spec.Source.SourceSecret != nil {
 		if err := setupSourceSecret(build.Spec.Source.SourceSecret.Name, scmAuths); err != nil {
-			glog.Fatalf("Cannot setup secret file for accessing private repository: %v", err)
+			err = fmt.Errorf("Cannot setup secret file for accessing private repository: %v", err)
+			writeTerminationMessage(api.StatusReasonFetchSourceFailed, err)
+			glog.Fatal(err)
 		}
 	}
 	b := builderFactory(client, endpoint, authcfg, authPresent, &build)
 	if err = b.Build(); err != nil {
+		writeTerminationMessage(bld.ReasonForError(err), err)
 		glog.Fatalf("Build error: %v", err)
 	}
 	if !output {
@@ -67,6 +75,16 @@ func run(builderFactory factoryFunc, scmAuths []scmauth.SCMAuth) {
 
 }
 
+// writeTerminationMessage records the reason of a failed build in the
+// termination message of the build container, where the build controller picks
+// it up to update the status of the build.
+func writeTerminationMessage(reason api.StatusReason, err error) {
+	message := buildutil.FormatTerminationMessage(reason, err.Error())
+	if err := ioutil.WriteFile(terminationMessagePath, []byte(message), 0644); err != nil {
+		glog.V(2).Infof("Unable to write the termination message: %v", err)
+	}
+}
+
 // fixSecretPermissions loweres access permissions to very low acceptable level
 // TODO: this method should be removed as soon as secrets permissions are fixed upstream
 func fixSecretPermissions() error {
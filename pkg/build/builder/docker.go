@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"net"
-	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -21,6 +19,8 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
+	"github.com/openshift/origin/pkg/generate/dockerfile"
+	stidocker "github.com/openshift/source-to-image/pkg/docker"
 	"github.com/openshift/source-to-image/pkg/git"
 	"github.com/openshift/source-to-image/pkg/tar"
 	"github.com/openshift/source-to-image/pkg/util"
@@ -46,6 +46,8 @@ type DockerBuilder struct {
 	tar          tar.Tar
 	build        *api.Build
 	urlTimeout   time.Duration
+	// in is the stream the binary input of the build is read from
+	in io.Reader
 }
 
 // MetaInstuction represent an Docker instruction used for adding metadata
@@ -69,6 +71,7 @@ func NewDockerBuilder(dockerClient DockerClient, authCfg docker.AuthConfiguratio
 		git:          git.New(),
 		tar:          tar.New(),
 		urlTimeout:   urlCheckTimeout,
+		in:           os.Stdin,
 	}
 }
 
@@ -79,7 +82,11 @@ func (d *DockerBuilder) Build() error {
 		return err
 	}
 	if err = d.fetchSource(buildDir); err != nil {
-		return err
+		return newBuildError(api.StatusReasonFetchSourceFailed, err)
+	}
+	secretFiles, err := copyBuildSecrets(d.build.Spec.Source.Secrets, filepath.Join(buildDir, d.build.Spec.Source.ContextDir))
+	if err != nil {
+		return newBuildError(api.StatusReasonFetchSourceFailed, err)
 	}
 	if err = d.addBuildParameters(buildDir); err != nil {
 		return err
@@ -99,12 +106,24 @@ func (d *DockerBuilder) Build() error {
 		push = true
 	}
 
+	if err = d.pullBaseImage(buildDir); err != nil {
+		return newBuildError(api.StatusReasonPullBuilderImageFailed, err)
+	}
+
 	if err = d.dockerBuild(buildDir); err != nil {
-		return err
+		return newBuildError(api.StatusReasonAssembleFailed, err)
 	}
 
 	defer removeImage(d.dockerClient, d.build.Spec.Output.To.Name)
 
+	if err := squashBuildSecrets(d.dockerClient, d.build.Spec.Output.To.Name, secretFiles); err != nil {
+		return newBuildError(api.StatusReasonAssembleFailed, err)
+	}
+
+	if err := execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, d.build.Spec.Output.To.Name); err != nil {
+		return newBuildError(api.StatusReasonPostCommitHookFailed, err)
+	}
+
 	if push {
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
@@ -117,116 +136,51 @@ func (d *DockerBuilder) Build() error {
 		}
 		glog.Infof("Pushing %s image ...", d.build.Spec.Output.To.Name)
 		if err := pushImage(d.dockerClient, d.build.Spec.Output.To.Name, d.auth); err != nil {
-			return fmt.Errorf("Failed to push image: %v", err)
+			return newBuildError(api.StatusReasonPushImageFailed, fmt.Errorf("Failed to push image: %v", err))
 		}
 		glog.Infof("Successfully pushed %s", d.build.Spec.Output.To.Name)
 	}
 	return nil
 }
 
-// checkSourceURI performs a check on the URI associated with the build
-// to make sure that it is live before proceeding with the build.
-func (d *DockerBuilder) checkSourceURI() error {
-	rawurl := d.build.Spec.Source.Git.URI
-	if !d.git.ValidCloneSpec(rawurl) {
-		return fmt.Errorf("Invalid git source url: %s", rawurl)
-	}
-	if strings.HasPrefix(rawurl, "git://") || strings.HasPrefix(rawurl, "git@") {
-		return nil
-	}
-	if !strings.HasPrefix(rawurl, "http://") && !strings.HasPrefix(rawurl, "https://") {
-		rawurl = fmt.Sprintf("https://%s", rawurl)
-	}
-	srcURL, err := url.Parse(rawurl)
-	if err != nil {
-		return err
-	}
-	host := srcURL.Host
-	if strings.Index(host, ":") == -1 {
-		switch srcURL.Scheme {
-		case "http":
-			host += ":80"
-		case "https":
-			host += ":443"
-		}
-	}
-	dialer := net.Dialer{Timeout: d.urlTimeout}
-	conn, err := dialer.Dial("tcp", host)
-	if err != nil {
-		return err
-	}
-	return conn.Close()
-
-}
-
-// fetchSource retrieves the git source from the repository. If a commit ID
-// is included in the build revision, that commit ID is checked out. Otherwise
-// if a ref is included in the source definition, that ref is checked out.
+// fetchSource retrieves the source of the build into dir. Binary builds receive
+// their source from the client, Git builds clone the repository. The paths of the
+// image sources are then copied on top of it. When the source contains an inline
+// Dockerfile it is written into the context dir, replacing any Dockerfile
+// provided by the repository.
 func (d *DockerBuilder) fetchSource(dir string) error {
-	if err := d.checkSourceURI(); err != nil {
-		return err
-	}
-	origProxy := make(map[string]string)
-	var setHttp, setHttps bool
-	// set the http proxy to be used by the git clone performed by S2I
-	if len(d.build.Spec.Source.Git.HTTPSProxy) != 0 {
-		glog.V(2).Infof("Setting https proxy variables for Git to %s", d.build.Spec.Source.Git.HTTPSProxy)
-		origProxy["HTTPS_PROXY"] = os.Getenv("HTTPS_PROXY")
-		origProxy["https_proxy"] = os.Getenv("https_proxy")
-		os.Setenv("HTTPS_PROXY", d.build.Spec.Source.Git.HTTPSProxy)
-		os.Setenv("https_proxy", d.build.Spec.Source.Git.HTTPSProxy)
-		setHttps = true
-	}
-	if len(d.build.Spec.Source.Git.HTTPProxy) != 0 {
-		glog.V(2).Infof("Setting http proxy variables for Git to %s", d.build.Spec.Source.Git.HTTPSProxy)
-		origProxy["HTTP_PROXY"] = os.Getenv("HTTP_PROXY")
-		origProxy["http_proxy"] = os.Getenv("http_proxy")
-		os.Setenv("HTTP_PROXY", d.build.Spec.Source.Git.HTTPProxy)
-		os.Setenv("http_proxy", d.build.Spec.Source.Git.HTTPProxy)
-		setHttp = true
-	}
-	defer func() {
-		// reset http proxy env variables to original value
-		if setHttps {
-			glog.V(4).Infof("Resetting HTTPS_PROXY variable for Git to %s", origProxy["HTTPS_PROXY"])
-			os.Setenv("HTTPS_PROXY", origProxy["HTTPS_PROXY"])
-			glog.V(4).Infof("Resetting https_proxy variable for Git to %s", origProxy["https_proxy"])
-			os.Setenv("https_proxy", origProxy["https_proxy"])
+	source := d.build.Spec.Source
+	switch {
+	case source.Binary != nil:
+		if err := extractInputBinary(d.in, source.Binary, dir); err != nil {
+			return err
 		}
-		if setHttp {
-			glog.V(4).Infof("Resetting HTTP_PROXY variable for Git to %s", origProxy["HTTP_PROXY"])
-			os.Setenv("HTTP_PROXY", origProxy["HTTP_PROXY"])
-			glog.V(4).Infof("Resetting http_proxy variable for Git to %s", origProxy["http_proxy"])
-			os.Setenv("http_proxy", origProxy["http_proxy"])
+	case source.Git != nil:
+		if err := fetchGitSource(d.git, d.build, dir, d.urlTimeout); err != nil {
+			return err
 		}
-	}()
-
-	if err := d.git.Clone(d.build.Spec.Source.Git.URI, dir); err != nil {
-		return err
 	}
-
-	if d.build.Spec.Source.Git.Ref == "" &&
-		(d.build.Spec.Revision == nil ||
-			d.build.Spec.Revision.Git == nil ||
-			d.build.Spec.Revision.Git.Commit == "") {
+	if len(source.Images) > 0 {
+		if err := extractSourceFromImages(d.dockerClient, source.Images, dir); err != nil {
+			return err
+		}
+	}
+	if source.Dockerfile == nil {
 		return nil
 	}
-	if d.build.Spec.Revision != nil &&
-		d.build.Spec.Revision.Git != nil &&
-		d.build.Spec.Revision.Git.Commit != "" {
-		return d.git.Checkout(dir, d.build.Spec.Revision.Git.Commit)
+	contextDir := filepath.Join(dir, source.ContextDir)
+	if err := os.MkdirAll(contextDir, 0755); err != nil {
+		return err
 	}
-	return d.git.Checkout(dir, d.build.Spec.Source.Git.Ref)
+	glog.V(4).Infof("Writing the inline Dockerfile to %s", contextDir)
+	return ioutil.WriteFile(filepath.Join(contextDir, "Dockerfile"), []byte(*source.Dockerfile), 0644)
 }
 
 // addBuildParameters checks if a Image is set to replace the default base image.
 // If that's the case then change the Dockerfile to make the build with the given image.
 // Also append the environment variables and labels in the Dockerfile.
 func (d *DockerBuilder) addBuildParameters(dir string) error {
-	dockerfilePath := filepath.Join(dir, "Dockerfile")
-	if d.build.Spec.Strategy.DockerStrategy != nil && len(d.build.Spec.Source.ContextDir) > 0 {
-		dockerfilePath = filepath.Join(dir, d.build.Spec.Source.ContextDir, "Dockerfile")
-	}
+	dockerfilePath := d.dockerfilePath(dir)
 
 	fileStat, err := os.Lstat(dockerfilePath)
 	if err != nil {
@@ -240,26 +194,37 @@ func (d *DockerBuilder) addBuildParameters(dir string) error {
 		return err
 	}
 
-	var newFileData string
+	newFileData := string(fileData)
 	if d.build.Spec.Strategy.DockerStrategy.From != nil && d.build.Spec.Strategy.DockerStrategy.From.Kind == "DockerImage" {
-		newFileData, err = replaceValidCmd(dockercmd.From, d.build.Spec.Strategy.DockerStrategy.From.Name, fileData)
+		newFileData, err = replaceLastFrom(newFileData, d.build.Spec.Strategy.DockerStrategy.From.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if env := d.build.Spec.Strategy.DockerStrategy.Env; len(env) > 0 {
+		resolved, err := resolveBuildEnv(env)
+		if err != nil {
+			return err
+		}
+		newFileData, err = insertEnvAfterFrom(newFileData, resolved)
 		if err != nil {
 			return err
 		}
-	} else {
-		newFileData = newFileData + string(fileData)
 	}
 
 	envVars := getBuildEnvVars(d.build)
 	newFileData = appendMetadata(Env, newFileData, envVars)
 
-	labels := map[string]string{}
-	sourceInfo := d.git.GetInfo(dir)
-	if len(d.build.Spec.Source.ContextDir) > 0 {
-		sourceInfo.ContextDir = d.build.Spec.Source.ContextDir
+	if d.build.Spec.Source.Git != nil {
+		labels := map[string]string{}
+		sourceInfo := d.git.GetInfo(dir)
+		if len(d.build.Spec.Source.ContextDir) > 0 {
+			sourceInfo.ContextDir = d.build.Spec.Source.ContextDir
+		}
+		labels = util.GenerateLabelsFromSourceInfo(labels, sourceInfo, DefaultDockerLabelNamespace)
+		newFileData = appendMetadata(Label, newFileData, labels)
 	}
-	labels = util.GenerateLabelsFromSourceInfo(labels, sourceInfo, DefaultDockerLabelNamespace)
-	newFileData = appendMetadata(Label, newFileData, labels)
 
 	if ioutil.WriteFile(dockerfilePath, []byte(newFileData), filePerm); err != nil {
 		return err
@@ -287,136 +252,68 @@ func appendMetadata(inst MetaInstruction, fileData string, envVars map[string]st
 	return fileData
 }
 
-// invalidCmdErr represents an error returned from replaceValidCmd
-// when an invalid Dockerfile command has been passed to
-// replaceValidCmd
-var invalidCmdErr = errors.New("invalid Dockerfile command")
-
-// replaceCmdErr represents an error returned from replaceValidCmd
-// when a command which has more than one valid occurrences inside
-// a Dockerfile has been passed or the specified command cannot
-// be found
-var replaceCmdErr = errors.New("cannot replace given Dockerfile command")
-
-// replaceValidCmd replaces the valid occurrence of a command
-// in a Dockerfile with the given replaceArgs
-func replaceValidCmd(cmd, replaceArgs string, fileData []byte) (string, error) {
-	if _, ok := dockercmd.Commands[cmd]; !ok {
-		return "", invalidCmdErr
-	}
-	buf := bytes.NewBuffer(fileData)
-	// Parse with Docker parser
-	node, err := parser.Parse(buf)
-	if err != nil {
-		return "", errors.New("cannot parse Dockerfile: " + err.Error())
-	}
+// envValueReplacer escapes the characters the Dockerfile parser would otherwise
+// interpret in a double quoted ENV value.
+var envValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`)
 
-	pos := traverseAST(cmd, node)
-	if pos == 0 {
-		return "", replaceCmdErr
+// insertEnvAfterFrom inserts an ENV instruction with the given variables right
+// after the last FROM instruction of a Dockerfile, so they override the
+// environment of the base image and are visible to all the instructions that
+// follow it.
+func insertEnvAfterFrom(fileData string, env []kapi.EnvVar) (string, error) {
+	if len(env) == 0 {
+		return fileData, nil
+	}
+	_, insertAt, ok := dockerfile.FindLastDirective(fileData, dockercmd.From)
+	if !ok {
+		return "", noFromErr
 	}
+	lines := strings.Split(fileData, "\n")
 
-	// Re-initialize the buffer
-	buf = bytes.NewBuffer(fileData)
-	var newFileData string
-	var index int
-	var replaceNextLn bool
-	for {
-		line, err := buf.ReadString('\n')
-		if err != nil && err != io.EOF {
-			return "", err
+	instruction := string(Env)
+	for i, ev := range env {
+		if strings.Contains(ev.Value, "\n") {
+			return "", fmt.Errorf("the value of %s cannot span multiple lines of a Dockerfile", ev.Name)
 		}
-		line = strings.TrimSpace(line)
-
-		// The current line starts with the specified command (cmd)
-		if strings.HasPrefix(strings.ToUpper(line), strings.ToUpper(cmd)) {
-			index++
-
-			// The current line finishes on a backslash.
-			// All we need to do is replace the next line
-			// with our specified replaceArgs
-			if line[len(line)-1:] == "\\" && index == pos {
-				replaceNextLn = true
-
-				args := strings.Split(line, " ")
-				if len(args) > 2 {
-					// Keep just our Dockerfile command and the backslash
-					newFileData += args[0] + " \\" + "\n"
-				} else {
-					newFileData += line + "\n"
-				}
-				continue
-			}
-
-			// Normal ending line
-			if index == pos {
-				line = fmt.Sprintf("%s %s", strings.ToUpper(cmd), replaceArgs)
-			}
+		if i > 0 {
+			instruction += " \\\n\t"
+		} else {
+			instruction += " "
 		}
+		instruction += fmt.Sprintf("%s=\"%s\"", ev.Name, envValueReplacer.Replace(ev.Value))
+	}
 
-		// Previous line ended on a backslash
-		// This line contains command arguments
-		if replaceNextLn {
-			if line[len(line)-1:] == "\\" {
-				// Ignore all successive lines terminating on a backslash
-				// since they all are going to be replaced by replaceArgs
-				continue
-			}
-			replaceNextLn = false
-			line = replaceArgs
-		}
+	result := append([]string{}, lines[:insertAt+1]...)
+	result = append(result, instruction)
+	result = append(result, lines[insertAt+1:]...)
+	return strings.Join(result, "\n"), nil
+}
 
-		if err == io.EOF {
-			// Otherwise, the new Dockerfile will have one newline
-			// more in the end
-			newFileData += line
-			break
-		}
-		newFileData += line + "\n"
+// noFromErr is returned when a Dockerfile has no FROM instruction to rewrite
+// or to add instructions after.
+var noFromErr = errors.New("the Dockerfile has no FROM instruction")
+
+// replaceLastFrom rewrites the last FROM instruction of a Dockerfile, which
+// defines the base of the built image, to use the given image. The instruction
+// is written on a single line, even if it was continued on several lines.
+func replaceLastFrom(fileData, image string) (string, error) {
+	start, end, ok := dockerfile.FindLastDirective(fileData, dockercmd.From)
+	if !ok {
+		return "", noFromErr
 	}
+	lines := strings.Split(fileData, "\n")
+	result := append([]string{}, lines[:start]...)
+	result = append(result, fmt.Sprintf("%s %s", strings.ToUpper(dockercmd.From), image))
+	result = append(result, lines[end+1:]...)
+	newFileData := strings.Join(result, "\n")
 
 	// Parse output for validation
-	buf = bytes.NewBuffer([]byte(newFileData))
-	if _, err := parser.Parse(buf); err != nil {
+	if _, err := parser.Parse(bytes.NewBufferString(newFileData)); err != nil {
 		return "", errors.New("cannot parse new Dockerfile: " + err.Error())
 	}
-
 	return newFileData, nil
 }
 
-// traverseAST traverses the Abstract Syntax Tree output
-// from the Docker parser and returns the valid position
-// of the command it was requested to look for.
-//
-// Note that this function is intended to be used with
-// Dockerfile commands that should be specified only once
-// in a Dockerfile (FROM, CMD, ENTRYPOINT)
-func traverseAST(cmd string, node *parser.Node) int {
-	switch cmd {
-	case dockercmd.From, dockercmd.Entrypoint, dockercmd.Cmd:
-	default:
-		return 0
-	}
-
-	index := 0
-	if node.Value == cmd {
-		index++
-	}
-	for _, n := range node.Children {
-		index += traverseAST(cmd, n)
-	}
-	if node.Next != nil {
-		for n := node.Next; n != nil; n = n.Next {
-			if len(n.Children) > 0 {
-				index += traverseAST(cmd, n)
-			} else if n.Value == cmd {
-				index++
-			}
-		}
-	}
-	return index
-}
-
 // setupPullSecret provides a Docker authentication configuration when the
 // PullSecret is specified.
 func (d *DockerBuilder) setupPullSecret() (*docker.AuthConfigurations, error) {
@@ -430,20 +327,75 @@ func (d *DockerBuilder) setupPullSecret() (*docker.AuthConfigurations, error) {
 	return docker.NewAuthConfigurations(r)
 }
 
+// dockerfilePath returns the path of the Dockerfile of the build in dir.
+func (d *DockerBuilder) dockerfilePath(dir string) string {
+	if d.build.Spec.Strategy.DockerStrategy != nil && len(d.build.Spec.Source.ContextDir) > 0 {
+		return filepath.Join(dir, d.build.Spec.Source.ContextDir, "Dockerfile")
+	}
+	return filepath.Join(dir, "Dockerfile")
+}
+
+// pullBaseImage pulls the image the Dockerfile in dir is built from, so that a
+// failure to pull it is reported apart from a failure of the build itself. The
+// image is only pulled when it is not present yet or the strategy forces a pull.
+func (d *DockerBuilder) pullBaseImage(dir string) error {
+	data, err := ioutil.ReadFile(d.dockerfilePath(dir))
+	if err != nil {
+		return err
+	}
+	node, err := dockerfile.NewParser().Parse(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	from, ok := node.GetDirective(dockercmd.From)
+	if !ok {
+		return noFromErr
+	}
+	image := strings.TrimSpace(from[len(from)-1])
+	if image == "scratch" {
+		return nil
+	}
+	if d.build.Spec.Strategy.DockerStrategy == nil || !d.build.Spec.Strategy.DockerStrategy.ForcePull {
+		if _, err := d.dockerClient.InspectImage(image); err == nil {
+			return nil
+		}
+	}
+	glog.Infof("Pulling image %s ...", image)
+	if err := pullImage(d.dockerClient, image, d.pullAuth(image)); err != nil {
+		return fmt.Errorf("unable to pull the image %s: %v", image, err)
+	}
+	return nil
+}
+
+// pullAuth returns the authentication of the pull secret of the build for the
+// registry of image.
+func (d *DockerBuilder) pullAuth(image string) docker.AuthConfiguration {
+	path := os.Getenv(dockercfg.PullAuthType)
+	if len(path) == 0 {
+		return docker.AuthConfiguration{}
+	}
+	r, err := os.Open(path)
+	if err != nil {
+		glog.Warningf("Unable to read the pull secret %s for image %s: %v", path, image, err)
+		return docker.AuthConfiguration{}
+	}
+	defer r.Close()
+	return stidocker.GetImageRegistryAuth(r, image)
+}
+
 // dockerBuild performs a docker build on the source that has been retrieved
 func (d *DockerBuilder) dockerBuild(dir string) error {
 	var noCache bool
-	var forcePull bool
 	if d.build.Spec.Strategy.DockerStrategy != nil {
 		if d.build.Spec.Source.ContextDir != "" {
 			dir = filepath.Join(dir, d.build.Spec.Source.ContextDir)
 		}
 		noCache = d.build.Spec.Strategy.DockerStrategy.NoCache
-		forcePull = d.build.Spec.Strategy.DockerStrategy.ForcePull
 	}
 	auth, err := d.setupPullSecret()
 	if err != nil {
 		return err
 	}
-	return buildImage(d.dockerClient, dir, noCache, d.build.Spec.Output.To.Name, d.tar, auth, forcePull)
+	// the base image was already pulled by pullBaseImage if the strategy forces a pull
+	return buildImage(d.dockerClient, dir, noCache, d.build.Spec.Output.To.Name, d.tar, auth, false)
 }
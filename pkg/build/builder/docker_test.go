@@ -2,78 +2,72 @@ package builder
 
 import (
 	"bytes"
+	"errors"
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"testing"
 
-	dockercmd "github.com/docker/docker/builder/command"
 	"github.com/docker/docker/builder/parser"
+	docker "github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
 )
 
-func TestReplaceValidCmd(t *testing.T) {
+func TestReplaceLastFrom(t *testing.T) {
 	tests := []struct {
 		name           string
-		cmd            string
 		replaceArgs    string
-		fileData       []byte
+		fileData       string
 		expectedOutput string
 		expectedDiffs  int
 		expectedErr    error
 	}{
 		{
 			name:           "from-replacement",
-			cmd:            dockercmd.From,
 			replaceArgs:    "other/image",
-			fileData:       []byte(dockerFile),
+			fileData:       dockerFile,
 			expectedOutput: expectedFROM,
 			expectedDiffs:  1,
 			expectedErr:    nil,
 		},
 		{
-			name:           "run-replacement",
-			cmd:            dockercmd.Run,
-			replaceArgs:    "This test kind-of-fails before string replacement so this string won't be used",
-			fileData:       []byte(dockerFile),
-			expectedOutput: "",
-			expectedErr:    replaceCmdErr,
-		},
-		{
-			name:           "invalid-dockerfile-cmd",
-			cmd:            "blabla",
-			replaceArgs:    "This test fails at start so this string won't be used",
-			fileData:       []byte(dockerFile),
-			expectedOutput: "",
-			expectedErr:    invalidCmdErr,
-		},
-		{
-			name:           "no-cmd-in-dockerfile",
-			cmd:            dockercmd.Cmd,
-			replaceArgs:    "runme.sh",
-			fileData:       []byte(dockerFile),
+			name:           "no-from-in-dockerfile",
+			replaceArgs:    "other/image",
+			fileData:       "RUN make\n",
 			expectedOutput: "",
-			expectedErr:    replaceCmdErr,
+			expectedErr:    noFromErr,
 		},
 		{
 			name:           "trailing-slash",
-			cmd:            dockercmd.From,
 			replaceArgs:    "rhel",
-			fileData:       []byte(trSlashFile),
+			fileData:       trSlashFile,
 			expectedOutput: expectedtrSlashFile,
 			expectedDiffs:  1,
 			expectedErr:    nil,
 		},
 		{
 			name:           "multiple trailing slashes plus plus",
-			cmd:            dockercmd.From,
 			replaceArgs:    "scratch",
-			fileData:       []byte(trickierFile),
+			fileData:       trickierFile,
 			expectedOutput: expectedTrickierFile,
 			expectedDiffs:  1,
 			expectedErr:    nil,
 		},
+		{
+			name:           "from in a continued instruction",
+			replaceArgs:    "rhel",
+			fileData:       continuedFromFile,
+			expectedOutput: expectedContinuedFromFile,
+			expectedDiffs:  1,
+			expectedErr:    nil,
+		},
 	}
 
 	for _, test := range tests {
-		out, err := replaceValidCmd(test.cmd, test.replaceArgs, test.fileData)
+		out, err := replaceLastFrom(test.fileData, test.replaceArgs)
 		if err != test.expectedErr {
 			t.Errorf("%s: Unexpected error: Expected %v, got %v", test.name, test.expectedErr, err)
 		}
@@ -81,29 +75,19 @@ func TestReplaceValidCmd(t *testing.T) {
 			t.Errorf("%s: Unexpected output:\n\nExpected:\n%s\n(length: %d)\n\ngot:\n%s\n(length: %d)",
 				test.name, test.expectedOutput, len(test.expectedOutput), out, len(out))
 		}
-	}
-
-	// Re-use the tests above
-	var buf *bytes.Buffer
-	for _, test := range tests {
-		buf = bytes.NewBuffer([]byte(test.fileData))
-		original, err := parser.Parse(buf)
 		if err != nil {
-			log.Println(err)
+			continue
 		}
-		repl, err := replaceValidCmd(test.cmd, test.replaceArgs, test.fileData)
+
+		original, err := parser.Parse(bytes.NewBufferString(test.fileData))
 		if err != nil {
 			log.Println(err)
 		}
-		buf = bytes.NewBuffer([]byte(repl))
-		edited, err := parser.Parse(buf)
+		edited, err := parser.Parse(bytes.NewBufferString(out))
 		if err != nil {
 			log.Println(err)
 		}
-
-		diff := cmpASTs(original, edited)
-
-		if diff != test.expectedDiffs {
+		if diff := cmpASTs(original, edited); diff != test.expectedDiffs {
 			t.Errorf("%s: Edit mismatch, expected %d edit(s), got %d", test.name, test.expectedDiffs, diff)
 		}
 	}
@@ -137,85 +121,98 @@ func cmpASTs(original *parser.Node, edited *parser.Node) int {
 	return index
 }
 
-func TestTraverseAST(t *testing.T) {
+func TestAppendEnvVars(t *testing.T) {
 	tests := []struct {
-		name     string
-		cmd      string
-		fileData []byte
-		expected int
+		name       string
+		dockerFile string
+		envVars    map[string]string
+		expected   string
 	}{
 		{
-			name:     "dockerFile",
-			cmd:      dockercmd.Entrypoint,
-			fileData: []byte(dockerFile),
-			expected: 1,
-		},
-		{
-			name:     "dockerFile no newline",
-			cmd:      dockercmd.Entrypoint,
-			fileData: []byte(dockerFileNoNewline),
-			expected: 1,
-		},
-		{
-			name:     "expectedFROM",
-			cmd:      dockercmd.From,
-			fileData: []byte(expectedFROM),
-			expected: 2,
-		},
-		{
-			name:     "trSlashFile",
-			cmd:      dockercmd.Entrypoint,
-			fileData: []byte(trSlashFile),
-			expected: 0,
+			name:       "regular dockerFile",
+			dockerFile: dockerFile,
+			envVars:    map[string]string{"VAR1": "value1"},
+			expected:   dockerFile + "ENV VAR1=\"value1\"\n",
 		},
 		{
-			name:     "expectedtrSlashFile",
-			cmd:      dockercmd.Cmd,
-			fileData: []byte(expectedtrSlashFile),
-			expected: 1,
+			name:       "dockerFile with no newline",
+			dockerFile: dockerFileNoNewline,
+			envVars:    map[string]string{"VAR1": "value1"},
+			expected:   dockerFileNoNewline + "\nENV VAR1=\"value1\"\n",
 		},
 	}
 
-	var buf *bytes.Buffer
 	for _, test := range tests {
-		buf = bytes.NewBuffer([]byte(test.fileData))
-		node, err := parser.Parse(buf)
-		if err != nil {
-			log.Println(err)
-		}
-
-		howMany := traverseAST(test.cmd, node)
-		if howMany != test.expected {
-			t.Errorf("Wrong result, expected %d, got %d", test.expected, howMany)
+		result := appendMetadata(Env, test.dockerFile, test.envVars)
+		if result != test.expected {
+			t.Errorf("%s: unexpected result.\n\tExpected: %s\n\tGot: %s\n", test.name, test.expected, result)
 		}
 	}
 }
 
-func TestAppendEnvVars(t *testing.T) {
+func TestInsertEnvAfterFrom(t *testing.T) {
 	tests := []struct {
 		name       string
 		dockerFile string
-		envVars    map[string]string
+		env        []kapi.EnvVar
 		expected   string
+		expectErr  bool
 	}{
 		{
-			name:       "regular dockerFile",
-			dockerFile: dockerFile,
-			envVars:    map[string]string{"VAR1": "value1"},
-			expected:   dockerFile + "ENV VAR1=\"value1\"\n",
+			name:       "single FROM",
+			dockerFile: "FROM centos\nRUN make\n",
+			env:        []kapi.EnvVar{{Name: "VAR1", Value: "value1"}, {Name: "VAR2", Value: "value2"}},
+			expected:   "FROM centos\nENV VAR1=\"value1\" \\\n\tVAR2=\"value2\"\nRUN make\n",
 		},
 		{
-			name:       "dockerFile with no newline",
-			dockerFile: dockerFileNoNewline,
-			envVars:    map[string]string{"VAR1": "value1"},
-			expected:   dockerFileNoNewline + "\nENV VAR1=\"value1\"\n",
+			name:       "last FROM",
+			dockerFile: "FROM centos\nRUN make\nfrom fedora\nCMD run\n",
+			env:        []kapi.EnvVar{{Name: "VAR1", Value: "value1"}},
+			expected:   "FROM centos\nRUN make\nfrom fedora\nENV VAR1=\"value1\"\nCMD run\n",
+		},
+		{
+			name:       "continued FROM",
+			dockerFile: "FROM \\\n  centos\nRUN make",
+			env:        []kapi.EnvVar{{Name: "VAR1", Value: "value1"}},
+			expected:   "FROM \\\n  centos\nENV VAR1=\"value1\"\nRUN make",
+		},
+		{
+			name:       "escaped value",
+			dockerFile: "FROM centos\n",
+			env:        []kapi.EnvVar{{Name: "VAR1", Value: `a "quoted" $HOME \ value`}},
+			expected:   "FROM centos\nENV VAR1=\"a \\\"quoted\\\" \\$HOME \\\\ value\"\n",
+		},
+		{
+			name:       "multi-line value",
+			dockerFile: "FROM centos\n",
+			env:        []kapi.EnvVar{{Name: "VAR1", Value: "line1\nline2"}},
+			expectErr:  true,
+		},
+		{
+			name:       "no FROM",
+			dockerFile: "RUN make\n",
+			env:        []kapi.EnvVar{{Name: "VAR1", Value: "value1"}},
+			expectErr:  true,
 		},
 	}
 
 	for _, test := range tests {
-		result := appendMetadata(Env, test.dockerFile, test.envVars)
+		result, err := insertEnvAfterFrom(test.dockerFile, test.env)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
 		if result != test.expected {
-			t.Errorf("%s: unexpected result.\n\tExpected: %s\n\tGot: %s\n", test.name, test.expected, result)
+			t.Errorf("%s: unexpected result.\n\tExpected: %q\n\tGot: %q\n", test.name, test.expected, result)
+		}
+		if _, err := parser.Parse(bytes.NewBufferString(result)); err != nil {
+			t.Errorf("%s: cannot parse the result: %v", test.name, err)
 		}
 	}
 }
@@ -249,6 +246,96 @@ func TestAppendLabels(t *testing.T) {
 	}
 }
 
+func TestFetchSourceInlineDockerfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker-build")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	contents := "FROM scratch\n"
+	builder := &DockerBuilder{
+		build: &api.Build{
+			Spec: api.BuildSpec{
+				Source: api.BuildSource{
+					Type:       api.BuildSourceDockerfile,
+					Dockerfile: &contents,
+					ContextDir: "context",
+				},
+			},
+		},
+	}
+	if err := builder.fetchSource(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "context", "Dockerfile"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != contents {
+		t.Errorf("unexpected Dockerfile contents: %q", string(data))
+	}
+}
+
+func TestDockerBuildPullBaseImageFailed(t *testing.T) {
+	contents := "FROM registry.example.com/base\nRUN true\n"
+	var pulled string
+	client := &FakeDocker{
+		inspectImageFunc: func(name string) (*docker.Image, error) {
+			return nil, docker.ErrNoSuchImage
+		},
+		pullImageFunc: func(opts docker.PullImageOptions, auth docker.AuthConfiguration) error {
+			pulled = opts.Repository
+			return errors.New("unauthorized")
+		},
+		buildImageFunc: func(opts docker.BuildImageOptions) error {
+			t.Errorf("Unexpected build of the image")
+			return nil
+		},
+	}
+	builder := NewDockerBuilder(client, docker.AuthConfiguration{}, false, &api.Build{
+		Spec: api.BuildSpec{
+			Source: api.BuildSource{
+				Type:       api.BuildSourceDockerfile,
+				Dockerfile: &contents,
+			},
+			Strategy: api.BuildStrategy{
+				Type:           api.DockerBuildStrategyType,
+				DockerStrategy: &api.DockerBuildStrategy{},
+			},
+		},
+	})
+	err := builder.Build()
+	if err == nil {
+		t.Fatalf("Expected the build to fail")
+	}
+	if pulled != "registry.example.com/base" {
+		t.Errorf("Expected the base image to be pulled, got %q", pulled)
+	}
+	if reason := ReasonForError(err); reason != api.StatusReasonPullBuilderImageFailed {
+		t.Errorf("Expected reason %s, got %s: %v", api.StatusReasonPullBuilderImageFailed, reason, err)
+	}
+}
+
+func TestDockerBuildFetchSourceFailed(t *testing.T) {
+	builder := NewDockerBuilder(&FakeDocker{}, docker.AuthConfiguration{}, false, &api.Build{
+		Spec: api.BuildSpec{
+			Source: api.BuildSource{
+				Type: api.BuildSourceGit,
+				Git:  &api.GitBuildSource{URI: "not a repository"},
+			},
+			Strategy: api.BuildStrategy{
+				Type:           api.DockerBuildStrategyType,
+				DockerStrategy: &api.DockerBuildStrategy{},
+			},
+		},
+	})
+	err := builder.Build()
+	if reason := ReasonForError(err); reason != api.StatusReasonFetchSourceFailed {
+		t.Errorf("Expected reason %s, got %s: %v", api.StatusReasonFetchSourceFailed, reason, err)
+	}
+}
+
 const (
 	dockerFile = `
 FROM openshift/origin-base
@@ -296,8 +383,7 @@ centos
 CMD "cat /etc/passwd"`
 
 	expectedtrSlashFile = `
-from \
-rhel
+FROM rhel
 CMD "cat /etc/passwd"`
 
 	trickierFile = `
@@ -309,9 +395,20 @@ CMD ["executable","param1","param2"]
 `
 
 	expectedTrickierFile = `
-from \
-scratch
+FROM scratch
 
 CMD ["executable","param1","param2"]
+`
+
+	continuedFromFile = `FROM centos
+RUN yum install -y \
+from
+CMD ["executable"]
+`
+
+	expectedContinuedFromFile = `FROM rhel
+RUN yum install -y \
+from
+CMD ["executable"]
 `
 )
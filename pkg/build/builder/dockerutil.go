@@ -3,6 +3,7 @@ package builder
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"k8s.io/kubernetes/pkg/util"
@@ -10,6 +11,8 @@ import (
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
 	"github.com/openshift/source-to-image/pkg/tar"
+
+	"github.com/openshift/origin/pkg/build/api"
 )
 
 var (
@@ -26,6 +29,17 @@ type DockerClient interface {
 	BuildImage(opts docker.BuildImageOptions) error
 	PushImage(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
 	RemoveImage(name string) error
+	PullImage(opts docker.PullImageOptions, auth docker.AuthConfiguration) error
+	CreateContainer(opts docker.CreateContainerOptions) (*docker.Container, error)
+	RemoveContainer(opts docker.RemoveContainerOptions) error
+	CopyFromContainer(opts docker.CopyFromContainerOptions) error
+	StartContainer(id string, hostConfig *docker.HostConfig) error
+	WaitContainer(id string) (int, error)
+	Logs(opts docker.LogsOptions) error
+	InspectImage(name string) (*docker.Image, error)
+	CommitContainer(opts docker.CommitContainerOptions) (*docker.Image, error)
+	ExportContainer(opts docker.ExportContainerOptions) error
+	ImportImage(opts docker.ImportImageOptions) error
 }
 
 // pushImage pushes a docker image to the registry specified in its tag
@@ -54,10 +68,86 @@ func pushImage(client DockerClient, name string, authConfig docker.AuthConfigura
 	return err
 }
 
+// pullImage pulls a docker image from the registry specified in its name. The
+// name may reference the image by tag or by digest.
+func pullImage(client DockerClient, name string, authConfig docker.AuthConfiguration) error {
+	repository, tag := name, ""
+	if !strings.Contains(name, "@") {
+		repository, tag = docker.ParseRepositoryTag(name)
+	}
+	opts := docker.PullImageOptions{
+		Repository: repository,
+		Tag:        tag,
+	}
+	if glog.V(5) {
+		opts.OutputStream = os.Stderr
+	}
+	return client.PullImage(opts, authConfig)
+}
+
 func removeImage(client DockerClient, name string) error {
 	return client.RemoveImage(name)
 }
 
+// execPostCommitHook runs the post commit hook of a build in a new container
+// created from image. The output of the hook is streamed to the build log and
+// an error is returned when the hook exits with a non-zero status.
+func execPostCommitHook(client DockerClient, postCommitSpec api.BuildPostCommitSpec, image string) error {
+	script, command, args := postCommitSpec.Script, postCommitSpec.Command, postCommitSpec.Args
+	if len(script) == 0 && len(command) == 0 && len(args) == 0 {
+		return nil
+	}
+
+	var entrypoint []string
+	switch {
+	case len(script) > 0:
+		// the arguments are passed to the script as positional parameters
+		entrypoint = []string{"/bin/sh", "-ic"}
+		args = append([]string{script, "/bin/sh"}, args...)
+	case len(command) > 0:
+		entrypoint = command
+	}
+
+	glog.Infof("Running post commit hook in a container from %s ...", image)
+	container, err := client.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			Image:      image,
+			Entrypoint: entrypoint,
+			Cmd:        args,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create a container for the post commit hook: %v", err)
+	}
+	defer func() {
+		if err := client.RemoveContainer(docker.RemoveContainerOptions{ID: container.ID, Force: true}); err != nil {
+			glog.Warningf("Unable to remove the post commit hook container %s: %v", container.ID, err)
+		}
+	}()
+
+	if err := client.StartContainer(container.ID, nil); err != nil {
+		return fmt.Errorf("unable to start the post commit hook container: %v", err)
+	}
+	if err := client.Logs(docker.LogsOptions{
+		Container:    container.ID,
+		OutputStream: os.Stdout,
+		ErrorStream:  os.Stderr,
+		Follow:       true,
+		Stdout:       true,
+		Stderr:       true,
+	}); err != nil {
+		glog.Warningf("Unable to stream the output of the post commit hook: %v", err)
+	}
+	exitCode, err := client.WaitContainer(container.ID)
+	if err != nil {
+		return fmt.Errorf("unable to wait for the post commit hook container: %v", err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("post commit hook exited with code %d", exitCode)
+	}
+	return nil
+}
+
 // buildImage invokes a docker build on a particular directory
 func buildImage(client DockerClient, dir string, noCache bool, tag string, tar tar.Tar, pullAuth *docker.AuthConfigurations, forcePull bool) error {
 	tarFile, err := tar.CreateTarFile("", dir)
@@ -1,15 +1,30 @@
 package builder
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/fsouza/go-dockerclient"
+
+	"github.com/openshift/origin/pkg/build/api"
 )
 
 type FakeDocker struct {
 	pushImageFunc   func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
 	buildImageFunc  func(opts docker.BuildImageOptions) error
 	removeImageFunc func(name string) error
+
+	pullImageFunc         func(opts docker.PullImageOptions, auth docker.AuthConfiguration) error
+	createContainerFunc   func(opts docker.CreateContainerOptions) (*docker.Container, error)
+	removeContainerFunc   func(opts docker.RemoveContainerOptions) error
+	copyFromContainerFunc func(opts docker.CopyFromContainerOptions) error
+	startContainerFunc    func(id string, hostConfig *docker.HostConfig) error
+	waitContainerFunc     func(id string) (int, error)
+	logsFunc              func(opts docker.LogsOptions) error
+	inspectImageFunc      func(name string) (*docker.Image, error)
+	commitContainerFunc   func(opts docker.CommitContainerOptions) (*docker.Image, error)
+	exportContainerFunc   func(opts docker.ExportContainerOptions) error
+	importImageFunc       func(opts docker.ImportImageOptions) error
 }
 
 func (d *FakeDocker) BuildImage(opts docker.BuildImageOptions) error {
@@ -33,6 +48,83 @@ func (d *FakeDocker) RemoveImage(name string) error {
 	return nil
 }
 
+func (d *FakeDocker) PullImage(opts docker.PullImageOptions, auth docker.AuthConfiguration) error {
+	if d.pullImageFunc != nil {
+		return d.pullImageFunc(opts, auth)
+	}
+	return nil
+}
+
+func (d *FakeDocker) CreateContainer(opts docker.CreateContainerOptions) (*docker.Container, error) {
+	if d.createContainerFunc != nil {
+		return d.createContainerFunc(opts)
+	}
+	return &docker.Container{ID: "container"}, nil
+}
+
+func (d *FakeDocker) RemoveContainer(opts docker.RemoveContainerOptions) error {
+	if d.removeContainerFunc != nil {
+		return d.removeContainerFunc(opts)
+	}
+	return nil
+}
+
+func (d *FakeDocker) CopyFromContainer(opts docker.CopyFromContainerOptions) error {
+	if d.copyFromContainerFunc != nil {
+		return d.copyFromContainerFunc(opts)
+	}
+	return nil
+}
+
+func (d *FakeDocker) StartContainer(id string, hostConfig *docker.HostConfig) error {
+	if d.startContainerFunc != nil {
+		return d.startContainerFunc(id, hostConfig)
+	}
+	return nil
+}
+
+func (d *FakeDocker) WaitContainer(id string) (int, error) {
+	if d.waitContainerFunc != nil {
+		return d.waitContainerFunc(id)
+	}
+	return 0, nil
+}
+
+func (d *FakeDocker) Logs(opts docker.LogsOptions) error {
+	if d.logsFunc != nil {
+		return d.logsFunc(opts)
+	}
+	return nil
+}
+
+func (d *FakeDocker) InspectImage(name string) (*docker.Image, error) {
+	if d.inspectImageFunc != nil {
+		return d.inspectImageFunc(name)
+	}
+	return &docker.Image{}, nil
+}
+
+func (d *FakeDocker) CommitContainer(opts docker.CommitContainerOptions) (*docker.Image, error) {
+	if d.commitContainerFunc != nil {
+		return d.commitContainerFunc(opts)
+	}
+	return &docker.Image{}, nil
+}
+
+func (d *FakeDocker) ExportContainer(opts docker.ExportContainerOptions) error {
+	if d.exportContainerFunc != nil {
+		return d.exportContainerFunc(opts)
+	}
+	return nil
+}
+
+func (d *FakeDocker) ImportImage(opts docker.ImportImageOptions) error {
+	if d.importImageFunc != nil {
+		return d.importImageFunc(opts)
+	}
+	return nil
+}
+
 func TestDockerPush(t *testing.T) {
 	verifyFunc := func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error {
 		if opts.Name != "test/image" {
@@ -43,3 +135,94 @@ func TestDockerPush(t *testing.T) {
 	fd := &FakeDocker{pushImageFunc: verifyFunc}
 	pushImage(fd, "test/image", docker.AuthConfiguration{})
 }
+
+func TestExecPostCommitHook(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       api.BuildPostCommitSpec
+		exitCode   int
+		entrypoint []string
+		cmd        []string
+		run        bool
+		wantErr    bool
+	}{
+		{
+			name: "no hook",
+		},
+		{
+			name:       "script with arguments",
+			spec:       api.BuildPostCommitSpec{Script: "rake test $1", Args: []string{"--verbose"}},
+			entrypoint: []string{"/bin/sh", "-ic"},
+			cmd:        []string{"rake test $1", "/bin/sh", "--verbose"},
+			run:        true,
+		},
+		{
+			name:       "command with arguments",
+			spec:       api.BuildPostCommitSpec{Command: []string{"rake"}, Args: []string{"test"}},
+			entrypoint: []string{"rake"},
+			cmd:        []string{"test"},
+			run:        true,
+		},
+		{
+			name: "arguments to the default entrypoint",
+			spec: api.BuildPostCommitSpec{Args: []string{"test"}},
+			cmd:  []string{"test"},
+			run:  true,
+		},
+		{
+			name:     "failing hook",
+			spec:     api.BuildPostCommitSpec{Script: "exit 1"},
+			exitCode: 1,
+			run:      true,
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		var config *docker.Config
+		removed := false
+		client := &FakeDocker{
+			createContainerFunc: func(opts docker.CreateContainerOptions) (*docker.Container, error) {
+				config = opts.Config
+				return &docker.Container{ID: "hook"}, nil
+			},
+			removeContainerFunc: func(opts docker.RemoveContainerOptions) error {
+				removed = true
+				return nil
+			},
+			waitContainerFunc: func(id string) (int, error) {
+				return test.exitCode, nil
+			},
+		}
+
+		err := execPostCommitHook(client, test.spec, "test/image")
+		if test.wantErr != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.run {
+			if config != nil {
+				t.Errorf("%s: expected no container to be created", test.name)
+			}
+			continue
+		}
+		if config == nil {
+			t.Errorf("%s: expected a container to be created", test.name)
+			continue
+		}
+		if !removed {
+			t.Errorf("%s: expected the container to be removed", test.name)
+		}
+		if config.Image != "test/image" {
+			t.Errorf("%s: unexpected image %s", test.name, config.Image)
+		}
+		if test.wantErr {
+			continue
+		}
+		if !reflect.DeepEqual(config.Entrypoint, test.entrypoint) {
+			t.Errorf("%s: expected entrypoint %v, got %v", test.name, test.entrypoint, config.Entrypoint)
+		}
+		if !reflect.DeepEqual(config.Cmd, test.cmd) {
+			t.Errorf("%s: expected command %v, got %v", test.name, test.cmd, config.Cmd)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package builder
+
+import (
+	"os/exec"
+
+	stierrors "github.com/openshift/source-to-image/pkg/errors"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+// BuildError is returned by the builders when a step of the build fails. Reason
+// identifies the failed step and is reported in the status of the build.
+type BuildError struct {
+	Reason api.StatusReason
+	Err    error
+}
+
+// Error returns the message of the underlying error.
+func (e *BuildError) Error() string {
+	return e.Err.Error()
+}
+
+// newBuildError wraps err with the reason of the failed build step.
+func newBuildError(reason api.StatusReason, err error) error {
+	return &BuildError{Reason: reason, Err: err}
+}
+
+// ReasonForError returns the reason of a build that failed with err.
+func ReasonForError(err error) api.StatusReason {
+	if buildErr, ok := err.(*BuildError); ok {
+		return buildErr.Reason
+	}
+	return api.StatusReasonGenericBuildFailed
+}
+
+// stiErrorReason maps an error returned by an S2I build to the reason of the
+// failed build step.
+func stiErrorReason(err error) api.StatusReason {
+	switch e := err.(type) {
+	case stierrors.Error:
+		switch e.ErrorCode {
+		case stierrors.InspectImageError, stierrors.PullImageError:
+			return api.StatusReasonPullBuilderImageFailed
+		case stierrors.URLHandlerError, stierrors.SourcePathError:
+			return api.StatusReasonFetchSourceFailed
+		}
+	case stierrors.ContainerError:
+		// a script of the builder image exited with an error
+	case *exec.ExitError, *exec.Error:
+		// git is the only command S2I runs, to clone and check out the source
+		return api.StatusReasonFetchSourceFailed
+	default:
+		return api.StatusReasonGenericBuildFailed
+	}
+	return api.StatusReasonAssembleFailed
+}
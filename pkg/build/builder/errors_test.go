@@ -0,0 +1,36 @@
+package builder
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	stierrors "github.com/openshift/source-to-image/pkg/errors"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestReasonForError(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason api.StatusReason
+	}{
+		{err: errors.New("failure"), reason: api.StatusReasonGenericBuildFailed},
+		{err: newBuildError(api.StatusReasonPushImageFailed, errors.New("failure")), reason: api.StatusReasonPushImageFailed},
+		{err: newBuildError(stiErrorReason(stierrors.NewPullImageError("builder", nil)), errors.New("failure")), reason: api.StatusReasonPullBuilderImageFailed},
+		{err: newBuildError(stiErrorReason(stierrors.NewURLHandlerError("http://example.com")), errors.New("failure")), reason: api.StatusReasonFetchSourceFailed},
+		{err: newBuildError(stiErrorReason(stierrors.NewAssembleError("builder", "output", nil)), errors.New("failure")), reason: api.StatusReasonAssembleFailed},
+		{err: newBuildError(stiErrorReason(stierrors.ContainerError{ExitCode: 1}), errors.New("failure")), reason: api.StatusReasonAssembleFailed},
+		{err: newBuildError(stiErrorReason(&exec.ExitError{}), errors.New("failure")), reason: api.StatusReasonFetchSourceFailed},
+		{err: newBuildError(stiErrorReason(&exec.Error{Name: "git", Err: exec.ErrNotFound}), errors.New("failure")), reason: api.StatusReasonFetchSourceFailed},
+		{err: newBuildError(stiErrorReason(errors.New("failure")), errors.New("failure")), reason: api.StatusReasonGenericBuildFailed},
+	}
+	for i, test := range tests {
+		if reason := ReasonForError(test.err); reason != test.reason {
+			t.Errorf("(%d) expected reason %s, got %s", i, test.reason, reason)
+		}
+		if test.err.Error() != "failure" {
+			t.Errorf("(%d) expected the message of the wrapped error, got %q", i, test.err.Error())
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package builder
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+// buildSecretsEnv is the environment variable holding the directory the
+// secrets of the build source are mounted under. Each secret is mounted in a
+// subdirectory named after the secret.
+const buildSecretsEnv = "BUILD_SECRETS_PATH"
+
+// buildEnvSecretsEnv is the environment variable holding the directory the
+// secrets referenced by the build environment variables are mounted under.
+const buildEnvSecretsEnv = "BUILD_ENV_SECRETS_PATH"
+
+// resolveBuildEnv returns the build environment variables with their values.
+// The values of the variables referencing a key of a secret are read from the
+// mounted secret.
+func resolveBuildEnv(env []api.BuildEnvVar) ([]kapi.EnvVar, error) {
+	resolved := []kapi.EnvVar{}
+	for _, ev := range env {
+		if ev.ValueFrom == nil || ev.ValueFrom.SecretKeyRef == nil {
+			resolved = append(resolved, kapi.EnvVar{Name: ev.Name, Value: ev.Value})
+			continue
+		}
+		base := os.Getenv(buildEnvSecretsEnv)
+		if len(base) == 0 {
+			return nil, fmt.Errorf("the secrets of the build environment are not available, %s is not set", buildEnvSecretsEnv)
+		}
+		ref := ev.ValueFrom.SecretKeyRef
+		data, err := ioutil.ReadFile(filepath.Join(base, ref.Name, ref.Key))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the key %s of the secret %s for %s: %v", ref.Key, ref.Name, ev.Name, err)
+		}
+		// secret values are commonly created from files ending with a newline
+		resolved = append(resolved, kapi.EnvVar{Name: ev.Name, Value: strings.TrimSuffix(string(data), "\n")})
+	}
+	return resolved, nil
+}
+
+// buildSecretFile is a file of a build secret that was copied into the build
+// directory.
+type buildSecretFile struct {
+	// path is the path of the copy, relative to the build directory.
+	path string
+	// size and digest identify the content of the file.
+	size   int64
+	digest [sha256.Size]byte
+}
+
+// copyBuildSecrets copies the files of the build secrets into their
+// destination directories within dir and returns the copied files.
+func copyBuildSecrets(secrets []api.SecretBuildSource, dir string) ([]buildSecretFile, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	base := os.Getenv(buildSecretsEnv)
+	if len(base) == 0 {
+		return nil, fmt.Errorf("the build secrets are not available, %s is not set", buildSecretsEnv)
+	}
+	copied := []buildSecretFile{}
+	for _, secret := range secrets {
+		source := filepath.Join(base, secret.Secret.Name)
+		files, err := ioutil.ReadDir(source)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the secret %s: %v", secret.Secret.Name, err)
+		}
+		destination := filepath.Join(dir, secret.DestinationDir)
+		if err := os.MkdirAll(destination, 0755); err != nil {
+			return nil, err
+		}
+		glog.V(3).Infof("Copying the files of the secret %s to %s", secret.Secret.Name, destination)
+		for _, file := range files {
+			// skip the hidden bookkeeping entries of the secret volume
+			if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(source, file.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("unable to read the secret %s: %v", secret.Secret.Name, err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(destination, file.Name()), data, file.Mode().Perm()); err != nil {
+				return nil, err
+			}
+			copied = append(copied, buildSecretFile{
+				path:   filepath.Join(secret.DestinationDir, file.Name()),
+				size:   int64(len(data)),
+				digest: sha256.Sum256(data),
+			})
+		}
+	}
+	return copied, nil
+}
+
+// squashBuildSecrets replaces image with a single layer image holding its
+// filesystem without the build secrets, so that the secrets do not remain in
+// any of the layers the build committed, e.g. by the ADD instruction of a
+// Dockerfile or the commit of the S2I assemble container. Since the build may
+// have copied the build directory anywhere, a regular file is dropped when it
+// has the content of a build secret and its path ends with the path of the
+// secret within the build directory. The configuration of image is preserved.
+func squashBuildSecrets(client DockerClient, image string, files []buildSecretFile) error {
+	if len(files) == 0 {
+		return nil
+	}
+	info, err := client.InspectImage(image)
+	if err != nil {
+		return fmt.Errorf("unable to inspect the image %s: %v", image, err)
+	}
+
+	glog.Infof("Squashing %s to remove the build secrets ...", image)
+	// the container is only exported, it never runs
+	container, err := client.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			Image:      image,
+			Entrypoint: []string{"/bin/true"},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create a container to export %s: %v", image, err)
+	}
+	exportReader, exportWriter := io.Pipe()
+	go func() {
+		exportWriter.CloseWithError(client.ExportContainer(docker.ExportContainerOptions{ID: container.ID, OutputStream: exportWriter}))
+	}()
+	importReader, importWriter := io.Pipe()
+	go func() {
+		err := filterBuildSecrets(exportReader, importWriter, files)
+		exportReader.CloseWithError(err)
+		importWriter.CloseWithError(err)
+	}()
+	repository, tag := docker.ParseRepositoryTag(image)
+	err = client.ImportImage(docker.ImportImageOptions{Repository: repository, Tag: tag, Source: "-", InputStream: importReader})
+	importReader.Close()
+	removeContainer(client, container.ID)
+	if err != nil {
+		return fmt.Errorf("unable to import the squashed image %s: %v", image, err)
+	}
+
+	// restore the configuration the import dropped
+	config := *info.Config
+	config.Image = image
+	container, err = client.CreateContainer(docker.CreateContainerOptions{Config: &config})
+	if err != nil {
+		return fmt.Errorf("unable to create a container to restore the configuration of %s: %v", image, err)
+	}
+	defer removeContainer(client, container.ID)
+	if _, err := client.CommitContainer(docker.CommitContainerOptions{
+		Container:  container.ID,
+		Repository: repository,
+		Tag:        tag,
+		Run:        info.Config,
+	}); err != nil {
+		return fmt.Errorf("unable to restore the configuration of %s: %v", image, err)
+	}
+
+	// the image the build committed still holds the secrets
+	if err := client.RemoveImage(info.ID); err != nil {
+		glog.Warningf("Unable to remove the image %s holding the build secrets: %v", info.ID, err)
+	}
+	return nil
+}
+
+// filterBuildSecrets copies the tar archive of in to out, leaving out the
+// regular files which are copies of one of files.
+func filterBuildSecrets(in io.Reader, out io.Writer, files []buildSecretFile) error {
+	tr := tar.NewReader(in)
+	tw := tar.NewWriter(out)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var content io.Reader = tr
+		if candidates := buildSecretsAt(header, files); len(candidates) > 0 {
+			data, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if isBuildSecret(data, candidates) {
+				glog.V(3).Infof("Removing the build secret %s", header.Name)
+				continue
+			}
+			content = bytes.NewReader(data)
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, content); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// buildSecretsAt returns the files whose path within the build directory the
+// regular file of header ends with, and whose size it has.
+func buildSecretsAt(header *tar.Header, files []buildSecretFile) []buildSecretFile {
+	if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+		return nil
+	}
+	name := path.Clean("/" + header.Name)
+	candidates := []buildSecretFile{}
+	for _, file := range files {
+		if file.size == header.Size && strings.HasSuffix(name, path.Clean("/"+filepath.ToSlash(file.path))) {
+			candidates = append(candidates, file)
+		}
+	}
+	return candidates
+}
+
+// isBuildSecret returns true if data is the content of one of files.
+func isBuildSecret(data []byte, files []buildSecretFile) bool {
+	digest := sha256.Sum256(data)
+	for _, file := range files {
+		if file.size == int64(len(data)) && file.digest == digest {
+			return true
+		}
+	}
+	return false
+}
+
+// removeContainer removes the container with the given ID, logging failures.
+func removeContainer(client DockerClient, id string) {
+	if err := client.RemoveContainer(docker.RemoveContainerOptions{ID: id, Force: true}); err != nil {
+		glog.Warningf("Unable to remove the container %s: %v", id, err)
+	}
+}
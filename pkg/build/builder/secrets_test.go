@@ -0,0 +1,238 @@
+package builder
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestCopyBuildSecrets(t *testing.T) {
+	secretsDir, err := ioutil.TempDir("", "build-secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(secretsDir)
+	buildDir, err := ioutil.TempDir("", "build-context")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	os.MkdirAll(filepath.Join(secretsDir, "maven"), 0755)
+	ioutil.WriteFile(filepath.Join(secretsDir, "maven", "settings.xml"), []byte("<settings/>"), 0644)
+	ioutil.WriteFile(filepath.Join(secretsDir, "maven", ".hidden"), []byte("skip"), 0644)
+	os.MkdirAll(filepath.Join(secretsDir, "npm"), 0755)
+	ioutil.WriteFile(filepath.Join(secretsDir, "npm", ".npmrc"), []byte("skip"), 0644)
+	ioutil.WriteFile(filepath.Join(secretsDir, "npm", "token"), []byte("secret"), 0644)
+
+	os.Setenv(buildSecretsEnv, secretsDir)
+	defer os.Unsetenv(buildSecretsEnv)
+
+	secrets := []api.SecretBuildSource{
+		{Secret: kapi.LocalObjectReference{Name: "maven"}, DestinationDir: "configuration"},
+		{Secret: kapi.LocalObjectReference{Name: "npm"}},
+	}
+	files, err := copyBuildSecrets(secrets, buildDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	paths := []string{}
+	for _, file := range files {
+		paths = append(paths, file.path)
+	}
+	if e := []string{"configuration/settings.xml", "token"}; !reflect.DeepEqual(e, paths) {
+		t.Errorf("Expected copied files %v, got %v", e, paths)
+	}
+	if files[1].size != 6 || files[1].digest != sha256.Sum256([]byte("secret")) {
+		t.Errorf("Expected the content of token to be recorded, got %#v", files[1])
+	}
+	data, err := ioutil.ReadFile(filepath.Join(buildDir, "configuration", "settings.xml"))
+	if err != nil || string(data) != "<settings/>" {
+		t.Errorf("Expected the secret to be copied, got %q: %v", string(data), err)
+	}
+	if _, err := os.Stat(filepath.Join(buildDir, "configuration", ".hidden")); !os.IsNotExist(err) {
+		t.Errorf("Expected hidden files to be skipped, got %v", err)
+	}
+
+	os.Unsetenv(buildSecretsEnv)
+	if _, err := copyBuildSecrets(secrets, buildDir); err == nil {
+		t.Errorf("Expected an error when the secrets are not mounted")
+	}
+}
+
+func TestResolveBuildEnv(t *testing.T) {
+	secretsDir, err := ioutil.TempDir("", "build-env-secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(secretsDir)
+	os.MkdirAll(filepath.Join(secretsDir, "credentials"), 0755)
+	ioutil.WriteFile(filepath.Join(secretsDir, "credentials", "token"), []byte("s3cr3t\n"), 0644)
+
+	os.Setenv(buildEnvSecretsEnv, secretsDir)
+	defer os.Unsetenv(buildEnvSecretsEnv)
+
+	env := []api.BuildEnvVar{
+		{Name: "DEPLOY_ENV", Value: "staging"},
+		{Name: "TOKEN", ValueFrom: &api.BuildEnvVarSource{
+			SecretKeyRef: &api.SecretKeySelector{
+				LocalObjectReference: kapi.LocalObjectReference{Name: "credentials"},
+				Key:                  "token",
+			},
+		}},
+	}
+	resolved, err := resolveBuildEnv(env)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []kapi.EnvVar{{Name: "DEPLOY_ENV", Value: "staging"}, {Name: "TOKEN", Value: "s3cr3t"}}
+	if !reflect.DeepEqual(expected, resolved) {
+		t.Errorf("Expected %#v, got %#v", expected, resolved)
+	}
+
+	env[1].ValueFrom.SecretKeyRef.Key = "missing"
+	if _, err := resolveBuildEnv(env); err == nil {
+		t.Errorf("Expected an error for a missing key")
+	}
+	os.Unsetenv(buildEnvSecretsEnv)
+	if _, err := resolveBuildEnv(env); err == nil {
+		t.Errorf("Expected an error when the secrets are not mounted")
+	}
+}
+
+// tarLayer returns a tar archive of the regular files of layer.
+func tarLayer(t *testing.T, layer map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	names := []string{}
+	for name := range layer {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(layer[name])), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte(layer[name]))
+	}
+	tw.Close()
+	return buf.Bytes()
+}
+
+// untarLayer returns the regular files of the tar archive in.
+func untarLayer(t *testing.T, in io.Reader) map[string]string {
+	layer := map[string]string{}
+	tr := tar.NewReader(in)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return layer
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := ioutil.ReadAll(tr)
+		layer[header.Name] = string(data)
+	}
+}
+
+func TestSquashBuildSecrets(t *testing.T) {
+	secret := func(path, content string) buildSecretFile {
+		return buildSecretFile{path: path, size: int64(len(content)), digest: sha256.Sum256([]byte(content))}
+	}
+	files := []buildSecretFile{secret("configuration/settings.xml", "<settings/>"), secret("token", "secret"), secret("configuration/empty", ""), secret("configuration/flag", "1")}
+
+	// The layers the build committed: the builder image, the layer adding the
+	// source with the secrets to /tmp/src and the layer of the assemble script,
+	// which moved the source and removed a secret again.
+	layers := []map[string]string{
+		{"usr/bin/app": "binary", "usr/lib/python/__init__.py": "", "etc/flag": "1"},
+		{"tmp/src/configuration/settings.xml": "<settings/>", "tmp/src/token": "secret", "tmp/src/main.go": "package main", "tmp/src/configuration/empty": "", "tmp/src/configuration/flag": "1"},
+		{"opt/app/configuration/settings.xml": "<settings/>", "opt/app/main.go": "package main", "opt/app/notes": "public", "opt/app/configuration/empty": "", "opt/app/.keep": ""},
+	}
+	removedByAssemble := "tmp/src/token"
+	imageConfig := &docker.Config{WorkingDir: "/opt/app", Cmd: []string{"run"}}
+
+	var imported map[string]string
+	var importOpts docker.ImportImageOptions
+	var committed docker.CommitContainerOptions
+	var removedImage string
+	client := &FakeDocker{
+		inspectImageFunc: func(name string) (*docker.Image, error) {
+			return &docker.Image{ID: "built", Config: imageConfig}, nil
+		},
+		exportContainerFunc: func(opts docker.ExportContainerOptions) error {
+			// the export is the union of the layers of the image
+			union := map[string]string{}
+			for _, layer := range layers {
+				for name, content := range layer {
+					union[name] = content
+				}
+			}
+			delete(union, removedByAssemble)
+			_, err := opts.OutputStream.Write(tarLayer(t, union))
+			return err
+		},
+		importImageFunc: func(opts docker.ImportImageOptions) error {
+			importOpts = opts
+			imported = untarLayer(t, opts.InputStream)
+			return nil
+		},
+		commitContainerFunc: func(opts docker.CommitContainerOptions) (*docker.Image, error) {
+			committed = opts
+			return &docker.Image{}, nil
+		},
+		removeImageFunc: func(name string) error {
+			removedImage = name
+			return nil
+		},
+	}
+
+	if err := squashBuildSecrets(client, "registry:5000/ns/app:latest", files); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if importOpts.Repository != "registry:5000/ns/app" || importOpts.Tag != "latest" {
+		t.Errorf("Unexpected import: %#v", importOpts)
+	}
+	// the squashed image consists of the imported layer only, which must not
+	// hold any of the secrets, wherever the build copied them, but keeps the
+	// other files with the content of a secret
+	expected := map[string]string{
+		"usr/bin/app":                "binary",
+		"usr/lib/python/__init__.py": "",
+		"etc/flag":                   "1",
+		"tmp/src/main.go":            "package main",
+		"opt/app/main.go":            "package main",
+		"opt/app/notes":              "public",
+		"opt/app/.keep":              "",
+	}
+	if !reflect.DeepEqual(expected, imported) {
+		t.Errorf("Expected the squashed layer %v, got %v", expected, imported)
+	}
+	if committed.Repository != "registry:5000/ns/app" || committed.Tag != "latest" || committed.Run != imageConfig {
+		t.Errorf("Unexpected commit restoring the configuration: %#v", committed)
+	}
+	if removedImage != "built" {
+		t.Errorf("Expected the image holding the secrets to be removed, got %q", removedImage)
+	}
+
+	client.importImageFunc = func(opts docker.ImportImageOptions) error {
+		return errors.New("import failed")
+	}
+	if err := squashBuildSecrets(client, "app", files); err == nil {
+		t.Errorf("Expected an error when the import fails")
+	}
+}
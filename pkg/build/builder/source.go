@@ -0,0 +1,372 @@
+package builder
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+	stidocker "github.com/openshift/source-to-image/pkg/docker"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/source-to-image/pkg/git"
+)
+
+// sourceImagePullSecretsEnv is the environment variable holding the directory
+// the pull secrets of the image sources are mounted under. Each secret is
+// mounted in a subdirectory named after the secret.
+const sourceImagePullSecretsEnv = "SOURCE_IMAGE_DOCKERCFG_PATH"
+
+// checkSourceURI performs a check on the URI associated with the build
+// to make sure that it is live before proceeding with the build.
+func checkSourceURI(gitClient git.Git, rawurl string, timeout time.Duration) error {
+	if !gitClient.ValidCloneSpec(rawurl) {
+		return fmt.Errorf("Invalid git source url: %s", rawurl)
+	}
+	if strings.HasPrefix(rawurl, "git://") || strings.HasPrefix(rawurl, "git@") {
+		return nil
+	}
+	if !strings.HasPrefix(rawurl, "http://") && !strings.HasPrefix(rawurl, "https://") {
+		rawurl = fmt.Sprintf("https://%s", rawurl)
+	}
+	srcURL, err := url.Parse(rawurl)
+	if err != nil {
+		return err
+	}
+	host := srcURL.Host
+	if strings.Index(host, ":") == -1 {
+		switch srcURL.Scheme {
+		case "http":
+			host += ":80"
+		case "https":
+			host += ":443"
+		}
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.Dial("tcp", host)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// fetchGitSource retrieves the git source from the repository. If a commit ID
+// is included in the build revision, that commit ID is checked out. Otherwise
+// if a ref is included in the source definition, that ref is checked out.
+func fetchGitSource(gitClient git.Git, build *api.Build, dir string, urlTimeout time.Duration) error {
+	if err := checkSourceURI(gitClient, build.Spec.Source.Git.URI, urlTimeout); err != nil {
+		return err
+	}
+	origProxy := make(map[string]string)
+	var setHttp, setHttps bool
+	// set the http proxy to be used by the git clone performed by S2I
+	if len(build.Spec.Source.Git.HTTPSProxy) != 0 {
+		glog.V(2).Infof("Setting https proxy variables for Git to %s", build.Spec.Source.Git.HTTPSProxy)
+		origProxy["HTTPS_PROXY"] = os.Getenv("HTTPS_PROXY")
+		origProxy["https_proxy"] = os.Getenv("https_proxy")
+		os.Setenv("HTTPS_PROXY", build.Spec.Source.Git.HTTPSProxy)
+		os.Setenv("https_proxy", build.Spec.Source.Git.HTTPSProxy)
+		setHttps = true
+	}
+	if len(build.Spec.Source.Git.HTTPProxy) != 0 {
+		glog.V(2).Infof("Setting http proxy variables for Git to %s", build.Spec.Source.Git.HTTPSProxy)
+		origProxy["HTTP_PROXY"] = os.Getenv("HTTP_PROXY")
+		origProxy["http_proxy"] = os.Getenv("http_proxy")
+		os.Setenv("HTTP_PROXY", build.Spec.Source.Git.HTTPProxy)
+		os.Setenv("http_proxy", build.Spec.Source.Git.HTTPProxy)
+		setHttp = true
+	}
+	defer func() {
+		// reset http proxy env variables to original value
+		if setHttps {
+			glog.V(4).Infof("Resetting HTTPS_PROXY variable for Git to %s", origProxy["HTTPS_PROXY"])
+			os.Setenv("HTTPS_PROXY", origProxy["HTTPS_PROXY"])
+			glog.V(4).Infof("Resetting https_proxy variable for Git to %s", origProxy["https_proxy"])
+			os.Setenv("https_proxy", origProxy["https_proxy"])
+		}
+		if setHttp {
+			glog.V(4).Infof("Resetting HTTP_PROXY variable for Git to %s", origProxy["HTTP_PROXY"])
+			os.Setenv("HTTP_PROXY", origProxy["HTTP_PROXY"])
+			glog.V(4).Infof("Resetting http_proxy variable for Git to %s", origProxy["http_proxy"])
+			os.Setenv("http_proxy", origProxy["http_proxy"])
+		}
+	}()
+
+	if err := gitClient.Clone(build.Spec.Source.Git.URI, dir); err != nil {
+		return err
+	}
+
+	if build.Spec.Source.Git.Ref == "" &&
+		(build.Spec.Revision == nil ||
+			build.Spec.Revision.Git == nil ||
+			build.Spec.Revision.Git.Commit == "") {
+		return nil
+	}
+	if build.Spec.Revision != nil &&
+		build.Spec.Revision.Git != nil &&
+		build.Spec.Revision.Git.Commit != "" {
+		return gitClient.Checkout(dir, build.Spec.Revision.Git.Commit)
+	}
+	return gitClient.Checkout(dir, build.Spec.Source.Git.Ref)
+}
+
+// extractInputBinary reads the binary input streamed to the build and extracts
+// it into dir. The client always sends the input as a tar archive, which may be
+// gzip compressed. When the binary source is marked AsFile, the archive is
+// expected to contain only that file.
+func extractInputBinary(in io.Reader, source *api.BinaryBuildSource, dir string) error {
+	if len(source.AsFile) > 0 {
+		glog.Infof("Receiving source from STDIN as file %s", source.AsFile)
+	} else {
+		glog.Infof("Receiving source from STDIN as archive ...")
+	}
+
+	r := bufio.NewReader(in)
+	var stream io.Reader = r
+	if magic, err := r.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("unable to read the gzip compressed binary input: %v", err)
+		}
+		// the stream is not closed by the client, so never wait for another gzip member
+		gz.Multistream(false)
+		stream = gz
+	}
+	if err := extractTarStream(dir, stream); err != nil {
+		return fmt.Errorf("unable to extract the binary input: %v", err)
+	}
+
+	if len(source.AsFile) > 0 {
+		if _, err := os.Stat(filepath.Join(dir, source.AsFile)); err != nil {
+			return fmt.Errorf("the binary input did not contain the expected file %s: %v", source.AsFile, err)
+		}
+	}
+	return nil
+}
+
+// extractTarStream extracts the tar archive read from r into dir. It stops at
+// the end-of-archive marker and does not require the stream to be closed.
+// Entries are never written outside of dir, neither directly nor through a
+// symlink extracted from the archive.
+func extractTarStream(dir string, r io.Reader) error {
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.Clean(header.Name)
+		if filepath.IsAbs(name) || !isInDir(root, filepath.Join(root, name)) {
+			return fmt.Errorf("the archive entry %q points outside of the build directory", header.Name)
+		}
+		parent, err := resolveDir(root, filepath.Dir(filepath.Join(root, name)))
+		if err != nil {
+			return err
+		}
+		if !isInDir(root, parent) {
+			return fmt.Errorf("the archive entry %q points outside of the build directory", header.Name)
+		}
+		path := filepath.Join(parent, filepath.Base(name))
+		glog.V(5).Infof("Extracting %s", path)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if filepath.IsAbs(header.Linkname) || !isInDir(root, filepath.Join(parent, header.Linkname)) {
+				return fmt.Errorf("the archive entry %q links to %q outside of the build directory", header.Name, header.Linkname)
+			}
+			if err := os.MkdirAll(parent, 0755); err != nil {
+				return err
+			}
+			if err := removeSymlink(path); err != nil {
+				return err
+			}
+			if err := os.Symlink(header.Linkname, path); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := os.MkdirAll(parent, 0755); err != nil {
+				return err
+			}
+			if err := removeSymlink(path); err != nil {
+				return err
+			}
+			if err := extractFile(path, header, tr); err != nil {
+				return err
+			}
+		default:
+			glog.V(4).Infof("Skipping unsupported archive entry %s of type %c", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// isInDir returns true if path is dir or lies below it. Both paths must be
+// absolute.
+func isInDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolveDir returns path with the symlinks of its existing part, below root,
+// resolved. The part of path that does not exist yet is appended as is.
+func resolveDir(root, path string) (string, error) {
+	missing := ""
+	for {
+		resolved, err := filepath.EvalSymlinks(path)
+		if err == nil {
+			return filepath.Join(resolved, missing), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if path == root || !isInDir(root, path) {
+			return "", err
+		}
+		missing = filepath.Join(filepath.Base(path), missing)
+		path = filepath.Dir(path)
+	}
+}
+
+// removeSymlink removes path if it is a symlink, so that a later archive entry
+// with the same name replaces the link instead of writing through it.
+func removeSymlink(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		return nil
+	}
+	return os.Remove(path)
+}
+
+// extractFile writes the content of the current archive entry into path.
+func extractFile(path string, header *tar.Header, r io.Reader) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, header.FileInfo().Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	written, err := io.Copy(file, r)
+	if err != nil {
+		return err
+	}
+	if written != header.Size {
+		return fmt.Errorf("wrote %d bytes of %s, expected %d", written, header.Name, header.Size)
+	}
+	return nil
+}
+
+// extractSourceFromImages copies the paths referenced by the image sources of
+// the build into dir. Every image is pulled and a container is created from it,
+// without being started, so that the paths can be copied out of it.
+func extractSourceFromImages(client DockerClient, images []api.ImageSource, dir string) error {
+	for _, image := range images {
+		if err := extractSourceFromImage(client, image, dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractSourceFromImage copies the paths of a single image source into dir.
+func extractSourceFromImage(client DockerClient, image api.ImageSource, dir string) error {
+	glog.Infof("Extracting source from image %s ...", image.From.Name)
+	if err := pullImage(client, image.From.Name, imageSourceAuth(image)); err != nil {
+		return fmt.Errorf("unable to pull the source image %s: %v", image.From.Name, err)
+	}
+	container, err := client.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			Image:      image.From.Name,
+			Entrypoint: []string{"/bin/true"},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create a container from the source image %s: %v", image.From.Name, err)
+	}
+	defer func() {
+		if err := client.RemoveContainer(docker.RemoveContainerOptions{ID: container.ID, Force: true}); err != nil {
+			glog.Warningf("Unable to remove the container %s: %v", container.ID, err)
+		}
+	}()
+
+	for _, path := range image.Paths {
+		destination := filepath.Join(dir, path.DestinationDir)
+		glog.V(4).Infof("Copying %s from image %s to %s", path.SourcePath, image.From.Name, destination)
+		if err := os.MkdirAll(destination, 0755); err != nil {
+			return err
+		}
+		if err := copyFromContainer(client, container.ID, path.SourcePath, destination); err != nil {
+			return fmt.Errorf("unable to copy %s from the source image %s: %v", path.SourcePath, image.From.Name, err)
+		}
+	}
+	return nil
+}
+
+// copyFromContainer extracts the archive of source returned by the container
+// into dir.
+func copyFromContainer(client DockerClient, containerID, source, dir string) error {
+	r, w := io.Pipe()
+	result := make(chan error, 1)
+	go func() {
+		err := client.CopyFromContainer(docker.CopyFromContainerOptions{
+			OutputStream: w,
+			Container:    containerID,
+			Resource:     source,
+		})
+		w.CloseWithError(err)
+		result <- err
+	}()
+	if err := extractTarStream(dir, r); err != nil {
+		r.CloseWithError(err)
+		<-result
+		return err
+	}
+	// drain any padding following the end-of-archive marker
+	io.Copy(ioutil.Discard, r)
+	return <-result
+}
+
+// imageSourceAuth returns the credentials used to pull the image of an image
+// source. They are read from the pull secret of the image source, if any.
+func imageSourceAuth(image api.ImageSource) docker.AuthConfiguration {
+	if image.PullSecret == nil || len(os.Getenv(sourceImagePullSecretsEnv)) == 0 {
+		return docker.AuthConfiguration{}
+	}
+	path := filepath.Join(os.Getenv(sourceImagePullSecretsEnv), image.PullSecret.Name, kapi.DockerConfigKey)
+	r, err := os.Open(path)
+	if err != nil {
+		glog.Warningf("Unable to read the pull secret %s for image %s: %v", path, image.From.Name, err)
+		return docker.AuthConfiguration{}
+	}
+	defer r.Close()
+	glog.V(3).Infof("Using the pull secret %s for image %s", image.PullSecret.Name, image.From.Name)
+	return stidocker.GetImageRegistryAuth(r, image.From.Name)
+}
@@ -0,0 +1,263 @@
+package builder
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func testArchive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for name, content := range files {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func gzipArchive(t *testing.T, data []byte) []byte {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	if _, err := gw.Write(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestExtractInputBinary(t *testing.T) {
+	archive := testArchive(t, map[string]string{
+		"Dockerfile":     "FROM scratch\n",
+		"src/app/app.go": "package main\n",
+	})
+	tests := []struct {
+		name    string
+		input   []byte
+		source  *api.BinaryBuildSource
+		files   []string
+		wantErr bool
+	}{
+		{
+			name:   "tar archive",
+			input:  archive,
+			source: &api.BinaryBuildSource{},
+			files:  []string{"Dockerfile", "src/app/app.go"},
+		},
+		{
+			name:   "gzipped tar archive",
+			input:  gzipArchive(t, archive),
+			source: &api.BinaryBuildSource{},
+			files:  []string{"Dockerfile", "src/app/app.go"},
+		},
+		{
+			name:   "single file",
+			input:  testArchive(t, map[string]string{"app.war": "binary"}),
+			source: &api.BinaryBuildSource{AsFile: "app.war"},
+			files:  []string{"app.war"},
+		},
+		{
+			name:    "missing file",
+			input:   archive,
+			source:  &api.BinaryBuildSource{AsFile: "app.war"},
+			wantErr: true,
+		},
+		{
+			name:    "entry outside of the build directory",
+			input:   testArchive(t, map[string]string{"../evil": "binary"}),
+			source:  &api.BinaryBuildSource{},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		dir, err := ioutil.TempDir("", "binary-input")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		// the client never closes the stream, so extraction must not wait for EOF
+		r, w := io.Pipe()
+		go w.Write(test.input)
+
+		err = extractInputBinary(r, test.source, dir)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		for _, file := range test.files {
+			if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+				t.Errorf("%s: expected %s to be extracted: %v", test.name, file, err)
+			}
+		}
+	}
+}
+
+func TestExtractTarStreamSymlinks(t *testing.T) {
+	outside, err := ioutil.TempDir("", "outside")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(outside)
+
+	tests := []struct {
+		name    string
+		entries []tar.Header
+		files   []string
+		wantErr bool
+	}{
+		{
+			name: "link inside of the build directory",
+			entries: []tar.Header{
+				{Name: "src/app", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "app", Typeflag: tar.TypeSymlink, Linkname: "src/app"},
+				{Name: "app/main.go", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+			files: []string{"src/app/main.go"},
+		},
+		{
+			name: "absolute link",
+			entries: []tar.Header{
+				{Name: "link", Typeflag: tar.TypeSymlink, Linkname: outside},
+				{Name: "link/evil", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+			wantErr: true,
+		},
+		{
+			name: "relative link outside of the build directory",
+			entries: []tar.Header{
+				{Name: "src/link", Typeflag: tar.TypeSymlink, Linkname: "../.."},
+				{Name: "src/link/evil", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+			wantErr: true,
+		},
+		{
+			name: "link leaving the build directory through another link",
+			entries: []tar.Header{
+				{Name: "a/b/up", Typeflag: tar.TypeSymlink, Linkname: "../.."},
+				{Name: "a/b/link", Typeflag: tar.TypeSymlink, Linkname: "up/.."},
+				{Name: "a/b/link/evil", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		dir, err := ioutil.TempDir("", "binary-input")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		for i := range test.entries {
+			if err := tw.WriteHeader(&test.entries[i]); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if err := tw.Close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		err = extractTarStream(dir, buf)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+		} else if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		for _, file := range test.files {
+			if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+				t.Errorf("%s: expected %s to be extracted: %v", test.name, file, err)
+			}
+		}
+		if _, err := os.Stat(filepath.Join(outside, "evil")); err == nil {
+			t.Errorf("%s: expected no file to be written outside of the build directory", test.name)
+		}
+		if _, err := os.Stat(filepath.Join(filepath.Dir(dir), "evil")); err == nil {
+			os.Remove(filepath.Join(filepath.Dir(dir), "evil"))
+			t.Errorf("%s: expected no file to be written outside of the build directory", test.name)
+		}
+	}
+}
+
+func TestExtractSourceFromImages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "image-source")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var pulled, removed []string
+	client := &FakeDocker{
+		pullImageFunc: func(opts docker.PullImageOptions, auth docker.AuthConfiguration) error {
+			pulled = append(pulled, opts.Repository+":"+opts.Tag)
+			return nil
+		},
+		removeContainerFunc: func(opts docker.RemoveContainerOptions) error {
+			removed = append(removed, opts.ID)
+			return nil
+		},
+		copyFromContainerFunc: func(opts docker.CopyFromContainerOptions) error {
+			files := map[string]map[string]string{
+				"/opt/app/app.war": {"app.war": "binary"},
+				"/opt/config":      {"config/app.properties": "key=value", "config/log.properties": "level=info"},
+			}[opts.Resource]
+			_, err := opts.OutputStream.Write(testArchive(t, files))
+			return err
+		},
+	}
+	images := []api.ImageSource{
+		{
+			From: kapi.ObjectReference{Kind: "DockerImage", Name: "registry/artifacts:latest"},
+			Paths: []api.ImageSourcePath{
+				{SourcePath: "/opt/app/app.war", DestinationDir: "deployments"},
+				{SourcePath: "/opt/config"},
+			},
+		},
+	}
+
+	if err := extractSourceFromImages(client, images, dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pulled) != 1 || pulled[0] != "registry/artifacts:latest" {
+		t.Errorf("expected the source image to be pulled, got %v", pulled)
+	}
+	if len(removed) != 1 {
+		t.Errorf("expected the container to be removed, got %v", removed)
+	}
+	for _, file := range []string{"deployments/app.war", "config/app.properties", "config/log.properties"} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("expected %s to be extracted: %v", file, err)
+		}
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/golang/glog"
 	stiapi "github.com/openshift/source-to-image/pkg/api"
@@ -12,6 +15,7 @@ import (
 	"github.com/openshift/source-to-image/pkg/api/validation"
 	sti "github.com/openshift/source-to-image/pkg/build/strategies"
 	stidocker "github.com/openshift/source-to-image/pkg/docker"
+	"github.com/openshift/source-to-image/pkg/git"
 	kapi "k8s.io/kubernetes/pkg/api"
 
 	docker "github.com/fsouza/go-dockerclient"
@@ -26,6 +30,8 @@ type STIBuilder struct {
 	authPresent  bool
 	auth         docker.AuthConfiguration
 	build        *api.Build
+	// in is the stream the binary input of the build is read from
+	in io.Reader
 }
 
 // NewSTIBuilder creates a new STIBuilder instance
@@ -36,6 +42,7 @@ func NewSTIBuilder(client DockerClient, dockerSocket string, authCfg docker.Auth
 		authPresent:  authPresent,
 		auth:         authCfg,
 		build:        build,
+		in:           os.Stdin,
 	}
 }
 
@@ -56,10 +63,35 @@ func (s *STIBuilder) Build() error {
 	}
 	tag := s.build.Spec.Output.To.Name
 
+	// S2I clones the repository itself, so that the image records its location,
+	// unless the binary input, the image sources or the build secrets have to be
+	// assembled with it locally first
+	var source string
+	var secretFiles []buildSecretFile
+	if s.build.Spec.Source.Git != nil && !hasLocalSource(s.build) {
+		if err := checkSourceURI(git.New(), s.build.Spec.Source.Git.URI, urlCheckTimeout); err != nil {
+			return newBuildError(api.StatusReasonFetchSourceFailed, err)
+		}
+		source = s.build.Spec.Source.Git.URI
+	} else {
+		dir, err := ioutil.TempDir("", "sti-source")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		if err := s.fetchSource(dir); err != nil {
+			return newBuildError(api.StatusReasonFetchSourceFailed, err)
+		}
+		if secretFiles, err = copyBuildSecrets(s.build.Spec.Source.Secrets, filepath.Join(dir, s.build.Spec.Source.ContextDir)); err != nil {
+			return newBuildError(api.StatusReasonFetchSourceFailed, err)
+		}
+		source = dir
+	}
+
 	config := &stiapi.Config{
 		BuilderImage:   s.build.Spec.Strategy.SourceStrategy.From.Name,
 		DockerConfig:   &stiapi.DockerConfig{Endpoint: s.dockerSocket},
-		Source:         s.build.Spec.Source.Git.URI,
+		Source:         source,
 		ContextDir:     s.build.Spec.Source.ContextDir,
 		DockerCfgPath:  os.Getenv(dockercfg.PullAuthType),
 		Tag:            tag,
@@ -72,7 +104,7 @@ func (s *STIBuilder) Build() error {
 	if s.build.Spec.Revision != nil && s.build.Spec.Revision.Git != nil &&
 		s.build.Spec.Revision.Git.Commit != "" {
 		config.Ref = s.build.Spec.Revision.Git.Commit
-	} else if s.build.Spec.Source.Git.Ref != "" {
+	} else if s.build.Spec.Source.Git != nil && s.build.Spec.Source.Git.Ref != "" {
 		config.Ref = s.build.Spec.Source.Git.Ref
 	}
 
@@ -101,9 +133,10 @@ func (s *STIBuilder) Build() error {
 		glog.Infof("Using provided pull secret for pulling %s image", config.BuilderImage)
 	}
 	glog.V(2).Infof("Creating a new S2I builder with build config: %#v\n", describe.DescribeConfig(config))
+	// the builder image is pulled or inspected to choose the strategy
 	builder, err := sti.GetStrategy(config)
 	if err != nil {
-		return err
+		return newBuildError(api.StatusReasonPullBuilderImageFailed, err)
 	}
 
 	glog.V(4).Infof("Starting S2I build from %s/%s BuildConfig ...", s.build.Namespace, s.build.Name)
@@ -111,7 +144,7 @@ func (s *STIBuilder) Build() error {
 	origProxy := make(map[string]string)
 	var setHttp, setHttps bool
 	// set the http proxy to be used by the git clone performed by S2I
-	if len(s.build.Spec.Source.Git.HTTPSProxy) != 0 {
+	if s.build.Spec.Source.Git != nil && len(s.build.Spec.Source.Git.HTTPSProxy) != 0 {
 		glog.V(2).Infof("Setting https proxy variables for Git to %s", s.build.Spec.Source.Git.HTTPSProxy)
 		origProxy["HTTPS_PROXY"] = os.Getenv("HTTPS_PROXY")
 		origProxy["https_proxy"] = os.Getenv("https_proxy")
@@ -119,7 +152,7 @@ func (s *STIBuilder) Build() error {
 		os.Setenv("https_proxy", s.build.Spec.Source.Git.HTTPSProxy)
 		setHttps = true
 	}
-	if len(s.build.Spec.Source.Git.HTTPProxy) != 0 {
+	if s.build.Spec.Source.Git != nil && len(s.build.Spec.Source.Git.HTTPProxy) != 0 {
 		glog.V(2).Infof("Setting http proxy variables for Git to %s", s.build.Spec.Source.Git.HTTPSProxy)
 		origProxy["HTTP_PROXY"] = os.Getenv("HTTP_PROXY")
 		origProxy["http_proxy"] = os.Getenv("http_proxy")
@@ -129,7 +162,7 @@ func (s *STIBuilder) Build() error {
 	}
 
 	if _, err = builder.Build(config); err != nil {
-		return err
+		return newBuildError(stiErrorReason(err), err)
 	}
 
 	// reset http proxy env variables to original value
@@ -146,6 +179,14 @@ func (s *STIBuilder) Build() error {
 		os.Setenv("http_proxy", origProxy["http_proxy"])
 	}
 
+	if err := squashBuildSecrets(s.dockerClient, tag, secretFiles); err != nil {
+		return newBuildError(api.StatusReasonAssembleFailed, err)
+	}
+
+	if err := execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, tag); err != nil {
+		return newBuildError(api.StatusReasonPostCommitHookFailed, err)
+	}
+
 	if push {
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
@@ -158,10 +199,35 @@ func (s *STIBuilder) Build() error {
 		}
 		glog.Infof("Pushing %s image ...", tag)
 		if err := pushImage(s.dockerClient, tag, s.auth); err != nil {
-			return fmt.Errorf("Failed to push image: %v", err)
+			return newBuildError(api.StatusReasonPushImageFailed, fmt.Errorf("Failed to push image: %v", err))
 		}
 		glog.Infof("Successfully pushed %s", tag)
 		glog.Flush()
 	}
 	return nil
 }
+
+// hasLocalSource returns true if the source of the build has to be assembled
+// before S2I builds it.
+func hasLocalSource(build *api.Build) bool {
+	source := build.Spec.Source
+	return source.Binary != nil || len(source.Images) > 0 || len(source.Secrets) > 0
+}
+
+// fetchSource assembles the source of the build in dir. The binary input or the
+// git repository is retrieved first and the paths of the image sources are
+// copied on top of it.
+func (s *STIBuilder) fetchSource(dir string) error {
+	source := s.build.Spec.Source
+	switch {
+	case source.Binary != nil:
+		if err := extractInputBinary(s.in, source.Binary, dir); err != nil {
+			return err
+		}
+	case source.Git != nil:
+		if err := fetchGitSource(git.New(), s.build, dir, urlCheckTimeout); err != nil {
+			return err
+		}
+	}
+	return extractSourceFromImages(s.dockerClient, source.Images, dir)
+}
@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestSTIBuildFetchSourceFailed(t *testing.T) {
+	builder := NewSTIBuilder(&FakeDocker{}, "", docker.AuthConfiguration{}, false, &api.Build{
+		Spec: api.BuildSpec{
+			Source: api.BuildSource{
+				Type: api.BuildSourceGit,
+				Git:  &api.GitBuildSource{URI: "not a repository"},
+			},
+			Strategy: api.BuildStrategy{
+				Type:           api.SourceBuildStrategyType,
+				SourceStrategy: &api.SourceBuildStrategy{From: kapi.ObjectReference{Kind: "DockerImage", Name: "builder"}},
+			},
+		},
+	})
+	err := builder.Build()
+	if reason := ReasonForError(err); reason != api.StatusReasonFetchSourceFailed {
+		t.Errorf("Expected reason %s, got %s: %v", api.StatusReasonFetchSourceFailed, reason, err)
+	}
+}
@@ -15,10 +15,12 @@ func getBuildEnvVars(build *buildapi.Build) map[string]string {
 	envVars := map[string]string{
 		"OPENSHIFT_BUILD_NAME":      build.Name,
 		"OPENSHIFT_BUILD_NAMESPACE": build.Namespace,
-		"OPENSHIFT_BUILD_SOURCE":    build.Spec.Source.Git.URI,
 	}
-	if build.Spec.Source.Git.Ref != "" {
-		envVars["OPENSHIFT_BUILD_REFERENCE"] = build.Spec.Source.Git.Ref
+	if build.Spec.Source.Git != nil {
+		envVars["OPENSHIFT_BUILD_SOURCE"] = build.Spec.Source.Git.URI
+		if build.Spec.Source.Git.Ref != "" {
+			envVars["OPENSHIFT_BUILD_REFERENCE"] = build.Spec.Source.Git.Ref
+		}
 	}
 	if build.Spec.Revision != nil &&
 		build.Spec.Revision.Git != nil &&
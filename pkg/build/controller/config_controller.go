@@ -44,6 +44,7 @@ func (c *BuildConfigController) HandleBuildConfig(bc *buildapi.BuildConfig) erro
 			Namespace: bc.Namespace,
 		},
 		LastVersion: &lastVersion,
+		TriggeredBy: []buildapi.BuildTriggerCause{{Message: buildapi.BuildTriggerCauseConfigMsg}},
 	}
 	if _, err := c.BuildConfigInstantiator.Instantiate(bc.Namespace, request); err != nil {
 		var instantiateErr error
@@ -9,17 +9,27 @@ import (
 	errors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildprune "github.com/openshift/origin/pkg/build/prune"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
+// podDeadlineExceededReason is the reason the kubelet sets on a pod that was
+// active for longer than its ActiveDeadlineSeconds.
+const podDeadlineExceededReason = "DeadlineExceeded"
+
 // BuildController watches build resources and manages their state
 type BuildController struct {
+	BuildLister       buildclient.BuildLister
 	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
 	PodManager        podManager
 	BuildStrategy     BuildStrategy
 	ImageStreamClient imageStreamClient
@@ -63,6 +73,7 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	build.Status.Phase = buildapi.BuildPhaseCancelled
+	build.Status.Reason = buildapi.StatusReasonCancelled
 	now := util.Now()
 	build.Status.CompletionTimestamp = &now
 	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
@@ -70,6 +81,7 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
+	pruneBuildHistory(build, bc.BuildLister, bc.BuildConfigGetter, bc.BuildDeleter)
 	return nil
 }
 
@@ -85,11 +97,34 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		}
 	}
 
-	// Handle new builds
+	// Start the next queued build once a serial build completes. The error is
+	// not returned because retrying would fail the completed build; the queued
+	// build is handled again on the next resync.
 	if build.Status.Phase != buildapi.BuildPhaseNew {
+		if err := bc.startNextQueuedBuild(build); err != nil {
+			util.HandleError(fmt.Errorf("failed to start the next queued build after %s/%s: %v", build.Namespace, build.Name, err))
+		}
 		return nil
 	}
 
+	// Handle new builds
+	return bc.startBuild(build)
+}
+
+// startBuild creates the pod for a new build unless its run policy requires it
+// to wait for other builds of its BuildConfig.
+func (bc *BuildController) startBuild(build *buildapi.Build) error {
+	if !build.Status.Cancelled && isSerialBuild(build) {
+		wait, err := bc.mustWait(build)
+		if err != nil {
+			return fmt.Errorf("Failed to schedule Build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+		if wait {
+			glog.V(4).Infof("Build %s/%s is queued until the previous builds of BuildConfig %s complete", build.Namespace, build.Name, build.Status.Config.Name)
+			return nil
+		}
+	}
+
 	if err := bc.nextBuildPhase(build); err != nil {
 		return fmt.Errorf("Build failed with error %s/%s: %v", build.Namespace, build.Name, err)
 	}
@@ -100,6 +135,10 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		// same "new" imageid change in the future, which is better than guaranteeing we
 		// run the build 2+ times by retrying it here.
 		glog.V(2).Infof("Failed to record changes to Build %s/%s: %v", build.Namespace, build.Name, err)
+		return nil
+	}
+	if buildutil.IsBuildComplete(build) {
+		pruneBuildHistory(build, bc.BuildLister, bc.BuildConfigGetter, bc.BuildDeleter)
 	}
 	return nil
 }
@@ -112,6 +151,7 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	if build.Status.Cancelled {
 		glog.V(4).Infof("Cancelling Build %s/%s.", build.Namespace, build.Name)
 		build.Status.Phase = buildapi.BuildPhaseCancelled
+		build.Status.Reason = buildapi.StatusReasonCancelled
 		return nil
 	}
 
@@ -174,6 +214,10 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	if err != nil {
 		return fmt.Errorf("the strategy failed to create a build pod for Build %s/%s: %v", build.Namespace, build.Name, err)
 	}
+	// the kubelet terminates the pod once it has been active for longer than the deadline
+	if build.Spec.CompletionDeadlineSeconds != nil {
+		podSpec.Spec.ActiveDeadlineSeconds = build.Spec.CompletionDeadlineSeconds
+	}
 	glog.V(4).Infof("Pod %s for Build %s/%s is about to be created", podSpec.Name, build.Namespace, build.Name)
 
 	if _, err := bc.PodManager.CreatePod(build.Namespace, podSpec); err != nil {
@@ -192,9 +236,12 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 
 // BuildPodController watches pods running builds and manages the build state
 type BuildPodController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
-	PodManager   podManager
+	BuildStore        cache.Store
+	BuildLister       buildclient.BuildLister
+	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	PodManager        podManager
 }
 
 // HandlePod updates the state of the build based on the pod state
@@ -224,6 +271,10 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			// should be failed.
 			glog.V(2).Infof("Failing build %s/%s because the pod has no containers", build.Namespace, build.Name)
 			nextStatus = buildapi.BuildPhaseFailed
+			if build.Status.Phase != nextStatus {
+				build.Status.Reason = buildapi.StatusReasonGenericBuildFailed
+				build.Status.Message = "The build pod has no containers."
+			}
 		} else {
 			for _, info := range pod.Status.ContainerStatuses {
 				if info.State.Terminated != nil && info.State.Terminated.ExitCode != 0 {
@@ -231,9 +282,21 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 					break
 				}
 			}
+			if nextStatus == buildapi.BuildPhaseFailed && build.Status.Phase != nextStatus {
+				build.Status.Reason, build.Status.Message = failureReasonFor(pod)
+			}
 		}
 	case kapi.PodFailed:
 		nextStatus = buildapi.BuildPhaseFailed
+		if build.Status.Phase != nextStatus {
+			if isPodDeadlineExceeded(pod) {
+				glog.V(2).Infof("Failing build %s/%s because it exceeded its completion deadline", build.Namespace, build.Name)
+				build.Status.Reason = buildapi.StatusReasonTimeout
+				build.Status.Message = buildutil.DeadlineExceededMessage
+			} else {
+				build.Status.Reason, build.Status.Message = failureReasonFor(pod)
+			}
+		}
 	}
 
 	if build.Status.Phase != nextStatus {
@@ -251,10 +314,103 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			return fmt.Errorf("failed to update Build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 		glog.V(4).Infof("Build %s/%s status was updated %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+		if buildutil.IsBuildComplete(build) {
+			pruneBuildHistory(build, bc.BuildLister, bc.BuildConfigGetter, bc.BuildDeleter)
+		}
 	}
 	return nil
 }
 
+// pruneBuildHistory deletes the builds of the BuildConfig the completed build
+// was created from that exceed the history limits of that BuildConfig. Errors
+// are logged and ignored, so pruning never fails the handling of a build.
+func pruneBuildHistory(build *buildapi.Build, buildLister buildclient.BuildLister, buildConfigGetter buildclient.BuildConfigGetter, buildDeleter buildclient.BuildDeleter) {
+	if err := pruneBuildConfigHistory(build, buildLister, buildConfigGetter, buildDeleter); err != nil {
+		util.HandleError(fmt.Errorf("failed to prune the build history of Build %s/%s: %v", build.Namespace, build.Name, err))
+	}
+}
+
+// pruneBuildConfigHistory does the work of pruneBuildHistory. The builds are
+// listed from the server, since a store would usually not have observed the
+// completion of build yet. The pods of the deleted builds are removed by the
+// BuildDeleteController.
+func pruneBuildConfigHistory(build *buildapi.Build, buildLister buildclient.BuildLister, buildConfigGetter buildclient.BuildConfigGetter, buildDeleter buildclient.BuildDeleter) error {
+	if build.Status.Config == nil {
+		return nil
+	}
+	buildConfig, err := buildConfigGetter.Get(configNamespace(build), build.Status.Config.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if buildConfig.Spec.SuccessfulBuildsHistoryLimit == nil && buildConfig.Spec.FailedBuildsHistoryLimit == nil {
+		return nil
+	}
+
+	builds, err := listConfigBuilds(buildLister, build)
+	if err != nil {
+		return err
+	}
+	for i := range builds {
+		if builds[i].Name == build.Name {
+			builds[i] = build
+		}
+	}
+	pruneTask := buildprune.NewBuildConfigHistoryPruneTasker(buildConfig, builds, func(b *buildapi.Build) error {
+		glog.V(4).Infof("Pruning Build %s/%s of BuildConfig %s", b.Namespace, b.Name, buildConfig.Name)
+		if err := buildDeleter.Delete(b.Namespace, b.Name); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return pruneTask.PruneTask()
+}
+
+// configNamespace returns the namespace of the BuildConfig build was created
+// from.
+func configNamespace(build *buildapi.Build) string {
+	if len(build.Status.Config.Namespace) > 0 {
+		return build.Status.Config.Namespace
+	}
+	return build.Namespace
+}
+
+// listConfigBuilds lists the builds of the BuildConfig build was created from,
+// including build itself, from the server.
+func listConfigBuilds(buildLister buildclient.BuildLister, build *buildapi.Build) ([]*buildapi.Build, error) {
+	name := build.Status.Config.Name
+	list, err := buildLister.List(configNamespace(build), labels.SelectorFromSet(labels.Set{buildapi.BuildConfigLabel: name}), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	builds := []*buildapi.Build{}
+	for i := range list.Items {
+		if b := &list.Items[i]; b.Status.Config != nil && b.Status.Config.Name == name {
+			builds = append(builds, b)
+		}
+	}
+	return builds, nil
+}
+
+// failureReasonFor returns the reason and the message of a build failure the
+// builder recorded in the termination message of the failed build container.
+func failureReasonFor(pod *kapi.Pod) (buildapi.StatusReason, string) {
+	for _, info := range pod.Status.ContainerStatuses {
+		if terminated := info.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return buildutil.ParseTerminationMessage(terminated.Message)
+		}
+	}
+	return buildapi.StatusReasonGenericBuildFailed, ""
+}
+
+// isPodDeadlineExceeded returns true if the kubelet failed the pod because it was
+// active for longer than its deadline.
+func isPodDeadlineExceeded(pod *kapi.Pod) bool {
+	return pod.Status.Phase == kapi.PodFailed && pod.Status.Reason == podDeadlineExceededReason && pod.Spec.ActiveDeadlineSeconds != nil
+}
+
 // isBuildCancellable checks for build status and returns true if the condition is checked.
 func isBuildCancellable(build *buildapi.Build) bool {
 	return build.Status.Phase == buildapi.BuildPhaseNew || build.Status.Phase == buildapi.BuildPhasePending || build.Status.Phase == buildapi.BuildPhaseRunning
@@ -262,8 +418,11 @@ func isBuildCancellable(build *buildapi.Build) bool {
 
 // BuildPodDeleteController watches pods running builds and updates the build if the pod is deleted
 type BuildPodDeleteController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
+	BuildStore        cache.Store
+	BuildLister       buildclient.BuildLister
+	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
 }
 
 // HandleBuildPodDeletion sets the status of a build to error if the build pod has been deleted
@@ -295,12 +454,14 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 	if build.Status.Phase != nextStatus {
 		glog.V(4).Infof("Updating build %s/%s status %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
 		build.Status.Phase = nextStatus
+		build.Status.Reason = buildapi.StatusReasonBuildPodDeleted
 		build.Status.Message = "The Pod for this Build was deleted before the Build completed."
 		now := util.Now()
 		build.Status.CompletionTimestamp = &now
 		if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
 			return fmt.Errorf("Failed to update Build %s/%s: %v", build.Namespace, build.Name, err)
 		}
+		pruneBuildHistory(build, bc.BuildLister, bc.BuildConfigGetter, bc.BuildDeleter)
 	}
 	return nil
 }
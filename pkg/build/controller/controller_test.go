@@ -4,15 +4,19 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildtest "github.com/openshift/origin/pkg/build/controller/test"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
@@ -28,6 +32,40 @@ func (ec *errBuildUpdater) Update(namespace string, build *buildapi.Build) error
 	return errors.New("UpdateBuild error!")
 }
 
+type fakeBuildDeleter struct {
+	deleted []string
+}
+
+func (d *fakeBuildDeleter) Delete(namespace, name string) error {
+	d.deleted = append(d.deleted, name)
+	return nil
+}
+
+type fakeBuildLister struct {
+	builds []*buildapi.Build
+}
+
+func (l *fakeBuildLister) List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error) {
+	list := &buildapi.BuildList{}
+	for _, build := range l.builds {
+		if build.Namespace == namespace && label.Matches(labels.Set(build.Labels)) {
+			list.Items = append(list.Items, *build)
+		}
+	}
+	return list, nil
+}
+
+type fakeBuildConfigGetter struct {
+	buildConfig *buildapi.BuildConfig
+}
+
+func (g *fakeBuildConfigGetter) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	if g.buildConfig == nil {
+		return nil, kerrors.NewNotFound("BuildConfig", name)
+	}
+	return g.buildConfig, nil
+}
+
 type okStrategy struct {
 	build *buildapi.Build
 }
@@ -37,6 +75,14 @@ func (os *okStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod, error) {
 	return &kapi.Pod{}, nil
 }
 
+type podStrategy struct {
+	pod *kapi.Pod
+}
+
+func (ps *podStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod, error) {
+	return ps.pod, nil
+}
+
 type errStrategy struct{}
 
 func (es *errStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod, error) {
@@ -139,7 +185,10 @@ func mockBuild(phase buildapi.BuildPhase, output buildapi.BuildOutput) *buildapi
 
 func mockBuildController() *BuildController {
 	return &BuildController{
+		BuildLister:       &fakeBuildLister{},
 		BuildUpdater:      &okBuildUpdater{},
+		BuildDeleter:      &fakeBuildDeleter{},
+		BuildConfigGetter: &fakeBuildConfigGetter{},
 		PodManager:        &okPodManager{},
 		BuildStrategy:     &okStrategy{},
 		ImageStreamClient: &okImageStreamClient{},
@@ -149,9 +198,12 @@ func mockBuildController() *BuildController {
 
 func mockBuildPodController(build *buildapi.Build) *BuildPodController {
 	return &BuildPodController{
-		BuildStore:   buildtest.NewFakeBuildStore(build),
-		BuildUpdater: &okBuildUpdater{},
-		PodManager:   &okPodManager{},
+		BuildStore:        buildtest.NewFakeBuildStore(build),
+		BuildLister:       &fakeBuildLister{},
+		BuildUpdater:      &okBuildUpdater{},
+		BuildDeleter:      &fakeBuildDeleter{},
+		BuildConfigGetter: &fakeBuildConfigGetter{},
+		PodManager:        &okPodManager{},
 	}
 }
 
@@ -518,6 +570,183 @@ func TestHandlePod(t *testing.T) {
 	}
 }
 
+func TestHandlePodDeadlineExceeded(t *testing.T) {
+	deadline := int64(60)
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	build.Spec.CompletionDeadlineSeconds = &deadline
+	ctrl := mockBuildPodController(build)
+	pod := mockPod(kapi.PodFailed, 0)
+	pod.Spec.ActiveDeadlineSeconds = &deadline
+	pod.Status.Reason = "DeadlineExceeded"
+	pod.Status.ContainerStatuses = nil
+
+	if err := ctrl.HandlePod(pod); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseFailed {
+		t.Errorf("Expected %s, got %s", buildapi.BuildPhaseFailed, build.Status.Phase)
+	}
+	if build.Status.Reason != buildapi.StatusReasonTimeout {
+		t.Errorf("Expected reason %s, got %s", buildapi.StatusReasonTimeout, build.Status.Reason)
+	}
+	if build.Status.Message != buildutil.DeadlineExceededMessage {
+		t.Errorf("Expected message %q, got %q", buildutil.DeadlineExceededMessage, build.Status.Message)
+	}
+	if build.Status.CompletionTimestamp == nil {
+		t.Errorf("Expected the completion timestamp to be set")
+	}
+}
+
+func TestHandlePodFailureReason(t *testing.T) {
+	tests := []struct {
+		podPhase           kapi.PodPhase
+		terminationMessage string
+		expectedReason     buildapi.StatusReason
+		expectedMessage    string
+	}{
+		{
+			podPhase:           kapi.PodFailed,
+			terminationMessage: "PushImageFailed: Failed to push image: denied",
+			expectedReason:     buildapi.StatusReasonPushImageFailed,
+			expectedMessage:    "Failed to push image: denied",
+		},
+		{
+			podPhase:           kapi.PodSucceeded,
+			terminationMessage: "FetchSourceFailed: repository not found",
+			expectedReason:     buildapi.StatusReasonFetchSourceFailed,
+			expectedMessage:    "repository not found",
+		},
+		{
+			podPhase:       kapi.PodFailed,
+			expectedReason: buildapi.StatusReasonGenericBuildFailed,
+		},
+	}
+
+	for i, test := range tests {
+		build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+		ctrl := mockBuildPodController(build)
+		pod := mockPod(test.podPhase, 1)
+		pod.Status.ContainerStatuses[0].State.Terminated.Message = test.terminationMessage
+
+		if err := ctrl.HandlePod(pod); err != nil {
+			t.Fatalf("(%d) Unexpected error: %v", i, err)
+		}
+		if build.Status.Phase != buildapi.BuildPhaseFailed {
+			t.Errorf("(%d) Expected %s, got %s", i, buildapi.BuildPhaseFailed, build.Status.Phase)
+		}
+		if build.Status.Reason != test.expectedReason || build.Status.Message != test.expectedMessage {
+			t.Errorf("(%d) Expected %s %q, got %s %q", i, test.expectedReason, test.expectedMessage, build.Status.Reason, build.Status.Message)
+		}
+	}
+}
+
+// mockBuildHistory returns a BuildConfig keeping one successful and one failed
+// build, a build of it in the given phase and a lister with older builds of the
+// BuildConfig and a copy of the build that does not change with it.
+func mockBuildHistory(phase buildapi.BuildPhase) (*buildapi.BuildConfig, *buildapi.Build, *fakeBuildLister) {
+	one := 1
+	buildConfig := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config", Namespace: "namespace"},
+		Spec: buildapi.BuildConfigSpec{
+			SuccessfulBuildsHistoryLimit: &one,
+			FailedBuildsHistoryLimit:     &one,
+		},
+	}
+	mockConfigBuild := func(name string, phase buildapi.BuildPhase, age time.Duration) *buildapi.Build {
+		build := mockBuild(phase, buildapi.BuildOutput{})
+		build.Name = name
+		build.Namespace = buildConfig.Namespace
+		build.CreationTimestamp = util.NewTime(time.Now().Add(-age))
+		build.Labels = map[string]string{buildapi.BuildConfigLabel: buildConfig.Name}
+		build.Status.Config = &kapi.ObjectReference{Name: buildConfig.Name, Namespace: buildConfig.Namespace}
+		return build
+	}
+	build := mockConfigBuild("data-build", phase, 0)
+	lister := &fakeBuildLister{builds: []*buildapi.Build{
+		mockConfigBuild("data-build", phase, 0),
+		mockConfigBuild("complete-1", buildapi.BuildPhaseComplete, time.Hour),
+		mockConfigBuild("complete-2", buildapi.BuildPhaseComplete, 2*time.Hour),
+		mockConfigBuild("failed-1", buildapi.BuildPhaseFailed, time.Hour),
+		mockConfigBuild("running", buildapi.BuildPhaseRunning, 3*time.Hour),
+	}}
+	return buildConfig, build, lister
+}
+
+func expectPrunedBuilds(t *testing.T, deleter *fakeBuildDeleter, names ...string) {
+	deleted := util.NewStringSet(deleter.deleted...)
+	if e := util.NewStringSet(names...); !deleted.IsSuperset(e) || !e.IsSuperset(deleted) {
+		t.Errorf("Expected builds %v to be pruned, got %v", e.List(), deleted.List())
+	}
+}
+
+func TestHandlePodPrunesBuildHistory(t *testing.T) {
+	buildConfig, build, lister := mockBuildHistory(buildapi.BuildPhaseRunning)
+	deleter := &fakeBuildDeleter{}
+	ctrl := mockBuildPodController(build)
+	ctrl.BuildLister = lister
+	ctrl.BuildDeleter = deleter
+	ctrl.BuildConfigGetter = &fakeBuildConfigGetter{buildConfig: buildConfig}
+
+	pod := mockPod(kapi.PodSucceeded, 0)
+	pod.Namespace = build.Namespace
+	if err := ctrl.HandlePod(pod); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectPrunedBuilds(t, deleter, "complete-1", "complete-2")
+}
+
+func TestCancelBuildPrunesBuildHistory(t *testing.T) {
+	buildConfig, build, lister := mockBuildHistory(buildapi.BuildPhaseRunning)
+	deleter := &fakeBuildDeleter{}
+	ctrl := mockBuildController()
+	ctrl.BuildLister = lister
+	ctrl.BuildDeleter = deleter
+	ctrl.BuildConfigGetter = &fakeBuildConfigGetter{buildConfig: buildConfig}
+
+	if err := ctrl.CancelBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectPrunedBuilds(t, deleter, "failed-1", "complete-2")
+}
+
+func TestHandleBuildPodDeletionPrunesBuildHistory(t *testing.T) {
+	buildConfig, build, lister := mockBuildHistory(buildapi.BuildPhaseRunning)
+	deleter := &fakeBuildDeleter{}
+	ctrl := &BuildPodDeleteController{
+		BuildStore:        buildtest.NewFakeBuildStore(build),
+		BuildLister:       lister,
+		BuildUpdater:      &okBuildUpdater{},
+		BuildDeleter:      deleter,
+		BuildConfigGetter: &fakeBuildConfigGetter{buildConfig: buildConfig},
+	}
+
+	pod := mockPod(kapi.PodRunning, 0)
+	pod.Namespace = build.Namespace
+	if err := ctrl.HandleBuildPodDeletion(pod); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseError {
+		t.Fatalf("Expected the build to be in phase %s, got %s", buildapi.BuildPhaseError, build.Status.Phase)
+	}
+	expectPrunedBuilds(t, deleter, "failed-1", "complete-2")
+}
+
+func TestHandleBuildCompletionDeadline(t *testing.T) {
+	deadline := int64(60)
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{})
+	build.Spec.CompletionDeadlineSeconds = &deadline
+	pod := &kapi.Pod{}
+	ctrl := mockBuildController()
+	ctrl.BuildStrategy = &podStrategy{pod: pod}
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pod.Spec.ActiveDeadlineSeconds == nil || *pod.Spec.ActiveDeadlineSeconds != deadline {
+		t.Errorf("Expected the pod deadline to be %d, got %v", deadline, pod.Spec.ActiveDeadlineSeconds)
+	}
+}
+
 func TestCancelBuild(t *testing.T) {
 	type handleCancelBuildTest struct {
 		inStatus            buildapi.BuildPhase
@@ -648,6 +877,9 @@ func TestCancelBuild(t *testing.T) {
 		if build.Status.Phase != tc.outStatus {
 			t.Errorf("(%d) Expected %s, got %s!", i, tc.outStatus, build.Status.Phase)
 		}
+		if tc.inStatus != buildapi.BuildPhaseCancelled && tc.outStatus == buildapi.BuildPhaseCancelled && build.Status.Reason != buildapi.StatusReasonCancelled {
+			t.Errorf("(%d) Expected reason %s, got %s!", i, buildapi.StatusReasonCancelled, build.Status.Reason)
+		}
 	}
 }
 
@@ -798,8 +1030,11 @@ func (c *customBuildUpdater) Update(namespace string, build *buildapi.Build) err
 
 func mockBuildPodDeleteController(build *buildapi.Build, buildUpdater *customBuildUpdater, err error) *BuildPodDeleteController {
 	return &BuildPodDeleteController{
-		BuildStore:   buildtest.FakeBuildStore{Build: build, Err: err},
-		BuildUpdater: buildUpdater,
+		BuildStore:        buildtest.FakeBuildStore{Build: build, Err: err},
+		BuildLister:       &fakeBuildLister{},
+		BuildUpdater:      buildUpdater,
+		BuildDeleter:      &fakeBuildDeleter{},
+		BuildConfigGetter: &fakeBuildConfigGetter{},
 	}
 }
 
@@ -822,6 +1057,9 @@ func TestHandleBuildPodDeletionOK(t *testing.T) {
 	if !updateWasCalled {
 		t.Error("UpdateBuild was not called when it should!")
 	}
+	if build.Status.Reason != buildapi.StatusReasonBuildPodDeleted {
+		t.Errorf("Expected reason %s, got %s", buildapi.StatusReasonBuildPodDeleted, build.Status.Reason)
+	}
 }
 
 func TestHandleBuildPodDeletionOKFinishedBuild(t *testing.T) {
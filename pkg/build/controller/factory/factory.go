@@ -38,6 +38,7 @@ func limitedLogAndRetry(buildupdater buildclient.BuildUpdater, maxTimeout time.D
 			return true
 		}
 		build.Status.Phase = buildapi.BuildPhaseFailed
+		build.Status.Reason = buildapi.StatusReasonCannotCreateBuildPod
 		build.Status.Message = err.Error()
 		now := kutil.Now()
 		build.Status.CompletionTimestamp = &now
@@ -73,7 +74,10 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildController := &buildcontroller.BuildController{
+		BuildLister:       buildclient.NewOSClientBuildClient(factory.OSClient),
 		BuildUpdater:      factory.BuildUpdater,
+		BuildDeleter:      buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(factory.OSClient),
 		ImageStreamClient: client,
 		PodManager:        client,
 		BuildStrategy: &typeBasedFactoryStrategy{
@@ -170,9 +174,12 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildPodController := &buildcontroller.BuildPodController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
-		PodManager:   client,
+		BuildStore:        factory.buildStore,
+		BuildLister:       buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildUpdater:      factory.BuildUpdater,
+		BuildDeleter:      buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(factory.OSClient),
+		PodManager:        client,
 	}
 
 	return &controller.RetryController{
@@ -197,8 +204,11 @@ func (factory *BuildPodControllerFactory) CreateDeleteController() controller.Ru
 	cache.NewReflector(&buildPodDeleteLW{client, queue}, &kapi.Pod{}, queue, 5*time.Minute).RunUntil(factory.Stop)
 
 	buildPodDeleteController := &buildcontroller.BuildPodDeleteController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
+		BuildStore:        factory.buildStore,
+		BuildLister:       buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildUpdater:      factory.BuildUpdater,
+		BuildDeleter:      buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(factory.OSClient),
 	}
 
 	return &controller.RetryController{
@@ -291,6 +301,40 @@ func (factory *BuildConfigControllerFactory) Create() controller.RunnableControl
 	}
 }
 
+// PipelineControllerFactory can create a PipelineController which obtains Pipelines
+// from a queue populated from a watch of all Pipelines.
+type PipelineControllerFactory struct {
+	Client     osclient.Interface
+	KubeClient kclient.Interface
+	// Stop may be set to allow controllers created by this factory to be terminated.
+	Stop <-chan struct{}
+}
+
+// Create creates a new PipelineController. Pipelines are resynced often, since they
+// wait on Builds, deployments and pods without watching them.
+func (factory *PipelineControllerFactory) Create() controller.RunnableController {
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&pipelineLW{client: factory.Client}, &buildapi.Pipeline{}, queue, 30*time.Second).RunUntil(factory.Stop)
+
+	pipelineController := &buildcontroller.PipelineController{
+		Client:     factory.Client,
+		KubeClient: factory.KubeClient,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			retryFunc("Pipeline", nil),
+			kutil.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			pipeline := obj.(*buildapi.Pipeline)
+			return pipelineController.HandlePipeline(pipeline)
+		},
+	}
+}
+
 // podEnumerator allows a cache.Poller to enumerate items in an api.PodList
 type podEnumerator struct {
 	*kapi.PodList
@@ -488,6 +532,21 @@ func (lw *buildConfigLW) Watch(resourceVersion string) (watch.Interface, error)
 	return lw.client.BuildConfigs(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
 }
 
+// pipelineLW is a ListWatcher implementation for Pipelines.
+type pipelineLW struct {
+	client osclient.Interface
+}
+
+// List lists all Pipelines.
+func (lw *pipelineLW) List() (runtime.Object, error) {
+	return lw.client.Pipelines(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+}
+
+// Watch watches all Pipelines.
+func (lw *pipelineLW) Watch(resourceVersion string) (watch.Interface, error) {
+	return lw.client.Pipelines(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+}
+
 // imageStreamLW is a ListWatcher for ImageStreams.
 type imageStreamLW struct {
 	client osclient.Interface
@@ -28,8 +28,8 @@ func (e ImageChangeControllerFatalError) Error() string {
 }
 
 // ImageChangeController watches for changes to ImageRepositories and triggers
-// builds when a new version of a tag referenced by a BuildConfig
-// is available.
+// builds when a new version of a tag referenced by an ImageChange trigger or
+// an image source of a BuildConfig is available.
 type ImageChangeController struct {
 	BuildConfigStore        cache.Store
 	BuildConfigInstantiator buildclient.BuildConfigInstantiator
@@ -42,6 +42,57 @@ func getImageStreamNameFromReference(ref *kapi.ObjectReference) string {
 	return strings.Split(name, "@")[0]
 }
 
+// latestTaggedImage returns the pull spec of the latest image of repo tagged with the
+// ImageStreamTag from, if from refers to repo.
+func latestTaggedImage(repo *imageapi.ImageStream, config *buildapi.BuildConfig, from *kapi.ObjectReference) (string, bool) {
+	fromStreamName, tag, ok := imageapi.SplitImageStreamTag(from.Name)
+	if !ok {
+		glog.Errorf("Invalid image stream tag: %s in build config %s/%s", from.Name, config.Name, config.Namespace)
+		return "", false
+	}
+
+	fromNamespace := from.Namespace
+	if len(fromNamespace) == 0 {
+		fromNamespace = config.Namespace
+	}
+
+	// only trigger a build if this image repo matches the name and namespace of the ref in the build trigger
+	// also do not trigger if the imagerepo does not have a valid DockerImageRepository value for us to pull
+	// the image from
+	if len(repo.Status.DockerImageRepository) == 0 || fromStreamName != repo.Name || fromNamespace != repo.Namespace {
+		return "", false
+	}
+
+	// This split is safe because ImageStreamTag names always have the form
+	// name:tag.
+	latest := imageapi.LatestTaggedImage(repo, tag)
+	if latest == nil {
+		glog.V(4).Infof("unable to find tagged image: no image recorded for %s/%s:%s", repo.Namespace, repo.Name, tag)
+		return "", false
+	}
+	glog.V(4).Infof("Found ImageStream %s/%s with tag %s", repo.Namespace, repo.Name, tag)
+	return latest.DockerImageReference, true
+}
+
+// hasImageStreamTag returns true if refs contains the ImageStreamTag ref, where
+// references without a namespace are in namespace.
+func hasImageStreamTag(refs []*kapi.ObjectReference, ref *kapi.ObjectReference, namespace string) bool {
+	refNamespace := ref.Namespace
+	if len(refNamespace) == 0 {
+		refNamespace = namespace
+	}
+	for _, r := range refs {
+		rNamespace := r.Namespace
+		if len(rNamespace) == 0 {
+			rNamespace = namespace
+		}
+		if r.Name == ref.Name && rNamespace == refNamespace {
+			return true
+		}
+	}
+	return false
+}
+
 // HandleImageRepo processes the next ImageStream event.
 func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) error {
 	glog.V(4).Infof("Build image change controller detected ImageStream change %s", repo.Status.DockerImageRepository)
@@ -61,6 +112,7 @@ func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) erro
 			from           *kapi.ObjectReference
 			shouldBuild    = false
 			triggeredImage = ""
+			triggerRefs    []*kapi.ObjectReference
 		)
 		// For every ImageChange trigger find the latest tagged image from the image repository and
 		// invoke a build using that image id. A new build is triggered only if the latest tagged image id or pull spec
@@ -78,36 +130,14 @@ func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) erro
 			if from == nil || from.Kind != "ImageStreamTag" {
 				continue
 			}
-			fromStreamName, tag, ok := imageapi.SplitImageStreamTag(from.Name)
+			triggerRefs = append(triggerRefs, from)
+			next, ok := latestTaggedImage(repo, config, from)
 			if !ok {
-				glog.Errorf("Invalid image stream tag: %s in build config %s/%s", from.Name, config.Name, config.Namespace)
 				continue
 			}
 
-			fromNamespace := from.Namespace
-			if len(fromNamespace) == 0 {
-				fromNamespace = config.Namespace
-			}
-
-			// only trigger a build if this image repo matches the name and namespace of the ref in the build trigger
-			// also do not trigger if the imagerepo does not have a valid DockerImageRepository value for us to pull
-			// the image from
-			if len(repo.Status.DockerImageRepository) == 0 || fromStreamName != repo.Name || fromNamespace != repo.Namespace {
-				continue
-			}
-
-			// This split is safe because ImageStreamTag names always have the form
-			// name:tag.
-			latest := imageapi.LatestTaggedImage(repo, tag)
-			if latest == nil {
-				glog.V(4).Infof("unable to find tagged image: no image recorded for %s/%s:%s", repo.Namespace, repo.Name, tag)
-				continue
-			}
-			glog.V(4).Infof("Found ImageStream %s/%s with tag %s", repo.Namespace, repo.Name, tag)
-
 			// (must be different) to trigger a build
 			last := trigger.ImageChange.LastTriggeredImageID
-			next := latest.DockerImageReference
 
 			if len(last) == 0 || (len(next) > 0 && next != last) {
 				triggeredImage = next
@@ -118,6 +148,25 @@ func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) erro
 			}
 		}
 
+		// Every ImageStreamTag used as build source triggers a build as well, unless an
+		// ImageChange trigger already watches it. The image last used for a build is
+		// recorded on the image source by the generator.
+		for i := 0; !shouldBuild && i < len(config.Spec.Source.Images); i++ {
+			imageSource := &config.Spec.Source.Images[i]
+			if imageSource.From.Kind != "ImageStreamTag" || hasImageStreamTag(triggerRefs, &imageSource.From, config.Namespace) {
+				continue
+			}
+			next, ok := latestTaggedImage(repo, config, &imageSource.From)
+			if !ok {
+				continue
+			}
+			if len(next) > 0 && next != imageSource.LastTriggeredImageID {
+				from = &imageSource.From
+				triggeredImage = next
+				shouldBuild = true
+			}
+		}
+
 		if shouldBuild {
 			glog.V(4).Infof("Running build for BuildConfig %s/%s", config.Namespace, config.Name)
 			// instantiate new build
@@ -131,6 +180,13 @@ func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) erro
 					Name: triggeredImage,
 				},
 				From: from,
+				TriggeredBy: []buildapi.BuildTriggerCause{{
+					Message: buildapi.BuildTriggerCauseImageMsg,
+					ImageChangeBuild: &buildapi.ImageChangeCause{
+						ImageID: triggeredImage,
+						FromRef: from,
+					},
+				}},
 			}
 			if _, err := c.BuildConfigInstantiator.Instantiate(config.Namespace, request); err != nil {
 				if kerrors.IsConflict(err) {
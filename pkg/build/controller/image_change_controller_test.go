@@ -8,7 +8,9 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/client/testclient"
+	"k8s.io/kubernetes/pkg/util"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildtest "github.com/openshift/origin/pkg/build/controller/test"
@@ -37,6 +39,9 @@ func TestNewImageID(t *testing.T) {
 	if actual, expected := bcInstantiator.newBuild.Spec.Strategy.DockerStrategy.From.Name, "registry.com/namespace/imagename:newImageID123"; actual != expected {
 		t.Errorf("Image substitutions not properly setup for new build. Expected %s, got %s |", expected, actual)
 	}
+	if causes := bcInstantiator.newBuild.Status.TriggeredBy; len(causes) != 1 || causes[0].ImageChangeBuild == nil || causes[0].ImageChangeBuild.ImageID != "registry.com/namespace/imagename:newImageID123" {
+		t.Errorf("Expected the new build to be recorded as triggered by the new image, got %#v", causes)
+	}
 	if bcUpdater.buildcfg == nil {
 		t.Fatalf("Expected buildConfig update when new image was created!")
 	}
@@ -45,6 +50,89 @@ func TestNewImageID(t *testing.T) {
 	}
 }
 
+func TestNewImageIDForImageSource(t *testing.T) {
+	// an image source with a matching image change trigger, new build should be triggered.
+	buildcfg := mockBuildConfig("registry.com/namespace/imagename", "registry.com/namespace/imagename", "testImageStream", "testTag")
+	buildcfg.Spec.Strategy.DockerStrategy.From = &kapi.ObjectReference{Kind: "DockerImage", Name: "registry.com/namespace/imagename"}
+	artifacts := &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "artifacts:latest"}
+	buildcfg.Spec.Source.Images = []buildapi.ImageSource{
+		{From: *artifacts, Paths: []buildapi.ImageSourcePath{{SourcePath: "/opt/app/app.war"}}},
+	}
+	buildcfg.Spec.Triggers[0].ImageChange.From = artifacts
+	imageStream := mockImageStream("artifacts", "registry.com/namespace/artifacts", map[string]string{"latest": "newImageID123"})
+	image := mockImage("testImage@id", "registry.com/namespace/artifacts:newImageID123")
+	controller := mockImageChangeController(buildcfg, imageStream, image)
+	bcInstantiator := controller.BuildConfigInstantiator.(*buildConfigInstantiator)
+	bcUpdater := bcInstantiator.buildConfigUpdater
+
+	err := controller.HandleImageRepo(imageStream)
+	if err != nil {
+		t.Fatalf("Unexpected error %v from HandleImageRepo", err)
+	}
+
+	if len(bcInstantiator.name) == 0 {
+		t.Fatal("Expected build generation when new image was created!")
+	}
+	if actual, expected := bcInstantiator.newBuild.Spec.Source.Images[0].From.Name, "registry.com/namespace/artifacts:newImageID123"; actual != expected {
+		t.Errorf("Image source not properly resolved for new build. Expected %s, got %s", expected, actual)
+	}
+	if bcUpdater.buildcfg == nil {
+		t.Fatalf("Expected buildConfig update when new image was created!")
+	}
+	if actual, expected := bcUpdater.buildcfg.Spec.Triggers[0].ImageChange.LastTriggeredImageID, "registry.com/namespace/artifacts:newImageID123"; actual != expected {
+		t.Errorf("Expected last triggered image %q, got %q", expected, actual)
+	}
+}
+
+func TestNewImageIDForImageSourceWithoutTrigger(t *testing.T) {
+	// an image source without an image change trigger, new build should be triggered.
+	buildcfg := mockBuildConfig("registry.com/namespace/imagename", "registry.com/namespace/imagename", "testImageStream", "testTag")
+	buildcfg.Spec.Strategy.DockerStrategy.From = &kapi.ObjectReference{Kind: "DockerImage", Name: "registry.com/namespace/imagename"}
+	buildcfg.Spec.Source.Images = []buildapi.ImageSource{
+		{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "artifacts:latest"}, Paths: []buildapi.ImageSourcePath{{SourcePath: "/opt/app/app.war"}}},
+	}
+	buildcfg.Spec.Triggers = nil
+	imageStream := mockImageStream("artifacts", "registry.com/namespace/artifacts", map[string]string{"latest": "newImageID123"})
+	image := mockImage("testImage@id", "registry.com/namespace/artifacts:newImageID123")
+	controller := mockImageChangeController(buildcfg, imageStream, image)
+	bcInstantiator := controller.BuildConfigInstantiator.(*buildConfigInstantiator)
+	bcUpdater := bcInstantiator.buildConfigUpdater
+
+	err := controller.HandleImageRepo(imageStream)
+	if err != nil {
+		t.Fatalf("Unexpected error %v from HandleImageRepo", err)
+	}
+
+	if len(bcInstantiator.name) == 0 {
+		t.Fatal("Expected build generation when new image was created!")
+	}
+	source := bcInstantiator.newBuild.Spec.Source.Images[0]
+	if actual, expected := source.From.Name, "registry.com/namespace/artifacts:newImageID123"; actual != expected {
+		t.Errorf("Image source not properly resolved for new build. Expected %s, got %s", expected, actual)
+	}
+	if len(source.LastTriggeredImageID) != 0 {
+		t.Errorf("Expected no last triggered image on the build, got %q", source.LastTriggeredImageID)
+	}
+	if causes := bcInstantiator.newBuild.Status.TriggeredBy; len(causes) != 1 || causes[0].ImageChangeBuild == nil || causes[0].ImageChangeBuild.FromRef.Name != "artifacts:latest" {
+		t.Errorf("Expected the new build to be recorded as triggered by the image source, got %#v", causes)
+	}
+	if bcUpdater.buildcfg == nil {
+		t.Fatalf("Expected buildConfig update when new image was created!")
+	}
+	if actual, expected := bcUpdater.buildcfg.Spec.Source.Images[0].LastTriggeredImageID, "registry.com/namespace/artifacts:newImageID123"; actual != expected {
+		t.Errorf("Expected last triggered image %q, got %q", expected, actual)
+	}
+
+	// the same image must not trigger another build
+	bcInstantiator.name = ""
+	if err := controller.HandleImageRepo(imageStream); err != nil {
+		t.Fatalf("Unexpected error %v from HandleImageRepo", err)
+	}
+	if len(bcInstantiator.name) != 0 {
+		t.Error("New build generated when no change happened!")
+	}
+}
+
 func TestNewImageIDDefaultTag(t *testing.T) {
 	// valid configuration using default tag, new build should be triggered.
 	buildcfg := mockBuildConfig("registry.com/namespace/imagename", "registry.com/namespace/imagename", "testImageStream", "")
@@ -371,7 +459,9 @@ type buildConfigInstantiator struct {
 
 func (i *buildConfigInstantiator) Instantiate(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error) {
 	i.name = request.Name
-	return i.generator.Instantiate(kapi.WithNamespace(kapi.NewContext(), namespace), request)
+	// the controller runs as the master, which may record the cause of the build
+	master := &user.DefaultInfo{Name: "system:openshift-master", Groups: []string{bootstrappolicy.MastersGroup}}
+	return i.generator.Instantiate(kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), namespace), master), request)
 }
 
 func mockBuildConfigInstantiator(buildcfg *buildapi.BuildConfig, imageStream *imageapi.ImageStream, image *imageapi.Image) *buildConfigInstantiator {
@@ -382,8 +472,9 @@ func mockBuildConfigInstantiator(buildcfg *buildapi.BuildConfig, imageStream *im
 	instantiator := &buildConfigInstantiator{}
 	instantiator.buildConfigUpdater = &mockBuildConfigUpdater{}
 	generator := buildgenerator.BuildGenerator{
-		Secrets:         testclient.NewSimpleFake(),
-		ServiceAccounts: testclient.NewSimpleFake(&builderAccount),
+		Secrets:            testclient.NewSimpleFake(),
+		ServiceAccounts:    testclient.NewSimpleFake(&builderAccount),
+		TriggerCauseGroups: util.NewStringSet(bootstrappolicy.MastersGroup),
 		Client: buildgenerator.Client{
 			GetBuildConfigFunc: func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
 				return buildcfg, nil
@@ -0,0 +1,525 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client"
+	"k8s.io/kubernetes/pkg/util"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildedges "github.com/openshift/origin/pkg/build/graph"
+	buildgraph "github.com/openshift/origin/pkg/build/graph/nodes"
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployedges "github.com/openshift/origin/pkg/deploy/graph"
+	deploygraph "github.com/openshift/origin/pkg/deploy/graph/nodes"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	imagegraph "github.com/openshift/origin/pkg/image/graph/nodes"
+)
+
+// PipelineController runs the stages of Pipelines in order. Each call to HandlePipeline
+// starts the first stage which has not finished yet, or checks on what it started, and
+// records the outcome in the status of the pipeline.
+//
+// Build and Deploy stages save the name of the Build or deployment they are going to
+// start in the status of the pipeline before they start it, so that a retry of a
+// stale pipeline never starts a stage twice.
+type PipelineController struct {
+	Client     osclient.Interface
+	KubeClient kclient.Interface
+}
+
+// verifyServiceAccountName is the service account the pods of Verify stages run as.
+const verifyServiceAccountName = "default"
+
+// HandlePipeline advances a pipeline and updates its status if it changed.
+func (c *PipelineController) HandlePipeline(pipeline *buildapi.Pipeline) error {
+	return c.handlePipeline(pipeline, true)
+}
+
+// handlePipeline advances pipeline. If retry is true and the status update conflicts,
+// the pipeline is fetched again and handled once more; if a stage reserved the name of
+// what it starts, the stage is started once the reservation was saved.
+func (c *PipelineController) handlePipeline(pipeline *buildapi.Pipeline, retry bool) error {
+	if pipeline.Status.Phase == buildapi.PipelinePhaseComplete || pipeline.Status.Phase == buildapi.PipelinePhaseFailed {
+		return nil
+	}
+	glog.V(4).Infof("Handling Pipeline %s/%s", pipeline.Namespace, pipeline.Name)
+
+	obj, err := kapi.Scheme.Copy(pipeline)
+	if err != nil {
+		return err
+	}
+	updated := obj.(*buildapi.Pipeline)
+
+	now := util.Now()
+	if len(updated.Status.Stages) != len(updated.Spec.Stages) {
+		updated.Status.Stages = make([]buildapi.PipelineStageStatus, len(updated.Spec.Stages))
+		for i, stage := range updated.Spec.Stages {
+			updated.Status.Stages[i] = buildapi.PipelineStageStatus{Name: stage.Name, Phase: buildapi.PipelineStagePhasePending}
+		}
+	}
+	if updated.Status.Phase != buildapi.PipelinePhaseRunning {
+		updated.Status.Phase = buildapi.PipelinePhaseRunning
+		updated.Status.StartTimestamp = &now
+	}
+
+	var stageErr error
+	reserved := false
+	for i := range updated.Spec.Stages {
+		status := &updated.Status.Stages[i]
+		if isPipelineStageFinished(status.Phase) {
+			continue
+		}
+		if status.Phase == buildapi.PipelineStagePhasePending {
+			status.Phase = buildapi.PipelineStagePhaseRunning
+			status.StartTimestamp = &now
+		}
+		hadReference := status.Reference != nil
+		stageErr = c.handleStage(updated, i)
+		reserved = !hadReference && status.Reference != nil && !isPipelineStageFinished(status.Phase)
+		if isPipelineStageFinished(status.Phase) {
+			status.CompletionTimestamp = &now
+		}
+		break
+	}
+	updatePipelinePhase(updated, now)
+
+	if !kapi.Semantic.DeepEqual(pipeline.Status, updated.Status) {
+		persisted, err := c.Client.Pipelines(updated.Namespace).Update(updated)
+		if err != nil {
+			if kerrors.IsConflict(err) && retry {
+				latest, err := c.Client.Pipelines(updated.Namespace).Get(updated.Name)
+				if err != nil {
+					return fmt.Errorf("unable to get Pipeline %s/%s: %v", updated.Namespace, updated.Name, err)
+				}
+				return c.handlePipeline(latest, false)
+			}
+			return fmt.Errorf("unable to update status of Pipeline %s/%s: %v", updated.Namespace, updated.Name, err)
+		}
+		if reserved && stageErr == nil && retry {
+			return c.handlePipeline(persisted, false)
+		}
+	}
+	return stageErr
+}
+
+// isPipelineStageFinished returns true if the stage will not change anymore.
+func isPipelineStageFinished(phase buildapi.PipelineStagePhase) bool {
+	switch phase {
+	case buildapi.PipelineStagePhaseComplete, buildapi.PipelineStagePhaseFailed, buildapi.PipelineStagePhaseSkipped:
+		return true
+	}
+	return false
+}
+
+// updatePipelinePhase skips the stages after a failed stage and sets the phase of the
+// pipeline once all stages finished.
+func updatePipelinePhase(pipeline *buildapi.Pipeline, now util.Time) {
+	var failed *buildapi.PipelineStageStatus
+	for i := range pipeline.Status.Stages {
+		status := &pipeline.Status.Stages[i]
+		if failed != nil {
+			status.Phase = buildapi.PipelineStagePhaseSkipped
+			status.CompletionTimestamp = &now
+			continue
+		}
+		if status.Phase == buildapi.PipelineStagePhaseFailed {
+			failed = status
+			continue
+		}
+		if !isPipelineStageFinished(status.Phase) {
+			return
+		}
+	}
+	if failed != nil {
+		pipeline.Status.Phase = buildapi.PipelinePhaseFailed
+		pipeline.Status.Message = fmt.Sprintf("stage %q failed: %s", failed.Name, failed.Message)
+	} else {
+		pipeline.Status.Phase = buildapi.PipelinePhaseComplete
+	}
+	pipeline.Status.CompletionTimestamp = &now
+}
+
+// handleStage starts or checks on the stage at index, updating its status.
+func (c *PipelineController) handleStage(pipeline *buildapi.Pipeline, index int) error {
+	stage := &pipeline.Spec.Stages[index]
+	status := &pipeline.Status.Stages[index]
+	switch stage.Type {
+	case buildapi.PipelineStageBuild:
+		return c.handleBuildStage(pipeline, stage.Build, status)
+	case buildapi.PipelineStageDeploy:
+		return c.handleDeployStage(pipeline, index, status)
+	case buildapi.PipelineStageVerify:
+		return c.handleVerifyStage(pipeline, stage, status)
+	case buildapi.PipelineStagePromote:
+		return c.handlePromoteStage(pipeline, index, status)
+	}
+	failPipelineStage(status, "unknown stage type %q", stage.Type)
+	return nil
+}
+
+// failPipelineStage marks a stage as failed.
+func failPipelineStage(status *buildapi.PipelineStageStatus, format string, args ...interface{}) {
+	status.Phase = buildapi.PipelineStagePhaseFailed
+	status.Message = fmt.Sprintf(format, args...)
+}
+
+// handleBuildStage starts a Build from the BuildConfig of the stage and waits for it to finish.
+// The stage first reserves the name of the next Build of the BuildConfig, and only starts
+// that Build once the reservation is saved. The Build is requested for the LastVersion the
+// name was reserved for, so that it is started at most once.
+func (c *PipelineController) handleBuildStage(pipeline *buildapi.Pipeline, stage *buildapi.BuildStage, status *buildapi.PipelineStageStatus) error {
+	if status.Reference == nil {
+		config, err := c.Client.BuildConfigs(pipeline.Namespace).Get(stage.BuildConfig.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				failPipelineStage(status, "BuildConfig %s not found", stage.BuildConfig.Name)
+				return nil
+			}
+			return err
+		}
+		status.Reference = &kapi.ObjectReference{Kind: "Build", Namespace: pipeline.Namespace, Name: nextBuildName(config)}
+		return nil
+	}
+
+	build, err := c.Client.Builds(status.Reference.Namespace).Get(status.Reference.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return c.startBuild(pipeline, stage, status)
+		}
+		return err
+	}
+	switch build.Status.Phase {
+	case buildapi.BuildPhaseComplete:
+		status.Phase = buildapi.PipelineStagePhaseComplete
+	case buildapi.BuildPhaseFailed, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled:
+		failPipelineStage(status, "Build %s finished with phase %s", build.Name, build.Status.Phase)
+	}
+	return nil
+}
+
+// startBuild instantiates the Build reserved by status, unless the BuildConfig already
+// moved past it.
+func (c *PipelineController) startBuild(pipeline *buildapi.Pipeline, stage *buildapi.BuildStage, status *buildapi.PipelineStageStatus) error {
+	config, err := c.Client.BuildConfigs(pipeline.Namespace).Get(stage.BuildConfig.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			failPipelineStage(status, "BuildConfig %s not found", stage.BuildConfig.Name)
+			return nil
+		}
+		return err
+	}
+	if nextBuildName(config) != status.Reference.Name {
+		failPipelineStage(status, "Build %s was deleted", status.Reference.Name)
+		return nil
+	}
+	lastVersion := config.Status.LastVersion
+	request := &buildapi.BuildRequest{
+		ObjectMeta:  kapi.ObjectMeta{Name: config.Name, Namespace: pipeline.Namespace},
+		LastVersion: &lastVersion,
+		TriggeredBy: []buildapi.BuildTriggerCause{{Message: buildapi.BuildTriggerCausePipelineMsg}},
+	}
+	if _, err := c.Client.BuildConfigs(pipeline.Namespace).Instantiate(request); err != nil {
+		return fmt.Errorf("error instantiating Build from BuildConfig %s/%s: %v", pipeline.Namespace, config.Name, err)
+	}
+	return nil
+}
+
+// nextBuildName returns the name of the next Build of config.
+func nextBuildName(config *buildapi.BuildConfig) string {
+	return fmt.Sprintf("%s-%d", config.Name, config.Status.LastVersion+1)
+}
+
+// handleDeployStage waits for a new deployment of the DeploymentConfig of the stage.
+// If an earlier Build stage outputs an image which triggers the DeploymentConfig
+// automatically, the stage waits for the deployment of that image. Otherwise the
+// stage reserves the name of the next deployment, and starts it once the reservation
+// is saved.
+func (c *PipelineController) handleDeployStage(pipeline *buildapi.Pipeline, index int, status *buildapi.PipelineStageStatus) error {
+	stage := pipeline.Spec.Stages[index].Deploy
+	if status.Reference == nil {
+		config, err := c.Client.DeploymentConfigs(pipeline.Namespace).Get(stage.DeploymentConfig.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				failPipelineStage(status, "DeploymentConfig %s not found", stage.DeploymentConfig.Name)
+				return nil
+			}
+			return err
+		}
+
+		g := osgraph.New()
+		dcNode := deploygraph.EnsureDeploymentConfigNode(g, config)
+		deployedges.AddTriggerEdges(g, dcNode)
+		if err := c.addBuildOutputs(g, pipeline, index); err != nil {
+			return err
+		}
+		name := deployutil.DeploymentNameForConfigVersion(config.Name, config.LatestVersion+1)
+		if trigger := triggeringBuildOutput(g, dcNode); trigger != nil {
+			deployed, err := c.isTriggerCurrent(config, trigger)
+			if err != nil || !deployed {
+				status.Message = fmt.Sprintf("waiting for %s to be deployed", trigger.Name)
+				return err
+			}
+			name = deployutil.LatestDeploymentNameForConfig(config)
+		}
+		status.Message = ""
+		status.Reference = &kapi.ObjectReference{
+			Kind:      "ReplicationController",
+			Namespace: config.Namespace,
+			Name:      name,
+		}
+		return nil
+	}
+
+	deployment, err := c.KubeClient.ReplicationControllers(status.Reference.Namespace).Get(status.Reference.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return c.startDeployment(pipeline, stage, status)
+		}
+		return err
+	}
+	switch deployutil.DeploymentStatusFor(deployment) {
+	case deployapi.DeploymentStatusComplete:
+		status.Phase = buildapi.PipelineStagePhaseComplete
+	case deployapi.DeploymentStatusFailed:
+		failPipelineStage(status, "deployment %s failed", deployment.Name)
+	}
+	return nil
+}
+
+// startDeployment starts the deployment reserved by status if the DeploymentConfig has not
+// started it yet. Otherwise the deployment controller has not created the deployment yet.
+func (c *PipelineController) startDeployment(pipeline *buildapi.Pipeline, stage *buildapi.DeployStage, status *buildapi.PipelineStageStatus) error {
+	config, err := c.Client.DeploymentConfigs(pipeline.Namespace).Get(stage.DeploymentConfig.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			failPipelineStage(status, "DeploymentConfig %s not found", stage.DeploymentConfig.Name)
+			return nil
+		}
+		return err
+	}
+	if deployutil.DeploymentNameForConfigVersion(config.Name, config.LatestVersion+1) != status.Reference.Name {
+		return nil
+	}
+	// The update conflicts if the config changed since it was read, so the version is
+	// bumped at most once.
+	config.LatestVersion++
+	if _, err := c.Client.DeploymentConfigs(config.Namespace).Update(config); err != nil {
+		return fmt.Errorf("unable to start a deployment of DeploymentConfig %s/%s: %v", pipeline.Namespace, stage.DeploymentConfig.Name, err)
+	}
+	return nil
+}
+
+// addBuildOutputs adds the BuildConfigs of the Build stages before index to the graph,
+// with edges to the images they output.
+func (c *PipelineController) addBuildOutputs(g osgraph.Graph, pipeline *buildapi.Pipeline, index int) error {
+	for i := 0; i < index; i++ {
+		stage := pipeline.Spec.Stages[i]
+		if stage.Type != buildapi.PipelineStageBuild {
+			continue
+		}
+		config, err := c.Client.BuildConfigs(pipeline.Namespace).Get(stage.Build.BuildConfig.Name)
+		if err != nil {
+			return err
+		}
+		if config.Spec.Output.To == nil {
+			continue
+		}
+		buildedges.AddOutputEdges(g, buildgraph.EnsureBuildConfigNode(g, config))
+	}
+	return nil
+}
+
+// triggeringBuildOutput returns the ImageStreamTag output by a BuildConfig of the graph
+// which triggers the DeploymentConfig of dcNode, if any.
+func triggeringBuildOutput(g osgraph.Graph, dcNode *deploygraph.DeploymentConfigNode) *imageapi.ImageStreamTag {
+	for _, node := range g.PredecessorNodesByEdgeKind(dcNode, deployedges.TriggersDeploymentEdgeKind) {
+		istNode, ok := node.(*imagegraph.ImageStreamTagNode)
+		if !ok {
+			continue
+		}
+		if len(g.PredecessorNodesByEdgeKind(istNode, buildedges.BuildOutputEdgeKind)) > 0 {
+			return istNode.ImageStreamTag
+		}
+	}
+	return nil
+}
+
+// isTriggerCurrent returns true if the automatic image change trigger of config for ist
+// already deployed the current image of the tag.
+func (c *PipelineController) isTriggerCurrent(config *deployapi.DeploymentConfig, ist *imageapi.ImageStreamTag) (bool, error) {
+	name, tag, _ := imageapi.SplitImageStreamTag(ist.Name)
+	stream, err := c.Client.ImageStreams(ist.Namespace).Get(name)
+	if err != nil {
+		return false, err
+	}
+	latest := imageapi.LatestTaggedImage(stream, tag)
+	if latest == nil {
+		return false, nil
+	}
+	for _, trigger := range config.Triggers {
+		params := trigger.ImageChangeParams
+		if params == nil || params.From.Name != name {
+			continue
+		}
+		if len(params.From.Namespace) > 0 && params.From.Namespace != ist.Namespace {
+			continue
+		}
+		if params.Tag != tag && !(len(params.Tag) == 0 && tag == imageapi.DefaultImageTag) {
+			continue
+		}
+		if !params.Automatic {
+			return false, fmt.Errorf("the image change trigger of DeploymentConfig %s/%s for %s is not automatic", config.Namespace, config.Name, ist.Name)
+		}
+		return params.LastTriggeredImage == latest.DockerImageReference, nil
+	}
+	return false, nil
+}
+
+// handleVerifyStage runs the pod of the stage and waits for it to succeed. The pod
+// runs as the default service account of the namespace, so it is admitted with the
+// security context constraints of the project rather than those of the controller.
+func (c *PipelineController) handleVerifyStage(pipeline *buildapi.Pipeline, stage *buildapi.PipelineStage, status *buildapi.PipelineStageStatus) error {
+	if status.Reference == nil {
+		pod := &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:   fmt.Sprintf("%s-%s", pipeline.Name, stage.Name),
+				Labels: map[string]string{buildapi.PipelineLabel: pipeline.Name},
+			},
+			Spec: kapi.PodSpec{
+				Containers: []kapi.Container{
+					{
+						Name:    stage.Name,
+						Image:   stage.Verify.Image,
+						Command: stage.Verify.Command,
+						Env:     stage.Verify.Env,
+					},
+				},
+				RestartPolicy:      kapi.RestartPolicyNever,
+				ServiceAccountName: verifyServiceAccountName,
+			},
+		}
+		if _, err := c.KubeClient.Pods(pipeline.Namespace).Create(pod); err != nil && !kerrors.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to create verification pod for Pipeline %s/%s: %v", pipeline.Namespace, pipeline.Name, err)
+		}
+		status.Reference = &kapi.ObjectReference{Kind: "Pod", Namespace: pipeline.Namespace, Name: pod.Name}
+		return nil
+	}
+
+	pod, err := c.KubeClient.Pods(status.Reference.Namespace).Get(status.Reference.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			failPipelineStage(status, "verification pod %s was deleted", status.Reference.Name)
+			return nil
+		}
+		return err
+	}
+	switch pod.Status.Phase {
+	case kapi.PodSucceeded:
+		status.Phase = buildapi.PipelineStagePhaseComplete
+	case kapi.PodFailed:
+		failPipelineStage(status, "verification pod %s failed", pod.Name)
+	}
+	return nil
+}
+
+// handlePromoteStage tags the current image of the source ImageStreamTag of the stage
+// into its target ImageStreamTag.
+func (c *PipelineController) handlePromoteStage(pipeline *buildapi.Pipeline, index int, status *buildapi.PipelineStageStatus) error {
+	stage := pipeline.Spec.Stages[index].Promote
+	from := stage.From
+	if from == nil {
+		g := osgraph.New()
+		if err := c.addBuildOutputs(g, pipeline, index); err != nil {
+			return err
+		}
+		if from = lastBuildOutput(g, pipeline, index); from == nil {
+			failPipelineStage(status, "no earlier Build stage outputs to an ImageStreamTag")
+			return nil
+		}
+	}
+	// The controller is allowed to tag images in every namespace, so a pipeline must
+	// not be able to promote from or into namespaces other than its own.
+	fromNamespace := from.Namespace
+	if len(fromNamespace) == 0 {
+		fromNamespace = pipeline.Namespace
+	}
+	if fromNamespace != pipeline.Namespace {
+		failPipelineStage(status, "cannot promote ImageStreamTag %s/%s from outside of the namespace of the pipeline", fromNamespace, from.Name)
+		return nil
+	}
+	fromName, fromTag, _ := imageapi.SplitImageStreamTag(from.Name)
+	ist, err := c.Client.ImageStreamTags(fromNamespace).Get(fromName, fromTag)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			failPipelineStage(status, "ImageStreamTag %s/%s not found", fromNamespace, from.Name)
+			return nil
+		}
+		return err
+	}
+
+	toNamespace := stage.To.Namespace
+	if len(toNamespace) == 0 {
+		toNamespace = pipeline.Namespace
+	}
+	if toNamespace != pipeline.Namespace {
+		failPipelineStage(status, "cannot promote into ImageStreamTag %s/%s outside of the namespace of the pipeline", toNamespace, stage.To.Name)
+		return nil
+	}
+	toName, toTag, _ := imageapi.SplitImageStreamTag(stage.To.Name)
+	stream, err := c.Client.ImageStreams(toNamespace).Get(toName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			failPipelineStage(status, "ImageStream %s/%s not found", toNamespace, toName)
+			return nil
+		}
+		return err
+	}
+	if stream.Spec.Tags == nil {
+		stream.Spec.Tags = make(map[string]imageapi.TagReference)
+	}
+	stream.Spec.Tags[toTag] = imageapi.TagReference{
+		From: &kapi.ObjectReference{
+			Kind:      "ImageStreamImage",
+			Namespace: fromNamespace,
+			Name:      fmt.Sprintf("%s@%s", fromName, ist.Image.Name),
+		},
+	}
+	if _, err := c.Client.ImageStreams(toNamespace).Update(stream); err != nil {
+		return fmt.Errorf("unable to tag %s/%s into %s/%s: %v", fromNamespace, from.Name, toNamespace, stage.To.Name, err)
+	}
+	status.Reference = &kapi.ObjectReference{Kind: "ImageStream", Namespace: toNamespace, Name: toName}
+	status.Message = fmt.Sprintf("tagged %s/%s@%s into %s", fromNamespace, fromName, ist.Image.Name, stage.To.Name)
+	status.Phase = buildapi.PipelineStagePhaseComplete
+	return nil
+}
+
+// lastBuildOutput returns the ImageStreamTag output by the BuildConfig of the closest
+// Build stage before index, or nil if it does not output to an ImageStreamTag.
+func lastBuildOutput(g osgraph.Graph, pipeline *buildapi.Pipeline, index int) *kapi.ObjectReference {
+	for i := index - 1; i >= 0; i-- {
+		stage := pipeline.Spec.Stages[i]
+		if stage.Type != buildapi.PipelineStageBuild {
+			continue
+		}
+		for _, node := range g.NodesByKind(buildgraph.BuildConfigNodeKind) {
+			bcNode := node.(*buildgraph.BuildConfigNode)
+			if bcNode.BuildConfig.Name != stage.Build.BuildConfig.Name {
+				continue
+			}
+			for _, out := range g.SuccessorNodesByEdgeKind(bcNode, buildedges.BuildOutputEdgeKind) {
+				if istNode, ok := out.(*imagegraph.ImageStreamTagNode); ok {
+					return &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: istNode.Namespace, Name: istNode.ImageStreamTag.Name}
+				}
+			}
+		}
+		return nil
+	}
+	return nil
+}
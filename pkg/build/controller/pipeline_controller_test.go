@@ -0,0 +1,498 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// echoReaction wraps react to echo the objects a fake client is asked to create or update.
+func echoReaction(react ktestclient.ReactionFunc) ktestclient.ReactionFunc {
+	return func(action ktestclient.Action) (runtime.Object, error) {
+		switch a := action.(type) {
+		case ktestclient.CreateAction:
+			if a.GetSubresource() == "instantiate" {
+				return &buildapi.Build{ObjectMeta: kapi.ObjectMeta{Name: "frontend-1", Namespace: "test"}}, nil
+			}
+			return a.GetObject(), nil
+		case ktestclient.UpdateAction:
+			return a.GetObject(), nil
+		}
+		return react(action)
+	}
+}
+
+// newPipelineTestClient returns a fake client which serves objects and echoes the
+// objects it is asked to create or update.
+func newPipelineTestClient(objects ...runtime.Object) *testclient.Fake {
+	client := testclient.NewSimpleFake(objects...)
+	client.ReactFn = echoReaction(client.ReactFn)
+	return client
+}
+
+func pipelineWithStages(stages ...buildapi.PipelineStage) *buildapi.Pipeline {
+	return &buildapi.Pipeline{
+		ObjectMeta: kapi.ObjectMeta{Name: "release", Namespace: "test"},
+		Spec:       buildapi.PipelineSpec{Stages: stages},
+		Status:     buildapi.PipelineStatus{Phase: buildapi.PipelinePhaseNew},
+	}
+}
+
+func buildStage() buildapi.PipelineStage {
+	return buildapi.PipelineStage{
+		Name:  "build",
+		Type:  buildapi.PipelineStageBuild,
+		Build: &buildapi.BuildStage{BuildConfig: kapi.LocalObjectReference{Name: "frontend"}},
+	}
+}
+
+func deployStage() buildapi.PipelineStage {
+	return buildapi.PipelineStage{
+		Name:   "deploy",
+		Type:   buildapi.PipelineStageDeploy,
+		Deploy: &buildapi.DeployStage{DeploymentConfig: kapi.LocalObjectReference{Name: "frontend"}},
+	}
+}
+
+func verifyStage() buildapi.PipelineStage {
+	return buildapi.PipelineStage{
+		Name:   "verify",
+		Type:   buildapi.PipelineStageVerify,
+		Verify: &buildapi.VerifyStage{Image: "tester", Command: []string{"/test"}},
+	}
+}
+
+func promoteStage() buildapi.PipelineStage {
+	return buildapi.PipelineStage{
+		Name:    "promote",
+		Type:    buildapi.PipelineStagePromote,
+		Promote: &buildapi.PromoteStage{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "frontend:prod"}},
+	}
+}
+
+// stageStatuses returns the status of a pipeline whose stages have the given phases.
+func stageStatuses(pipeline *buildapi.Pipeline, phases ...buildapi.PipelineStagePhase) *buildapi.Pipeline {
+	pipeline.Status.Phase = buildapi.PipelinePhaseRunning
+	for i, phase := range phases {
+		pipeline.Status.Stages = append(pipeline.Status.Stages, buildapi.PipelineStageStatus{Name: pipeline.Spec.Stages[i].Name, Phase: phase})
+	}
+	return pipeline
+}
+
+func pipelineBuildConfig() *buildapi.BuildConfig {
+	return &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: "test"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Output: buildapi.BuildOutput{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "frontend:latest"}},
+			},
+		},
+	}
+}
+
+func pipelineDeploymentConfig(automatic bool, lastTriggeredImage string) *deployapi.DeploymentConfig {
+	return &deployapi.DeploymentConfig{
+		ObjectMeta:    kapi.ObjectMeta{Name: "frontend", Namespace: "test"},
+		LatestVersion: 1,
+		Triggers: []deployapi.DeploymentTriggerPolicy{
+			{
+				Type: deployapi.DeploymentTriggerOnImageChange,
+				ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{
+					Automatic:          automatic,
+					ContainerNames:     []string{"frontend"},
+					From:               kapi.ObjectReference{Kind: "ImageStream", Name: "frontend"},
+					Tag:                "latest",
+					LastTriggeredImage: lastTriggeredImage,
+				},
+			},
+		},
+		Template: deployapi.DeploymentTemplate{
+			ControllerTemplate: kapi.ReplicationControllerSpec{
+				Template: &kapi.PodTemplateSpec{
+					Spec: kapi.PodSpec{
+						Containers: []kapi.Container{{Name: "frontend", Image: "registry/test/frontend:latest"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pipelineImageStream() *imageapi.ImageStream {
+	return &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: "test"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{{DockerImageReference: "registry/test/frontend@sha256:new", Image: "sha256:new"}}},
+			},
+		},
+	}
+}
+
+// updatedPipeline returns the pipeline the controller last updated.
+func updatedPipeline(t *testing.T, client *testclient.Fake) *buildapi.Pipeline {
+	var pipeline *buildapi.Pipeline
+	for _, action := range client.Actions() {
+		if action.Matches("update", "pipelines") {
+			pipeline = action.(ktestclient.UpdateAction).GetObject().(*buildapi.Pipeline)
+		}
+	}
+	if pipeline == nil {
+		t.Fatalf("expected the pipeline to be updated, got actions %#v", client.Actions())
+	}
+	return pipeline
+}
+
+func TestHandlePipelineStartsBuild(t *testing.T) {
+	client := newPipelineTestClient(pipelineBuildConfig())
+	controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+	pipeline := pipelineWithStages(buildStage(), verifyStage())
+
+	if err := controller.HandlePipeline(pipeline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pipeline.Status.Phase != buildapi.PipelinePhaseNew {
+		t.Errorf("expected the pipeline passed in not to be modified")
+	}
+	updated := updatedPipeline(t, client)
+	if updated.Status.Phase != buildapi.PipelinePhaseRunning || updated.Status.StartTimestamp == nil {
+		t.Errorf("expected a running pipeline, got %#v", updated.Status)
+	}
+	if len(updated.Status.Stages) != 2 {
+		t.Fatalf("expected two stage statuses, got %#v", updated.Status.Stages)
+	}
+	build := updated.Status.Stages[0]
+	if build.Phase != buildapi.PipelineStagePhaseRunning || build.Reference == nil || build.Reference.Name != "frontend-1" {
+		t.Errorf("expected the build stage to run frontend-1, got %#v", build)
+	}
+	if verify := updated.Status.Stages[1]; verify.Phase != buildapi.PipelineStagePhasePending {
+		t.Errorf("expected the verify stage to be pending, got %#v", verify)
+	}
+	reserved, instantiated := false, 0
+	for _, action := range client.Actions() {
+		if action.Matches("update", "pipelines") {
+			reserved = true
+		}
+		if action.Matches("create", "buildconfigs") {
+			if !reserved {
+				t.Errorf("expected the build to be reserved in the pipeline before it is started")
+			}
+			instantiated++
+			request := action.(ktestclient.CreateAction).GetObject().(*buildapi.BuildRequest)
+			if len(request.TriggeredBy) != 1 || request.TriggeredBy[0].Message != buildapi.BuildTriggerCausePipelineMsg {
+				t.Errorf("expected the build to be triggered by the pipeline, got %#v", request.TriggeredBy)
+			}
+			if request.LastVersion == nil || *request.LastVersion != 0 {
+				t.Errorf("expected the build to be requested for LastVersion 0, got %v", request.LastVersion)
+			}
+		}
+	}
+	if instantiated != 1 {
+		t.Errorf("expected one build to be started, got %d", instantiated)
+	}
+}
+
+// TestHandlePipelineStaleRetry ensures that handling a stale pipeline again, as the
+// retry of a failed status update does, doesn't start a stage twice.
+func TestHandlePipelineStaleRetry(t *testing.T) {
+	current := stageStatuses(pipelineWithStages(buildStage(), verifyStage()), buildapi.PipelineStagePhaseRunning, buildapi.PipelineStagePhasePending)
+	current.ResourceVersion = "2"
+	current.Status.Stages[0].Reference = &kapi.ObjectReference{Kind: "Build", Namespace: "test", Name: "frontend-1"}
+	build := &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend-1", Namespace: "test"},
+		Status:     buildapi.BuildStatus{Phase: buildapi.BuildPhaseRunning},
+	}
+	config := pipelineBuildConfig()
+	config.Status.LastVersion = 1
+
+	client := newPipelineTestClient(config, build)
+	react := client.ReactFn
+	client.ReactFn = func(action ktestclient.Action) (runtime.Object, error) {
+		switch {
+		case action.Matches("update", "pipelines"):
+			if pipeline := action.(ktestclient.UpdateAction).GetObject().(*buildapi.Pipeline); pipeline.ResourceVersion != current.ResourceVersion {
+				return nil, kerrors.NewConflict("Pipeline", pipeline.Name, fmt.Errorf("stale"))
+			}
+		case action.Matches("get", "pipelines"):
+			return current, nil
+		}
+		return react(action)
+	}
+	controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+
+	stale := pipelineWithStages(buildStage(), verifyStage())
+	stale.ResourceVersion = "1"
+	if err := controller.HandlePipeline(stale); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range client.Actions() {
+		if action.Matches("create", "buildconfigs") {
+			t.Errorf("unexpected second start of the build stage")
+		}
+	}
+}
+
+// TestHandlePipelineBuildDeleted ensures that a reserved Build which the BuildConfig
+// already moved past isn't started again.
+func TestHandlePipelineBuildDeleted(t *testing.T) {
+	config := pipelineBuildConfig()
+	config.Status.LastVersion = 1
+	client := newPipelineTestClient(config)
+	controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+	pipeline := stageStatuses(pipelineWithStages(buildStage()), buildapi.PipelineStagePhaseRunning)
+	pipeline.Status.Stages[0].Reference = &kapi.ObjectReference{Kind: "Build", Namespace: "test", Name: "frontend-1"}
+
+	if err := controller.HandlePipeline(pipeline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range client.Actions() {
+		if action.Matches("create", "buildconfigs") {
+			t.Errorf("unexpected start of a deleted build")
+		}
+	}
+	if updated := updatedPipeline(t, client); updated.Status.Phase != buildapi.PipelinePhaseFailed {
+		t.Errorf("expected the pipeline to fail, got %#v", updated.Status)
+	}
+}
+
+func TestHandlePipelineBuildFinished(t *testing.T) {
+	tests := []struct {
+		name          string
+		phase         buildapi.BuildPhase
+		expectPhase   buildapi.PipelinePhase
+		expectStages  []buildapi.PipelineStagePhase
+		expectMessage string
+	}{
+		{
+			name:         "build running",
+			phase:        buildapi.BuildPhaseRunning,
+			expectPhase:  buildapi.PipelinePhaseRunning,
+			expectStages: []buildapi.PipelineStagePhase{buildapi.PipelineStagePhaseRunning, buildapi.PipelineStagePhasePending},
+		},
+		{
+			name:         "build complete",
+			phase:        buildapi.BuildPhaseComplete,
+			expectPhase:  buildapi.PipelinePhaseRunning,
+			expectStages: []buildapi.PipelineStagePhase{buildapi.PipelineStagePhaseComplete, buildapi.PipelineStagePhasePending},
+		},
+		{
+			name:          "build failed",
+			phase:         buildapi.BuildPhaseFailed,
+			expectPhase:   buildapi.PipelinePhaseFailed,
+			expectStages:  []buildapi.PipelineStagePhase{buildapi.PipelineStagePhaseFailed, buildapi.PipelineStagePhaseSkipped},
+			expectMessage: `stage "build" failed: Build frontend-1 finished with phase Failed`,
+		},
+	}
+
+	for _, test := range tests {
+		build := &buildapi.Build{
+			ObjectMeta: kapi.ObjectMeta{Name: "frontend-1", Namespace: "test"},
+			Status:     buildapi.BuildStatus{Phase: test.phase},
+		}
+		client := newPipelineTestClient(build)
+		controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+		pipeline := stageStatuses(pipelineWithStages(buildStage(), verifyStage()), buildapi.PipelineStagePhaseRunning, buildapi.PipelineStagePhasePending)
+		pipeline.Status.Stages[0].Reference = &kapi.ObjectReference{Kind: "Build", Namespace: "test", Name: "frontend-1"}
+
+		if err := controller.HandlePipeline(pipeline); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if test.phase == buildapi.BuildPhaseRunning {
+			for _, action := range client.Actions() {
+				if action.Matches("update", "pipelines") {
+					t.Errorf("%s: unexpected update of an unchanged pipeline", test.name)
+				}
+			}
+			continue
+		}
+		updated := updatedPipeline(t, client)
+		if updated.Status.Phase != test.expectPhase {
+			t.Errorf("%s: expected phase %s, got %s", test.name, test.expectPhase, updated.Status.Phase)
+		}
+		if updated.Status.Message != test.expectMessage {
+			t.Errorf("%s: expected message %q, got %q", test.name, test.expectMessage, updated.Status.Message)
+		}
+		for i, phase := range test.expectStages {
+			if updated.Status.Stages[i].Phase != phase {
+				t.Errorf("%s: expected stage %d to be %s, got %s", test.name, i, phase, updated.Status.Stages[i].Phase)
+			}
+		}
+	}
+}
+
+func TestHandlePipelineDeploy(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          *deployapi.DeploymentConfig
+		expectReference string
+		expectUpdate    bool
+	}{
+		{
+			name:            "triggered by the build output and deployed",
+			config:          pipelineDeploymentConfig(true, "registry/test/frontend@sha256:new"),
+			expectReference: "frontend-1",
+		},
+		{
+			name:   "triggered by the build output and not deployed yet",
+			config: pipelineDeploymentConfig(true, "registry/test/frontend@sha256:old"),
+		},
+		{
+			name:            "not triggered automatically",
+			config:          pipelineDeploymentConfig(false, ""),
+			expectReference: "frontend-2",
+			expectUpdate:    true,
+		},
+	}
+
+	for _, test := range tests {
+		if !test.config.Triggers[0].ImageChangeParams.Automatic {
+			// without an automatic trigger the config does not react to the build output
+			test.config.Triggers = nil
+		}
+		client := newPipelineTestClient(pipelineBuildConfig(), test.config, pipelineImageStream())
+		controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+		pipeline := stageStatuses(pipelineWithStages(buildStage(), deployStage()), buildapi.PipelineStagePhaseComplete, buildapi.PipelineStagePhasePending)
+
+		if err := controller.HandlePipeline(pipeline); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		updatedConfig := false
+		for _, action := range client.Actions() {
+			if action.Matches("update", "deploymentconfigs") {
+				updatedConfig = true
+			}
+		}
+		if updatedConfig != test.expectUpdate {
+			t.Errorf("%s: expected a new deployment to be started: %v, got %v", test.name, test.expectUpdate, updatedConfig)
+		}
+		deploy := updatedPipeline(t, client).Status.Stages[1]
+		if deploy.Phase != buildapi.PipelineStagePhaseRunning {
+			t.Errorf("%s: expected the deploy stage to be running, got %s", test.name, deploy.Phase)
+		}
+		if len(test.expectReference) == 0 {
+			if deploy.Reference != nil || len(deploy.Message) == 0 {
+				t.Errorf("%s: expected the deploy stage to wait for the trigger, got %#v", test.name, deploy)
+			}
+			continue
+		}
+		if deploy.Reference == nil || deploy.Reference.Name != test.expectReference {
+			t.Errorf("%s: expected the deploy stage to wait for %s, got %#v", test.name, test.expectReference, deploy.Reference)
+		}
+	}
+}
+
+func TestHandlePipelineDeployFinished(t *testing.T) {
+	deployment := &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "frontend-2",
+			Namespace:   "test",
+			Annotations: map[string]string{deployapi.DeploymentStatusAnnotation: string(deployapi.DeploymentStatusComplete)},
+		},
+	}
+	client := newPipelineTestClient()
+	controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake(deployment)}
+	pipeline := stageStatuses(pipelineWithStages(deployStage()), buildapi.PipelineStagePhaseRunning)
+	pipeline.Status.Stages[0].Reference = &kapi.ObjectReference{Kind: "ReplicationController", Namespace: "test", Name: "frontend-2"}
+
+	if err := controller.HandlePipeline(pipeline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated := updatedPipeline(t, client)
+	if updated.Status.Phase != buildapi.PipelinePhaseComplete || updated.Status.CompletionTimestamp == nil {
+		t.Errorf("expected the pipeline to be complete, got %#v", updated.Status)
+	}
+}
+
+func TestHandlePipelineVerify(t *testing.T) {
+	client := newPipelineTestClient()
+	kclient := ktestclient.NewSimpleFake()
+	kclient.ReactFn = echoReaction(kclient.ReactFn)
+	controller := &PipelineController{Client: client, KubeClient: kclient}
+	pipeline := stageStatuses(pipelineWithStages(verifyStage()), buildapi.PipelineStagePhasePending)
+
+	if err := controller.HandlePipeline(pipeline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var pod *kapi.Pod
+	for _, action := range kclient.Actions() {
+		if action.Matches("create", "pods") {
+			pod = action.(ktestclient.CreateAction).GetObject().(*kapi.Pod)
+		}
+	}
+	if pod == nil {
+		t.Fatalf("expected a verification pod to be created")
+	}
+	if pod.Name != "release-verify" || pod.Labels[buildapi.PipelineLabel] != "release" || pod.Spec.RestartPolicy != kapi.RestartPolicyNever {
+		t.Errorf("unexpected verification pod %#v", pod)
+	}
+	if e, a := verifyServiceAccountName, pod.Spec.ServiceAccountName; e != a {
+		t.Errorf("expected the verification pod to run as %s, got %s", e, a)
+	}
+	verify := updatedPipeline(t, client).Status.Stages[0]
+	if verify.Reference == nil || verify.Reference.Name != pod.Name {
+		t.Errorf("expected the verify stage to wait for %s, got %#v", pod.Name, verify.Reference)
+	}
+}
+
+func TestHandlePipelinePromote(t *testing.T) {
+	ist := &imageapi.ImageStreamTag{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend:latest", Namespace: "test"},
+		Image:      imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:new"}},
+	}
+	client := newPipelineTestClient(pipelineBuildConfig(), pipelineImageStream(), ist)
+	controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+	pipeline := stageStatuses(pipelineWithStages(buildStage(), promoteStage()), buildapi.PipelineStagePhaseComplete, buildapi.PipelineStagePhasePending)
+
+	if err := controller.HandlePipeline(pipeline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var stream *imageapi.ImageStream
+	for _, action := range client.Actions() {
+		if action.Matches("update", "imagestreams") {
+			stream = action.(ktestclient.UpdateAction).GetObject().(*imageapi.ImageStream)
+		}
+	}
+	if stream == nil {
+		t.Fatalf("expected the image stream to be updated")
+	}
+	from := stream.Spec.Tags["prod"].From
+	if from == nil || from.Kind != "ImageStreamImage" || from.Name != "frontend@sha256:new" {
+		t.Errorf("expected prod to point to frontend@sha256:new, got %#v", from)
+	}
+	updated := updatedPipeline(t, client)
+	if updated.Status.Phase != buildapi.PipelinePhaseComplete {
+		t.Errorf("expected the pipeline to be complete, got %#v", updated.Status)
+	}
+}
+
+func TestHandlePipelinePromoteOtherNamespace(t *testing.T) {
+	config := pipelineBuildConfig()
+	config.Spec.Output.To.Namespace = "other"
+	client := newPipelineTestClient(config)
+	controller := &PipelineController{Client: client, KubeClient: ktestclient.NewSimpleFake()}
+	pipeline := stageStatuses(pipelineWithStages(buildStage(), promoteStage()), buildapi.PipelineStagePhaseComplete, buildapi.PipelineStagePhasePending)
+
+	if err := controller.HandlePipeline(pipeline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range client.Actions() {
+		if action.GetNamespace() == "other" {
+			t.Errorf("unexpected action in another namespace: %#v", action)
+		}
+	}
+	promote := updatedPipeline(t, client).Status.Stages[1]
+	if promote.Phase != buildapi.PipelineStagePhaseFailed {
+		t.Errorf("expected the promote stage to fail, got %#v", promote)
+	}
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// getRunPolicy returns the run policy recorded on the build by the generator.
+// Builds without a recorded policy run in parallel.
+func getRunPolicy(build *buildapi.Build) buildapi.BuildRunPolicy {
+	if policy, ok := build.Annotations[buildapi.BuildRunPolicyAnnotation]; ok && len(policy) > 0 {
+		return buildapi.BuildRunPolicy(policy)
+	}
+	return buildapi.BuildRunPolicyParallel
+}
+
+// isSerialBuild returns true if the build must not run alongside other builds
+// of its BuildConfig.
+func isSerialBuild(build *buildapi.Build) bool {
+	if build.Status.Config == nil {
+		return false
+	}
+	policy := getRunPolicy(build)
+	return policy == buildapi.BuildRunPolicySerial || policy == buildapi.BuildRunPolicySerialLatestOnly
+}
+
+// isQueuedBuild returns true if the build has not been started and was not
+// cancelled.
+func isQueuedBuild(build *buildapi.Build) bool {
+	return build.Status.Phase == buildapi.BuildPhaseNew && !build.Status.Cancelled
+}
+
+// isOlderBuild returns true if a was created before b. Builds created within
+// the same second are ordered by their build number.
+func isOlderBuild(a, b *buildapi.Build) bool {
+	if !a.CreationTimestamp.Equal(b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(b.CreationTimestamp)
+	}
+	numberA, errA := strconv.Atoi(a.Annotations[buildapi.BuildNumberAnnotation])
+	numberB, errB := strconv.Atoi(b.Annotations[buildapi.BuildNumberAnnotation])
+	return errA == nil && errB == nil && numberA < numberB
+}
+
+// configBuilds returns the other builds of the BuildConfig of build. They are
+// listed from the server, since a store may not have observed yet that a build
+// handled just before was started or has completed.
+func (bc *BuildController) configBuilds(build *buildapi.Build) ([]*buildapi.Build, error) {
+	builds, err := listConfigBuilds(bc.BuildLister, build)
+	if err != nil {
+		return nil, err
+	}
+	others := []*buildapi.Build{}
+	for _, b := range builds {
+		if b.Name != build.Name {
+			others = append(others, b)
+		}
+	}
+	return others, nil
+}
+
+// mustWait returns true if the serial build has to stay in the New phase
+// because another build of its BuildConfig is running or, for the Serial
+// policy, an older build is still queued. With the SerialLatestOnly policy the
+// older queued builds are cancelled instead.
+func (bc *BuildController) mustWait(build *buildapi.Build) (bool, error) {
+	builds, err := bc.configBuilds(build)
+	if err != nil {
+		return false, err
+	}
+	latestOnly := getRunPolicy(build) == buildapi.BuildRunPolicySerialLatestOnly
+	wait := false
+	for _, b := range builds {
+		switch {
+		case b.Status.Phase == buildapi.BuildPhasePending || b.Status.Phase == buildapi.BuildPhaseRunning:
+			wait = true
+		case !isQueuedBuild(b):
+			// completed and cancelled builds do not hold up the build
+		case isOlderBuild(b, build) && latestOnly:
+			if err := bc.cancelQueuedBuild(b, build); err != nil {
+				return false, err
+			}
+		case isOlderBuild(b, build) || latestOnly:
+			// a newer build of a SerialLatestOnly config will cancel this one
+			wait = true
+		}
+	}
+	return wait, nil
+}
+
+// cancelQueuedBuild marks a build that never started as cancelled because a
+// newer build superseded it.
+func (bc *BuildController) cancelQueuedBuild(build, newer *buildapi.Build) error {
+	glog.V(4).Infof("Cancelling queued Build %s/%s in favour of Build %s", build.Namespace, build.Name, newer.Name)
+	obj, err := kapi.Scheme.Copy(build)
+	if err != nil {
+		return fmt.Errorf("unable to copy Build: %v", err)
+	}
+	buildCopy := obj.(*buildapi.Build)
+	buildCopy.Status.Cancelled = true
+	buildCopy.Status.Message = fmt.Sprintf("Cancelled in favour of the newer Build %s.", newer.Name)
+	if err := bc.BuildUpdater.Update(buildCopy.Namespace, buildCopy); err != nil {
+		return fmt.Errorf("failed to cancel queued Build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	return nil
+}
+
+// startNextQueuedBuild starts the next queued build of the BuildConfig of a
+// serial build that has just completed: the oldest one for the Serial policy
+// and the latest one for the SerialLatestOnly policy.
+func (bc *BuildController) startNextQueuedBuild(completed *buildapi.Build) error {
+	if !isSerialBuild(completed) || !buildutil.IsBuildComplete(completed) {
+		return nil
+	}
+	builds, err := bc.configBuilds(completed)
+	if err != nil {
+		return err
+	}
+	latestOnly := getRunPolicy(completed) == buildapi.BuildRunPolicySerialLatestOnly
+	var next *buildapi.Build
+	for _, b := range builds {
+		if !isQueuedBuild(b) {
+			continue
+		}
+		if next == nil || isOlderBuild(b, next) != latestOnly {
+			next = b
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	glog.V(4).Infof("Build %s/%s completed, starting queued Build %s", completed.Namespace, completed.Name, next.Name)
+	return bc.startBuild(next)
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+func mockPolicyBuild(name string, created int, phase buildapi.BuildPhase, policy buildapi.BuildRunPolicy) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Name = name
+	build.CreationTimestamp = util.NewTime(time.Unix(int64(created), 0))
+	build.Namespace = "namespace"
+	build.Labels = map[string]string{buildapi.BuildConfigLabel: "config"}
+	build.Annotations = map[string]string{buildapi.BuildRunPolicyAnnotation: string(policy)}
+	build.Status.Config = &kapi.ObjectReference{Kind: "BuildConfig", Name: "config", Namespace: "namespace"}
+	return build
+}
+
+// recordingBuildUpdater records the updated builds and stores them in the
+// lister, like the server would.
+type recordingBuildUpdater struct {
+	updated map[string]*buildapi.Build
+	lister  *fakeBuildLister
+}
+
+func newRecordingBuildUpdater(lister *fakeBuildLister) *recordingBuildUpdater {
+	return &recordingBuildUpdater{updated: map[string]*buildapi.Build{}, lister: lister}
+}
+
+func (r *recordingBuildUpdater) Update(namespace string, build *buildapi.Build) error {
+	r.updated[build.Name] = build
+	for i, b := range r.lister.builds {
+		if b.Name == build.Name {
+			buildCopy := *build
+			r.lister.builds[i] = &buildCopy
+		}
+	}
+	return nil
+}
+
+func TestHandleBuildRunPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    buildapi.BuildRunPolicy
+		existing  []buildapi.BuildPhase
+		queued    bool
+		cancelled []string
+	}{
+		{
+			name:     "parallel build starts alongside a running build",
+			policy:   buildapi.BuildRunPolicyParallel,
+			existing: []buildapi.BuildPhase{buildapi.BuildPhaseRunning},
+		},
+		{
+			name:     "serial build starts after completed builds",
+			policy:   buildapi.BuildRunPolicySerial,
+			existing: []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed},
+		},
+		{
+			name:     "serial build waits for a running build",
+			policy:   buildapi.BuildRunPolicySerial,
+			existing: []buildapi.BuildPhase{buildapi.BuildPhaseRunning},
+			queued:   true,
+		},
+		{
+			name:     "serial build waits for an older queued build",
+			policy:   buildapi.BuildRunPolicySerial,
+			existing: []buildapi.BuildPhase{buildapi.BuildPhaseNew},
+			queued:   true,
+		},
+		{
+			name:      "latest only build cancels older queued builds",
+			policy:    buildapi.BuildRunPolicySerialLatestOnly,
+			existing:  []buildapi.BuildPhase{buildapi.BuildPhaseNew, buildapi.BuildPhaseNew},
+			cancelled: []string{"build-0", "build-1"},
+		},
+		{
+			name:      "latest only build cancels older queued builds and waits for a running build",
+			policy:    buildapi.BuildRunPolicySerialLatestOnly,
+			existing:  []buildapi.BuildPhase{buildapi.BuildPhaseRunning, buildapi.BuildPhaseNew},
+			queued:    true,
+			cancelled: []string{"build-1"},
+		},
+	}
+
+	for _, test := range tests {
+		lister := &fakeBuildLister{}
+		for i, phase := range test.existing {
+			lister.builds = append(lister.builds, mockPolicyBuild(fmt.Sprintf("build-%d", i), i, phase, test.policy))
+		}
+		build := mockPolicyBuild("build-new", len(test.existing), buildapi.BuildPhaseNew, test.policy)
+		lister.builds = append(lister.builds, build)
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		updater := newRecordingBuildUpdater(lister)
+		ctrl.BuildUpdater = updater
+
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if queued := build.Status.Phase == buildapi.BuildPhaseNew; queued != test.queued {
+			t.Errorf("%s: expected queued to be %v, got phase %s", test.name, test.queued, build.Status.Phase)
+		}
+		cancelled := 0
+		for name, b := range updater.updated {
+			if b.Status.Cancelled {
+				cancelled++
+				found := false
+				for _, expected := range test.cancelled {
+					found = found || expected == name
+				}
+				if !found {
+					t.Errorf("%s: unexpected cancellation of %s", test.name, name)
+				}
+			}
+		}
+		if cancelled != len(test.cancelled) {
+			t.Errorf("%s: expected %d cancelled builds, got %d", test.name, len(test.cancelled), cancelled)
+		}
+	}
+}
+
+func TestHandleBuildStartsNextQueuedBuild(t *testing.T) {
+	tests := []struct {
+		policy  buildapi.BuildRunPolicy
+		started string
+	}{
+		{policy: buildapi.BuildRunPolicySerial, started: "build-1"},
+		{policy: buildapi.BuildRunPolicySerialLatestOnly, started: "build-2"},
+	}
+
+	for _, test := range tests {
+		completed := mockPolicyBuild("build-0", 0, buildapi.BuildPhaseComplete, test.policy)
+		lister := &fakeBuildLister{builds: []*buildapi.Build{
+			completed,
+			mockPolicyBuild("build-1", 1, buildapi.BuildPhaseNew, test.policy),
+			mockPolicyBuild("build-2", 2, buildapi.BuildPhaseNew, test.policy),
+		}}
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		updater := newRecordingBuildUpdater(lister)
+		ctrl.BuildUpdater = updater
+
+		if err := ctrl.HandleBuild(completed); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.policy, err)
+		}
+		build, ok := updater.updated[test.started]
+		if !ok || build.Status.Phase != buildapi.BuildPhasePending {
+			t.Errorf("%s: expected %s to be started, got %#v", test.policy, test.started, updater.updated)
+		}
+	}
+}
+
+func TestHandleBuildRunPolicyQueuedBackToBack(t *testing.T) {
+	for _, policy := range []buildapi.BuildRunPolicy{buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly} {
+		first := mockPolicyBuild("build-0", 0, buildapi.BuildPhaseNew, policy)
+		second := mockPolicyBuild("build-1", 1, buildapi.BuildPhaseNew, policy)
+		lister := &fakeBuildLister{builds: []*buildapi.Build{first, second}}
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		ctrl.BuildUpdater = newRecordingBuildUpdater(lister)
+
+		for _, build := range []*buildapi.Build{first, second} {
+			buildCopy := *build
+			if err := ctrl.HandleBuild(&buildCopy); err != nil {
+				t.Fatalf("%s: unexpected error: %v", policy, err)
+			}
+		}
+		started := 0
+		for _, b := range lister.builds {
+			if b.Status.Phase == buildapi.BuildPhasePending {
+				started++
+			}
+		}
+		if started > 1 {
+			t.Errorf("%s: expected at most one started build, got %d", policy, started)
+		}
+	}
+}
@@ -90,5 +90,7 @@ func (bs *CustomBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 		setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret)
 	}
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupBuildSecrets(pod, build.Spec.Source.Secrets)
+	setupBinaryInput(pod, build.Spec.Source.Binary)
 	return pod, nil
 }
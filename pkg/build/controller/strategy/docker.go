@@ -33,11 +33,15 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 
 	containerEnv := []kapi.EnvVar{
 		{Name: "BUILD", Value: string(data)},
-		{Name: "SOURCE_REPOSITORY", Value: build.Spec.Source.Git.URI},
 		{Name: "BUILD_LOGLEVEL", Value: fmt.Sprintf("%d", cmdutil.GetLogLevel())},
 	}
+	if build.Spec.Source.Git != nil {
+		containerEnv = append(containerEnv, kapi.EnvVar{
+			Name: "SOURCE_REPOSITORY", Value: build.Spec.Source.Git.URI,
+		})
+	}
 	if len(strategy.Env) > 0 {
-		mergeTrustedEnvWithoutDuplicates(strategy.Env, &containerEnv)
+		mergeTrustedEnvWithoutDuplicates(literalBuildEnv(strategy.Env), &containerEnv)
 	}
 
 	pod := &kapi.Pod{
@@ -69,5 +73,9 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	setupDockerSocket(pod)
 	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret)
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupSourceImageSecrets(pod, build.Spec.Source.Images)
+	setupBuildSecrets(pod, build.Spec.Source.Secrets)
+	setupBuildEnvSecrets(pod, strategy.Env)
+	setupBinaryInput(pod, build.Spec.Source.Binary)
 	return pod, nil
 }
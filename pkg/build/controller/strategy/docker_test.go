@@ -88,6 +88,32 @@ func TestDockerCreateBuildPod(t *testing.T) {
 	}
 }
 
+func TestDockerCreateBuildPodBinary(t *testing.T) {
+	strategy := DockerBuildStrategy{
+		Image: "docker-test-image",
+		Codec: latest.Codec,
+	}
+
+	build := mockDockerBuild()
+	build.Spec.Source = buildapi.BuildSource{
+		Type:   buildapi.BuildSourceBinary,
+		Binary: &buildapi.BinaryBuildSource{},
+	}
+	actual, err := strategy.CreateBuildPod(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	container := actual.Spec.Containers[0]
+	if !container.Stdin {
+		t.Errorf("Expected stdin to be allocated for a binary build")
+	}
+	for _, v := range container.Env {
+		if v.Name == "SOURCE_REPOSITORY" {
+			t.Errorf("Unexpected SOURCE_REPOSITORY variable for a binary build: %#v", v)
+		}
+	}
+}
+
 func mockDockerBuild() *buildapi.Build {
 	return &buildapi.Build{
 		ObjectMeta: kapi.ObjectMeta{
@@ -111,7 +137,7 @@ func mockDockerBuild() *buildapi.Build {
 				Type: buildapi.DockerBuildStrategyType,
 				DockerStrategy: &buildapi.DockerBuildStrategy{
 					PullSecret: &kapi.LocalObjectReference{Name: "bar"},
-					Env: []kapi.EnvVar{
+					Env: []buildapi.BuildEnvVar{
 						{Name: "ILLEGAL", Value: "foo"},
 						{Name: "BUILD_LOGLEVEL", Value: "bar"},
 					},
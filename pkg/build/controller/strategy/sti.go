@@ -48,9 +48,13 @@ func (bs *SourceBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 
 	containerEnv := []kapi.EnvVar{
 		{Name: "BUILD", Value: string(data)},
-		{Name: "SOURCE_REPOSITORY", Value: build.Spec.Source.Git.URI},
 		{Name: "BUILD_LOGLEVEL", Value: fmt.Sprintf("%d", cmdutil.GetLogLevel())},
 	}
+	if build.Spec.Source.Git != nil {
+		containerEnv = append(containerEnv, kapi.EnvVar{
+			Name: "SOURCE_REPOSITORY", Value: build.Spec.Source.Git.URI,
+		})
+	}
 
 	strategy := build.Spec.Strategy.SourceStrategy
 	if len(strategy.Env) > 0 {
@@ -92,6 +96,9 @@ func (bs *SourceBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	setupDockerSocket(pod)
 	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret)
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupSourceImageSecrets(pod, build.Spec.Source.Images)
+	setupBuildSecrets(pod, build.Spec.Source.Secrets)
+	setupBinaryInput(pod, build.Spec.Source.Binary)
 	return pod, nil
 }
 
@@ -17,6 +17,9 @@ const (
 	DockerPushSecretMountPath = "/var/run/secrets/openshift.io/push"
 	DockerPullSecretMountPath = "/var/run/secrets/openshift.io/pull"
 	sourceSecretMountPath     = "/var/run/secrets/openshift.io/source"
+	sourceImageSecretsPath    = "/var/run/secrets/openshift.io/source-image"
+	buildSecretsPath          = "/var/run/secrets/openshift.io/build"
+	buildEnvSecretsPath       = "/var/run/secrets/openshift.io/env"
 )
 
 var whitelistEnvVarNames = []string{"BUILD_LOGLEVEL"}
@@ -129,6 +132,87 @@ func setupSourceSecrets(pod *kapi.Pod, sourceSecret *kapi.LocalObjectReference)
 	}...)
 }
 
+// setupSourceImageSecrets mounts the pull secrets of the image sources, each in
+// its own directory, so the builder can pull the images it copies source from.
+func setupSourceImageSecrets(pod *kapi.Pod, images []buildapi.ImageSource) {
+	mounted := util.NewStringSet()
+	for _, image := range images {
+		if image.PullSecret == nil || mounted.Has(image.PullSecret.Name) {
+			continue
+		}
+		mounted.Insert(image.PullSecret.Name)
+		mountSecretVolume(pod, image.PullSecret.Name, filepath.Join(sourceImageSecretsPath, image.PullSecret.Name), "source-image")
+	}
+	if mounted.Len() == 0 {
+		return
+	}
+	glog.V(3).Infof("Installed source image secrets in %s, in Pod %s/%s", sourceImageSecretsPath, pod.Namespace, pod.Name)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, kapi.EnvVar{
+		Name: "SOURCE_IMAGE_DOCKERCFG_PATH", Value: sourceImageSecretsPath,
+	})
+}
+
+// setupBuildSecrets mounts the secrets of the build source, each in its own
+// directory, so the builder can copy them into the build directory.
+func setupBuildSecrets(pod *kapi.Pod, secrets []buildapi.SecretBuildSource) {
+	if len(secrets) == 0 {
+		return
+	}
+	for _, s := range secrets {
+		mountSecretVolume(pod, s.Secret.Name, filepath.Join(buildSecretsPath, s.Secret.Name), "build")
+	}
+	glog.V(3).Infof("Installed build secrets in %s, in Pod %s/%s", buildSecretsPath, pod.Namespace, pod.Name)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, kapi.EnvVar{
+		Name: "BUILD_SECRETS_PATH", Value: buildSecretsPath,
+	})
+}
+
+// setupBuildEnvSecrets mounts the secrets referenced by the build environment
+// variables, each in its own directory, so the builder can resolve their values.
+func setupBuildEnvSecrets(pod *kapi.Pod, env []buildapi.BuildEnvVar) {
+	mounted := util.NewStringSet()
+	for _, ev := range env {
+		if ev.ValueFrom == nil || ev.ValueFrom.SecretKeyRef == nil {
+			continue
+		}
+		name := ev.ValueFrom.SecretKeyRef.Name
+		if mounted.Has(name) {
+			continue
+		}
+		mountSecretVolume(pod, name, filepath.Join(buildEnvSecretsPath, name), "env")
+		mounted.Insert(name)
+	}
+	if mounted.Len() == 0 {
+		return
+	}
+	glog.V(3).Infof("Installed build environment secrets in %s, in Pod %s/%s", buildEnvSecretsPath, pod.Namespace, pod.Name)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, kapi.EnvVar{
+		Name: "BUILD_ENV_SECRETS_PATH", Value: buildEnvSecretsPath,
+	})
+}
+
+// literalBuildEnv returns the build environment variables which have a literal
+// value. Variables resolved from secrets are left to the builder.
+func literalBuildEnv(env []buildapi.BuildEnvVar) []kapi.EnvVar {
+	result := []kapi.EnvVar{}
+	for _, ev := range env {
+		if ev.ValueFrom != nil {
+			continue
+		}
+		result = append(result, kapi.EnvVar{Name: ev.Name, Value: ev.Value})
+	}
+	return result
+}
+
+// setupBinaryInput allocates stdin for the build container so that the binary
+// input provided by the client can be streamed into the build.
+func setupBinaryInput(pod *kapi.Pod, binary *buildapi.BinaryBuildSource) {
+	if binary == nil {
+		return
+	}
+	pod.Spec.Containers[0].Stdin = true
+}
+
 // mergeTrustedEnvWithoutDuplicates merges two environment lists without having
 // duplicate items in the output list.  Only trusted environment variables
 // will be merged.
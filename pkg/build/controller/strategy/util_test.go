@@ -3,6 +3,7 @@ package strategy
 import (
 	"testing"
 
+	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	kapi "k8s.io/kubernetes/pkg/api"
 )
@@ -98,6 +99,119 @@ func TestSetupBuildEnvEmpty(t *testing.T) {
 	}
 }
 
+func TestSetupSourceImageSecrets(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+	images := []buildapi.ImageSource{
+		{PullSecret: &kapi.LocalObjectReference{Name: "registry"}},
+		{PullSecret: &kapi.LocalObjectReference{Name: "registry"}},
+		{},
+	}
+
+	setupSourceImageSecrets(&pod, images)
+
+	if len(pod.Spec.Volumes) != 1 {
+		t.Fatalf("Expected 1 volume, got: %#v", pod.Spec.Volumes)
+	}
+	if pod.Spec.Volumes[0].Secret == nil || pod.Spec.Volumes[0].Secret.SecretName != "registry" {
+		t.Errorf("Expected the registry secret to be mounted, got: %#v", pod.Spec.Volumes[0])
+	}
+	mounts := pod.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 1 {
+		t.Fatalf("Expected 1 volume mount, got: %#v", mounts)
+	}
+	if e, a := "/var/run/secrets/openshift.io/source-image/registry", mounts[0].MountPath; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	env := pod.Spec.Containers[0].Env
+	if len(env) != 1 || env[0].Name != "SOURCE_IMAGE_DOCKERCFG_PATH" || env[0].Value != "/var/run/secrets/openshift.io/source-image" {
+		t.Errorf("Unexpected environment: %#v", env)
+	}
+}
+
+func TestSetupBuildSecrets(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+	secrets := []buildapi.SecretBuildSource{
+		{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: "configuration"},
+		{Secret: kapi.LocalObjectReference{Name: "npmrc"}},
+	}
+
+	setupBuildSecrets(&pod, secrets)
+
+	if len(pod.Spec.Volumes) != 2 {
+		t.Fatalf("Expected 2 volumes, got: %#v", pod.Spec.Volumes)
+	}
+	mounts := pod.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 2 {
+		t.Fatalf("Expected 2 volume mounts, got: %#v", mounts)
+	}
+	for i, name := range []string{"maven-settings", "npmrc"} {
+		if pod.Spec.Volumes[i].Secret == nil || pod.Spec.Volumes[i].Secret.SecretName != name {
+			t.Errorf("Expected the %s secret to be mounted, got: %#v", name, pod.Spec.Volumes[i])
+		}
+		if e, a := "/var/run/secrets/openshift.io/build/"+name, mounts[i].MountPath; e != a {
+			t.Errorf("Expected %s, got %s", e, a)
+		}
+	}
+	env := pod.Spec.Containers[0].Env
+	if len(env) != 1 || env[0].Name != "BUILD_SECRETS_PATH" || env[0].Value != "/var/run/secrets/openshift.io/build" {
+		t.Errorf("Unexpected environment: %#v", env)
+	}
+}
+
+func TestSetupBuildEnvSecrets(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+	secretRef := func(name, key string) *buildapi.BuildEnvVarSource {
+		return &buildapi.BuildEnvVarSource{
+			SecretKeyRef: &buildapi.SecretKeySelector{
+				LocalObjectReference: kapi.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+	env := []buildapi.BuildEnvVar{
+		{Name: "DEPLOY_ENV", Value: "staging"},
+		{Name: "USERNAME", ValueFrom: secretRef("credentials", "username")},
+		{Name: "PASSWORD", ValueFrom: secretRef("credentials", "password")},
+	}
+
+	setupBuildEnvSecrets(&pod, env)
+
+	if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].Secret == nil || pod.Spec.Volumes[0].Secret.SecretName != "credentials" {
+		t.Fatalf("Expected the credentials secret to be mounted once, got: %#v", pod.Spec.Volumes)
+	}
+	mounts := pod.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 1 || mounts[0].MountPath != "/var/run/secrets/openshift.io/env/credentials" {
+		t.Fatalf("Unexpected volume mounts: %#v", mounts)
+	}
+	containerEnv := pod.Spec.Containers[0].Env
+	if len(containerEnv) != 1 || containerEnv[0].Name != "BUILD_ENV_SECRETS_PATH" || containerEnv[0].Value != "/var/run/secrets/openshift.io/env" {
+		t.Errorf("Unexpected environment: %#v", containerEnv)
+	}
+
+	literal := literalBuildEnv(env)
+	if len(literal) != 1 || literal[0].Name != "DEPLOY_ENV" || literal[0].Value != "staging" {
+		t.Errorf("Unexpected literal environment: %#v", literal)
+	}
+}
+
 func TestTrustedMergeEnvWithoutDuplicates(t *testing.T) {
 	input := []kapi.EnvVar{
 		{Name: "foo", Value: "bar"},
@@ -27,6 +27,10 @@ type BuildGenerator struct {
 	DefaultServiceAccountName string
 	ServiceAccounts           kclient.ServiceAccountsNamespacer
 	Secrets                   kclient.SecretsNamespacer
+	// TriggerCauseGroups are the groups of the internal clients allowed to
+	// record why a Build was started. The Builds requested by other users are
+	// recorded as started manually.
+	TriggerCauseGroups util.StringSet
 }
 
 // GeneratorClient is the API client used by the generator
@@ -146,6 +150,32 @@ func findImageChangeTrigger(bc *buildapi.BuildConfig, ref *kapi.ObjectReference)
 	return nil
 }
 
+// findImageSource finds an image used as build source that has an ImageStreamTag from
+// that matches the passed in ref and that is not watched by an image change trigger
+func findImageSource(bc *buildapi.BuildConfig, ref *kapi.ObjectReference) *buildapi.ImageSource {
+	if ref == nil {
+		return nil
+	}
+	refNs := ref.Namespace
+	if refNs == "" {
+		refNs = bc.Namespace
+	}
+	for i := range bc.Spec.Source.Images {
+		imageSource := &bc.Spec.Source.Images[i]
+		if imageSource.From.Kind != "ImageStreamTag" || findImageChangeTrigger(bc, &imageSource.From) != nil {
+			continue
+		}
+		sourceNs := imageSource.From.Namespace
+		if sourceNs == "" {
+			sourceNs = bc.Namespace
+		}
+		if imageSource.From.Name == ref.Name && sourceNs == refNs {
+			return imageSource
+		}
+	}
+	return nil
+}
+
 func describeBuildRequest(request *buildapi.BuildRequest) string {
 	desc := fmt.Sprintf("BuildConfig: %s/%s", request.Namespace, request.Name)
 	if request.Revision != nil {
@@ -159,6 +189,9 @@ func describeBuildRequest(request *buildapi.BuildRequest) string {
 	if request.LastVersion != nil {
 		desc += fmt.Sprintf(", LastVersion: %d", *request.LastVersion)
 	}
+	if request.Binary != nil {
+		desc += fmt.Sprintf(", Binary: %#v", request.Binary)
+	}
 	return desc
 }
 
@@ -174,6 +207,10 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 		return nil, err
 	}
 
+	if bc.Spec.Source.Type == buildapi.BuildSourceBinary && request.Binary == nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("build config %s/%s has a binary source and can only be started with binary input", bc.Namespace, bc.Name))
+	}
+
 	if err := g.updateImageTriggers(ctx, bc, request.From, request.TriggeredByImage); err != nil {
 		return nil, err
 	}
@@ -182,6 +219,10 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 	if err != nil {
 		return nil, err
 	}
+	if request.Binary != nil {
+		setBinarySource(newBuild, request.Binary)
+	}
+	newBuild.Status.TriggeredBy = g.buildTriggerCauses(ctx, request)
 	glog.V(4).Infof("Build %s/%s has been generated from %s/%s BuildConfig", newBuild.Namespace, newBuild.ObjectMeta.Name, bc.Namespace, bc.ObjectMeta.Name)
 
 	// need to update the BuildConfig because LastVersion and possibly LastTriggeredImageID changed
@@ -208,14 +249,20 @@ func (g *BuildGenerator) checkLastVersion(bc *buildapi.BuildConfig, lastVersion
 	return nil
 }
 
-// updateImageTriggers sets the LastTriggeredImageID on all the ImageChangeTriggers on the BuildConfig and
-// updates the From reference of the strategy if the strategy uses an ImageStream or ImageStreamTag reference
+// updateImageTriggers sets the LastTriggeredImageID on all the ImageChangeTriggers and the image sources
+// not watched by one on the BuildConfig and updates the From reference of the strategy if the strategy
+// uses an ImageStream or ImageStreamTag reference
 func (g *BuildGenerator) updateImageTriggers(ctx kapi.Context, bc *buildapi.BuildConfig, from, triggeredBy *kapi.ObjectReference) error {
 	var requestTrigger *buildapi.ImageChangeTrigger
+	var requestSource *buildapi.ImageSource
 	if from != nil {
 		requestTrigger = findImageChangeTrigger(bc, from)
+		if requestTrigger == nil {
+			requestSource = findImageSource(bc, from)
+		}
 	}
-	if requestTrigger != nil && requestTrigger.LastTriggeredImageID == triggeredBy.Name {
+	if (requestTrigger != nil && requestTrigger.LastTriggeredImageID == triggeredBy.Name) ||
+		(requestSource != nil && requestSource.LastTriggeredImageID == triggeredBy.Name) {
 		glog.V(2).Infof("Aborting imageid triggered build for BuildConfig %s/%s with imageid %s because the BuildConfig already matches this imageid", bc.Namespace, bc.Name, triggeredBy.Name)
 		return fmt.Errorf("build config %s/%s has already instantiated a build for imageid %s", bc.Namespace, bc.Name, triggeredBy.Name)
 	}
@@ -245,6 +292,22 @@ func (g *BuildGenerator) updateImageTriggers(ctx kapi.Context, bc *buildapi.Buil
 		}
 		trigger.ImageChange.LastTriggeredImageID = image
 	}
+	// Update last triggered image id for all image sources that act as their own trigger
+	for i := range bc.Spec.Source.Images {
+		imageSource := &bc.Spec.Source.Images[i]
+		if imageSource != findImageSource(bc, &imageSource.From) {
+			continue
+		}
+		if imageSource == requestSource {
+			imageSource.LastTriggeredImageID = triggeredBy.Name
+			continue
+		}
+		image, err := g.resolveImageStreamReference(ctx, imageSource.From, bc.Namespace)
+		if err != nil {
+			glog.Warningf("Could not resolve image source reference for build config %s/%s: %#v", bc.Namespace, bc.Name, imageSource.From)
+		}
+		imageSource.LastTriggeredImageID = image
+	}
 	return nil
 }
 
@@ -255,11 +318,40 @@ func (g *BuildGenerator) Clone(ctx kapi.Context, request *buildapi.BuildRequest)
 	if err != nil {
 		return nil, err
 	}
+	if build.Spec.Source.Type == buildapi.BuildSourceBinary && request.Binary == nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("build %s/%s has a binary source and can only be re-run with binary input", build.Namespace, build.Name))
+	}
 	newBuild := generateBuildFromBuild(build)
+	if request.Binary != nil {
+		setBinarySource(newBuild, request.Binary)
+	}
+	newBuild.Status.TriggeredBy = g.buildTriggerCauses(ctx, request)
 	glog.V(4).Infof("Build %s/%s has been generated from Build %s/%s", newBuild.Namespace, newBuild.ObjectMeta.Name, build.Namespace, build.ObjectMeta.Name)
 	return g.createBuild(ctx, newBuild)
 }
 
+// buildTriggerCauses returns the causes to record on a Build generated for the
+// request. The causes of the request are only recorded when it was made by a
+// member of the TriggerCauseGroups, otherwise the Build is recorded as started
+// manually by the user of the context.
+func (g *BuildGenerator) buildTriggerCauses(ctx kapi.Context, request *buildapi.BuildRequest) []buildapi.BuildTriggerCause {
+	user, ok := kapi.UserFrom(ctx)
+	if len(request.TriggeredBy) > 0 {
+		if ok && g.TriggerCauseGroups.HasAny(user.GetGroups()...) {
+			return request.TriggeredBy
+		}
+		glog.V(4).Infof("Ignoring the trigger causes of the request for %s/%s, they may only be set by internal clients", request.Namespace, request.Name)
+	}
+	cause := buildapi.BuildTriggerCause{
+		Message: buildapi.BuildTriggerCauseManualMsg,
+		Manual:  &buildapi.ManualCause{},
+	}
+	if ok {
+		cause.Manual.User = user.GetName()
+	}
+	return []buildapi.BuildTriggerCause{cause}
+}
+
 // createBuild is responsible for validating build object and saving it and returning newly created object
 func (g *BuildGenerator) createBuild(ctx kapi.Context, build *buildapi.Build) (*buildapi.Build, error) {
 	if !kapi.ValidNamespace(ctx, &build.ObjectMeta) {
@@ -292,12 +384,14 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	bcCopy := obj.(*buildapi.BuildConfig)
 	build := &buildapi.Build{
 		Spec: buildapi.BuildSpec{
-			ServiceAccount: serviceAccount,
-			Source:         bcCopy.Spec.Source,
-			Strategy:       bcCopy.Spec.Strategy,
-			Output:         bcCopy.Spec.Output,
-			Revision:       revision,
-			Resources:      bcCopy.Spec.Resources,
+			ServiceAccount:            serviceAccount,
+			Source:                    bcCopy.Spec.Source,
+			Strategy:                  bcCopy.Spec.Strategy,
+			Output:                    bcCopy.Spec.Output,
+			Revision:                  revision,
+			Resources:                 bcCopy.Spec.Resources,
+			PostCommit:                bcCopy.Spec.PostCommit,
+			CompletionDeadlineSeconds: bcCopy.Spec.CompletionDeadlineSeconds,
 		},
 		ObjectMeta: kapi.ObjectMeta{
 			Labels: bcCopy.Labels,
@@ -312,11 +406,19 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		},
 	}
 
+	// a webhook may ask to build the pushed ref instead of the configured one
+	if revision != nil && revision.Git != nil && len(revision.Git.PushedRef) > 0 && build.Spec.Source.Git != nil {
+		build.Spec.Source.Git.Ref = revision.Git.PushedRef
+	}
+
 	build.Name = getNextBuildName(bc)
 	if build.Annotations == nil {
 		build.Annotations = make(map[string]string)
 	}
 	build.Annotations[buildapi.BuildNumberAnnotation] = strconv.Itoa(bc.Status.LastVersion)
+	if len(bc.Spec.RunPolicy) > 0 {
+		build.Annotations[buildapi.BuildRunPolicyAnnotation] = string(bc.Spec.RunPolicy)
+	}
 	if build.Labels == nil {
 		build.Labels = make(map[string]string)
 	}
@@ -384,9 +486,45 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		}
 		updateCustomImageEnv(build.Spec.Strategy.CustomStrategy, image)
 	}
+	if err := g.resolveImageSources(ctx, bc, build.Spec.Source.Images, builderSecrets); err != nil {
+		return nil, err
+	}
 	return build, nil
 }
 
+// resolveImageSources converts the From reference of every image used as build source
+// into a Docker image pull spec. Images referenced by an ImageChange trigger or recorded
+// on the image source use the image that was last triggered so the build matches the
+// trigger that started it.
+func (g *BuildGenerator) resolveImageSources(ctx kapi.Context, bc *buildapi.BuildConfig, images []buildapi.ImageSource, builderSecrets []kapi.Secret) error {
+	for i := range images {
+		imageSource := &images[i]
+		var image string
+		if trigger := findImageChangeTrigger(bc, &imageSource.From); trigger != nil {
+			if trigger.From != nil {
+				image = trigger.LastTriggeredImageID
+			}
+		} else {
+			image = imageSource.LastTriggeredImageID
+		}
+		if image == "" {
+			var err error
+			if image, err = g.resolveImageStreamReference(ctx, imageSource.From, bc.Namespace); err != nil {
+				return err
+			}
+		}
+		if imageSource.PullSecret == nil {
+			imageSource.PullSecret = g.resolveImageSecret(ctx, builderSecrets, &imageSource.From, bc.Namespace)
+		}
+		imageSource.From = kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: image,
+		}
+		imageSource.LastTriggeredImageID = ""
+	}
+	return nil
+}
+
 // resolveImageStreamReference looks up the ImageStream[Tag/Image] and converts it to a
 // docker pull spec that can be used in an Image field.
 func (g *BuildGenerator) resolveImageStreamReference(ctx kapi.Context, from kapi.ObjectReference, defaultNamespace string) (string, error) {
@@ -503,6 +641,15 @@ func (g *BuildGenerator) resolveImageSecret(ctx kapi.Context, secrets []kapi.Sec
 	return nil
 }
 
+// setBinarySource replaces the source of the build with the binary input that will be
+// provided by the client once the build is running.
+func setBinarySource(build *buildapi.Build, binary *buildapi.BinaryBuildSource) {
+	build.Spec.Source.Type = buildapi.BuildSourceBinary
+	build.Spec.Source.Git = nil
+	build.Spec.Source.SourceSecret = nil
+	build.Spec.Source.Binary = &buildapi.BinaryBuildSource{AsFile: binary.AsFile}
+}
+
 // getNextBuildName returns name of the next build and increments BuildConfig's LastVersion.
 func getNextBuildName(bc *buildapi.BuildConfig) string {
 	bc.Status.LastVersion++
@@ -537,7 +684,7 @@ func generateBuildFromBuild(build *buildapi.Build) *buildapi.Build {
 	obj, _ := kapi.Scheme.Copy(build)
 	buildCopy := obj.(*buildapi.Build)
 	// TODO: How do we want to handle buildapi.BuildNumberAnnotation for cloned builds?
-	return &buildapi.Build{
+	newBuild := &buildapi.Build{
 		Spec: buildCopy.Spec,
 		ObjectMeta: kapi.ObjectMeta{
 			Name:   getNextBuildNameFromBuild(buildCopy),
@@ -548,6 +695,11 @@ func generateBuildFromBuild(build *buildapi.Build) *buildapi.Build {
 			Config: buildCopy.Status.Config,
 		},
 	}
+	// the clone is scheduled with the same run policy as the original build
+	if policy, ok := buildCopy.Annotations[buildapi.BuildRunPolicyAnnotation]; ok {
+		newBuild.Annotations = map[string]string{buildapi.BuildRunPolicyAnnotation: policy}
+	}
+	return newBuild
 }
 
 // getNextBuildNameFromBuild returns name of the next build with random uuid added at the end
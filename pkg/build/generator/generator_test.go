@@ -9,9 +9,11 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/auth/user"
 
 	"k8s.io/kubernetes/pkg/client/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	mocks "github.com/openshift/origin/pkg/build/generator/test"
@@ -47,6 +49,99 @@ func TestInstantiate(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithBinary(t *testing.T) {
+	generator := mockBuildGenerator()
+	var created *buildapi.Build
+	c := generator.Client.(Client)
+	c.CreateBuildFunc = func(ctx kapi.Context, build *buildapi.Build) error {
+		created = build
+		return nil
+	}
+	generator.Client = c
+
+	_, err := generator.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{
+		Binary: &buildapi.BinaryBuildSource{AsFile: "app.war"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	source := created.Spec.Source
+	if source.Type != buildapi.BuildSourceBinary || source.Git != nil {
+		t.Errorf("Expected the build source to be replaced by the binary input, got %#v", source)
+	}
+	if source.Binary == nil || source.Binary.AsFile != "app.war" {
+		t.Errorf("Expected binary source with asFile app.war, got %#v", source.Binary)
+	}
+}
+
+func TestInstantiateTriggerCauses(t *testing.T) {
+	generator := mockBuildGenerator()
+	generator.TriggerCauseGroups = util.NewStringSet("system:masters", "system:serviceaccounts:openshift-infra")
+	var created *buildapi.Build
+	c := generator.Client.(Client)
+	c.CreateBuildFunc = func(ctx kapi.Context, build *buildapi.Build) error {
+		created = build
+		return nil
+	}
+	generator.Client = c
+
+	ctx := kapi.WithUser(kapi.NewDefaultContext(), &user.DefaultInfo{Name: "developer"})
+	if _, err := generator.Instantiate(ctx, &buildapi.BuildRequest{}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	expected := []buildapi.BuildTriggerCause{{
+		Message: buildapi.BuildTriggerCauseManualMsg,
+		Manual:  &buildapi.ManualCause{User: "developer"},
+	}}
+	if !reflect.DeepEqual(expected, created.Status.TriggeredBy) {
+		t.Errorf("Expected the build to be recorded as started by the user, got %#v", created.Status.TriggeredBy)
+	}
+
+	causes := []buildapi.BuildTriggerCause{{
+		Message: buildapi.BuildTriggerCauseGithubMsg,
+		GitHubWebHook: &buildapi.GitHubWebHookCause{
+			Revision: &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "1234"}},
+		},
+	}}
+	if _, err := generator.Instantiate(ctx, &buildapi.BuildRequest{TriggeredBy: causes}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(expected, created.Status.TriggeredBy) {
+		t.Errorf("Expected the causes set by a user to be ignored, got %#v", created.Status.TriggeredBy)
+	}
+
+	for _, internal := range []user.Info{
+		&user.DefaultInfo{Name: "system:openshift-master", Groups: []string{"system:masters"}},
+		&user.DefaultInfo{Name: "system:serviceaccount:openshift-infra:pipeline-controller", Groups: []string{"system:serviceaccounts", "system:serviceaccounts:openshift-infra"}},
+	} {
+		ctx := kapi.WithUser(kapi.NewDefaultContext(), internal)
+		if _, err := generator.Instantiate(ctx, &buildapi.BuildRequest{TriggeredBy: causes}); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if !reflect.DeepEqual(causes, created.Status.TriggeredBy) {
+			t.Errorf("Expected the causes of the request of %s, got %#v", internal.GetName(), created.Status.TriggeredBy)
+		}
+	}
+}
+
+func TestInstantiateBinaryConfigWithoutBinary(t *testing.T) {
+	generator := mockBuildGenerator()
+	c := generator.Client.(Client)
+	c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		source := buildapi.BuildSource{
+			Type:   buildapi.BuildSourceBinary,
+			Binary: &buildapi.BinaryBuildSource{},
+		}
+		return mocks.MockBuildConfig(source, mocks.MockSourceStrategyForImageRepository(), mocks.MockOutput()), nil
+	}
+	generator.Client = c
+
+	_, err := generator.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{})
+	if err == nil || !strings.Contains(err.Error(), "binary input") {
+		t.Errorf("Expected binary input error, got %v", err)
+	}
+}
+
 // TODO(agoldste): I'm not sure the intent of this test. Using the previous logic for
 // the generator, which would try to update the build config before creating
 // the build, I can see why the UpdateBuildConfigFunc is set up to return an
@@ -464,6 +559,35 @@ func TestClone(t *testing.T) {
 	}
 }
 
+func TestCloneTriggerCauses(t *testing.T) {
+	var created *buildapi.Build
+	generator := BuildGenerator{Client: Client{
+		CreateBuildFunc: func(ctx kapi.Context, build *buildapi.Build) error {
+			created = build
+			return nil
+		},
+		GetBuildFunc: func(ctx kapi.Context, name string) (*buildapi.Build, error) {
+			return &buildapi.Build{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "test-build-1",
+					Namespace: kapi.NamespaceDefault,
+				},
+				Status: buildapi.BuildStatus{
+					TriggeredBy: []buildapi.BuildTriggerCause{{Message: buildapi.BuildTriggerCauseConfigMsg}},
+				},
+			}, nil
+		},
+	}}
+
+	ctx := kapi.WithUser(kapi.NewDefaultContext(), &user.DefaultInfo{Name: "developer"})
+	if _, err := generator.Clone(ctx, &buildapi.BuildRequest{}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(created.Status.TriggeredBy) != 1 || created.Status.TriggeredBy[0].Manual == nil || created.Status.TriggeredBy[0].Manual.User != "developer" {
+		t.Errorf("Expected the clone to be recorded as started by the user, got %#v", created.Status.TriggeredBy)
+	}
+}
+
 func TestCloneError(t *testing.T) {
 	generator := BuildGenerator{Client: Client{
 		GetBuildFunc: func(ctx kapi.Context, name string) (*buildapi.Build, error) {
@@ -477,6 +601,30 @@ func TestCloneError(t *testing.T) {
 	}
 }
 
+func TestCloneBinaryWithoutBinary(t *testing.T) {
+	generator := BuildGenerator{Client: Client{
+		GetBuildFunc: func(ctx kapi.Context, name string) (*buildapi.Build, error) {
+			return &buildapi.Build{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "test-build-1",
+					Namespace: kapi.NamespaceDefault,
+				},
+				Spec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Type:   buildapi.BuildSourceBinary,
+						Binary: &buildapi.BinaryBuildSource{},
+					},
+				},
+			}, nil
+		},
+	}}
+
+	_, err := generator.Clone(kapi.NewDefaultContext(), &buildapi.BuildRequest{})
+	if err == nil || !strings.Contains(err.Error(), "binary input") {
+		t.Errorf("Expected binary input error, got %v", err)
+	}
+}
+
 func TestCreateBuild(t *testing.T) {
 	build := &buildapi.Build{
 		ObjectMeta: kapi.ObjectMeta{
@@ -536,6 +684,7 @@ func TestCreateBuildCreateError(t *testing.T) {
 }
 
 func TestGenerateBuildFromConfig(t *testing.T) {
+	deadline := int64(3600)
 	source := mocks.MockSource()
 	strategy := mockDockerStrategyForDockerImage(originalImage)
 	output := mocks.MockOutput()
@@ -558,7 +707,12 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 				Strategy:  strategy,
 				Output:    output,
 				Resources: resources,
+				PostCommit: buildapi.BuildPostCommitSpec{
+					Script: "rake test",
+				},
+				CompletionDeadlineSeconds: &deadline,
 			},
+			RunPolicy: buildapi.BuildRunPolicySerial,
 		},
 		Status: buildapi.BuildConfigStatus{
 			LastVersion: 12,
@@ -595,6 +749,12 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 	if !reflect.DeepEqual(resources, build.Spec.Resources) {
 		t.Errorf("Build resources does not match passed in resources")
 	}
+	if !reflect.DeepEqual(bc.Spec.PostCommit, build.Spec.PostCommit) {
+		t.Errorf("Build post commit hook does not match BuildConfig post commit hook")
+	}
+	if !reflect.DeepEqual(bc.Spec.CompletionDeadlineSeconds, build.Spec.CompletionDeadlineSeconds) {
+		t.Errorf("Build completion deadline does not match BuildConfig completion deadline")
+	}
 	if build.Labels["testlabel"] != bc.Labels["testlabel"] {
 		t.Errorf("Build does not contain labels from BuildConfig")
 	}
@@ -607,6 +767,44 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 	if build.Annotations[buildapi.BuildNumberAnnotation] != "13" {
 		t.Errorf("Build number annotation value %s does not match expected value 13", build.Annotations[buildapi.BuildNumberAnnotation])
 	}
+	if build.Annotations[buildapi.BuildRunPolicyAnnotation] != string(buildapi.BuildRunPolicySerial) {
+		t.Errorf("Build run policy annotation value %s does not match expected value Serial", build.Annotations[buildapi.BuildRunPolicyAnnotation])
+	}
+}
+
+func TestGenerateBuildFromConfigWithPushedRef(t *testing.T) {
+	bc := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "test-build-config",
+			Namespace: "test-namespace",
+		},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source:   mocks.MockSource(),
+				Strategy: mockDockerStrategyForDockerImage(originalImage),
+				Output:   mocks.MockOutput(),
+			},
+		},
+	}
+	revision := &buildapi.SourceRevision{
+		Type: buildapi.BuildSourceGit,
+		Git: &buildapi.GitSourceRevision{
+			Commit:    "abcd",
+			PushedRef: "feature/x",
+		},
+	}
+	generator := mockBuildGenerator()
+
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Spec.Source.Git.Ref != "feature/x" {
+		t.Errorf("Expected the build to check out the pushed ref, got %q", build.Spec.Source.Git.Ref)
+	}
+	if bc.Spec.Source.Git.Ref == "feature/x" {
+		t.Errorf("The BuildConfig source must not be modified")
+	}
 }
 
 func TestGenerateBuildWithImageTagForSourceStrategyImageRepository(t *testing.T) {
@@ -844,6 +1042,56 @@ func TestGenerateBuildWithImageTagForCustomStrategyImageRepository(t *testing.T)
 	}
 }
 
+func TestGenerateBuildWithImageSources(t *testing.T) {
+	source := mocks.MockSource()
+	source.Images = []buildapi.ImageSource{
+		{
+			From:  kapi.ObjectReference{Kind: "ImageStreamTag", Name: "artifacts:latest"},
+			Paths: []buildapi.ImageSourcePath{{SourcePath: "/opt/app/app.war"}},
+		},
+		{
+			From:  kapi.ObjectReference{Kind: "ImageStreamTag", Name: imageRepoName + ":" + tagName},
+			Paths: []buildapi.ImageSourcePath{{SourcePath: "/opt/tools/."}},
+		},
+	}
+	bc := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "test-build-config",
+		},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source:   source,
+				Strategy: mockDockerStrategyForNilImage(),
+				Output:   mocks.MockOutput(),
+			},
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type: buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{
+						From:                 &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "artifacts:latest"},
+						LastTriggeredImageID: "registry/artifacts@sha256:1234",
+					},
+				},
+			},
+		},
+	}
+	generator := mockBuildGenerator()
+
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	expected := []string{"registry/artifacts@sha256:1234", latestDockerReference}
+	for i, image := range build.Spec.Source.Images {
+		if image.From.Kind != "DockerImage" || image.From.Name != expected[i] {
+			t.Errorf("image source %d: expected DockerImage %s, got %s %s", i, expected[i], image.From.Kind, image.From.Name)
+		}
+	}
+	if bc.Spec.Source.Images[0].From.Kind != "ImageStreamTag" {
+		t.Errorf("the build config image sources must not be modified: %#v", bc.Spec.Source.Images[0].From)
+	}
+}
+
 func TestGenerateBuildFromBuild(t *testing.T) {
 	source := mocks.MockSource()
 	strategy := mockDockerStrategyForImageRepository()
@@ -851,6 +1099,9 @@ func TestGenerateBuildFromBuild(t *testing.T) {
 	build := &buildapi.Build{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test-build",
+			Annotations: map[string]string{
+				buildapi.BuildRunPolicyAnnotation: string(buildapi.BuildRunPolicySerialLatestOnly),
+			},
 		},
 		Spec: buildapi.BuildSpec{
 			Source: source,
@@ -872,6 +1123,9 @@ func TestGenerateBuildFromBuild(t *testing.T) {
 	if !reflect.DeepEqual(build.ObjectMeta.Labels, newBuild.ObjectMeta.Labels) {
 		t.Errorf("Build labels does not match the original Build labels")
 	}
+	if newBuild.Annotations[buildapi.BuildRunPolicyAnnotation] != string(buildapi.BuildRunPolicySerialLatestOnly) {
+		t.Errorf("Build run policy annotation does not match the original Build run policy")
+	}
 }
 
 func TestSubstituteImageCustomAllMatch(t *testing.T) {
@@ -49,6 +49,24 @@ func NewPruneTasker(buildConfigs []*buildapi.BuildConfig, builds []*buildapi.Bui
 	}
 }
 
+// NewBuildConfigHistoryPruneTasker returns a PruneTasker over the builds of buildConfig
+// that exceed the history limits set in its spec. A nil limit retains all the builds
+// it applies to.
+func NewBuildConfigHistoryPruneTasker(buildConfig *buildapi.BuildConfig, builds []*buildapi.Build, handler PruneFunc) PruneTasker {
+	keepComplete, keepFailed := -1, -1
+	if buildConfig.Spec.SuccessfulBuildsHistoryLimit != nil {
+		keepComplete = *buildConfig.Spec.SuccessfulBuildsHistoryLimit
+	}
+	if buildConfig.Spec.FailedBuildsHistoryLimit != nil {
+		keepFailed = *buildConfig.Spec.FailedBuildsHistoryLimit
+	}
+	dataSet := NewDataSet([]*buildapi.BuildConfig{buildConfig}, builds)
+	return &pruneTask{
+		resolver: NewPerBuildConfigResolver(dataSet, keepComplete, keepFailed),
+		handler:  handler,
+	}
+}
+
 // PruneTask will visit each item in the prunable set and invoke the associated handler
 func (t *pruneTask) PruneTask() error {
 	builds, err := t.resolver.Resolve()
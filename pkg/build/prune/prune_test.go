@@ -98,3 +98,57 @@ func TestPruneTask(t *testing.T) {
 	}
 
 }
+
+func TestBuildConfigHistoryPruneTask(t *testing.T) {
+	now := util.Now()
+	older := util.NewTime(now.Time.Add(-time.Hour))
+	oldest := util.NewTime(now.Time.Add(-2 * time.Hour))
+
+	buildConfig := mockBuildConfig("a", "build-config")
+	otherBuildConfig := mockBuildConfig("a", "other-build-config")
+	builds := []*buildapi.Build{
+		withCreated(withStatus(mockBuild("a", "complete-1", buildConfig), buildapi.BuildPhaseComplete), now),
+		withCreated(withStatus(mockBuild("a", "complete-2", buildConfig), buildapi.BuildPhaseComplete), older),
+		withCreated(withStatus(mockBuild("a", "complete-3", buildConfig), buildapi.BuildPhaseComplete), oldest),
+		withCreated(withStatus(mockBuild("a", "failed-1", buildConfig), buildapi.BuildPhaseFailed), now),
+		withCreated(withStatus(mockBuild("a", "cancelled-1", buildConfig), buildapi.BuildPhaseCancelled), older),
+		withCreated(withStatus(mockBuild("a", "other-1", otherBuildConfig), buildapi.BuildPhaseComplete), oldest),
+		withCreated(withStatus(mockBuild("a", "orphan-1", nil), buildapi.BuildPhaseComplete), oldest),
+	}
+
+	tests := []struct {
+		successful, failed *int
+		expected           util.StringSet
+	}{
+		{
+			expected: util.NewStringSet(),
+		},
+		{
+			successful: intPtr(1),
+			expected:   util.NewStringSet("complete-2", "complete-3"),
+		},
+		{
+			failed:   intPtr(0),
+			expected: util.NewStringSet("failed-1", "cancelled-1"),
+		},
+		{
+			successful: intPtr(2),
+			failed:     intPtr(1),
+			expected:   util.NewStringSet("complete-3", "cancelled-1"),
+		},
+	}
+	for _, test := range tests {
+		buildConfig.Spec.SuccessfulBuildsHistoryLimit = test.successful
+		buildConfig.Spec.FailedBuildsHistoryLimit = test.failed
+		recorder := &mockPruneRecorder{set: util.StringSet{}}
+		task := NewBuildConfigHistoryPruneTasker(buildConfig, builds, recorder.Handler)
+		if err := task.PruneTask(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		recorder.Verify(t, test.expected)
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -63,8 +63,9 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request, ctx kap
 	}
 
 	request := &buildapi.BuildRequest{
-		ObjectMeta: kapi.ObjectMeta{Name: name},
-		Revision:   revision,
+		ObjectMeta:  kapi.ObjectMeta{Name: name},
+		Revision:    revision,
+		TriggeredBy: webhook.GenerateBuildTriggerInfo(revision, hookType),
 	}
 	if _, err := c.instantiator.Instantiate(config.Namespace, request); err != nil {
 		return errors.NewInternalError(fmt.Errorf("could not generate a build: %v", err))
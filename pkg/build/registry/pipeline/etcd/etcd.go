@@ -0,0 +1,49 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/registry/pipeline"
+)
+
+const PipelinePath = "/pipelines"
+
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// NewStorage returns a RESTStorage object that will work against pipelines.
+func NewStorage(s storage.Interface) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc:      func() runtime.Object { return &api.Pipeline{} },
+		NewListFunc:  func() runtime.Object { return &api.PipelineList{} },
+		EndpointName: "pipeline",
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, PipelinePath)
+		},
+		KeyFunc: func(ctx kapi.Context, id string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, PipelinePath, id)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.Pipeline).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return pipeline.Matcher(label, field)
+		},
+
+		CreateStrategy:      pipeline.Strategy,
+		UpdateStrategy:      pipeline.Strategy,
+		DeleteStrategy:      pipeline.Strategy,
+		ReturnDeletedObject: false,
+		Storage:             s,
+	}
+
+	return &REST{store}
+}
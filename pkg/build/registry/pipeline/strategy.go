@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/fielderrors"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/api/validation"
+)
+
+// strategy implements behavior for Pipeline objects
+type strategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Pipeline objects.
+var Strategy = strategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+func (strategy) NamespaceScoped() bool {
+	return true
+}
+
+// AllowCreateOnUpdate is false for Pipeline objects.
+func (strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// PrepareForCreate clears the status of a new pipeline, which is managed by the
+// pipeline controller.
+func (strategy) PrepareForCreate(obj runtime.Object) {
+	pipeline := obj.(*api.Pipeline)
+	pipeline.Status = api.PipelineStatus{Phase: api.PipelinePhaseNew}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (strategy) PrepareForUpdate(obj, old runtime.Object) {
+	_ = obj.(*api.Pipeline)
+}
+
+// Validate validates a new pipeline.
+func (strategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePipeline(obj.(*api.Pipeline))
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePipelineUpdate(obj.(*api.Pipeline), old.(*api.Pipeline))
+}
+
+// CheckGracefulDelete allows a pipeline to be gracefully deleted.
+func (strategy) CheckGracefulDelete(obj runtime.Object, options *kapi.DeleteOptions) bool {
+	return false
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			pipeline, ok := obj.(*api.Pipeline)
+			if !ok {
+				return nil, nil, fmt.Errorf("not a pipeline")
+			}
+			return labels.Set(pipeline.ObjectMeta.Labels), SelectableFields(pipeline), nil
+		},
+	}
+}
+
+// SelectableFields returns a label set that represents the object
+func SelectableFields(pipeline *api.Pipeline) fields.Set {
+	return fields.Set{
+		"metadata.name": pipeline.Name,
+		"status":        string(pipeline.Status.Phase),
+	}
+}
@@ -0,0 +1,54 @@
+package pipeline
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+func TestPipelineStrategy(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("Pipeline is namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("Pipeline should not allow create on update")
+	}
+	pipeline := &buildapi.Pipeline{
+		ObjectMeta: kapi.ObjectMeta{Name: "release", Namespace: "namespace"},
+		Spec: buildapi.PipelineSpec{
+			Stages: []buildapi.PipelineStage{
+				{
+					Name:  "build",
+					Type:  buildapi.PipelineStageBuild,
+					Build: &buildapi.BuildStage{BuildConfig: kapi.LocalObjectReference{Name: "frontend"}},
+				},
+			},
+		},
+		Status: buildapi.PipelineStatus{
+			Phase:  buildapi.PipelinePhaseComplete,
+			Stages: []buildapi.PipelineStageStatus{{Name: "build", Phase: buildapi.PipelineStagePhaseComplete}},
+		},
+	}
+	Strategy.PrepareForCreate(pipeline)
+	if pipeline.Status.Phase != buildapi.PipelinePhaseNew || len(pipeline.Status.Stages) != 0 {
+		t.Errorf("Expected the status of a new pipeline to be reset, got %#v", pipeline.Status)
+	}
+	errs := Strategy.Validate(ctx, pipeline)
+	if len(errs) != 0 {
+		t.Errorf("Unexpected error validating %v", errs)
+	}
+
+	pipeline.ResourceVersion = "foo"
+	errs = Strategy.ValidateUpdate(ctx, pipeline, pipeline)
+	if len(errs) != 0 {
+		t.Errorf("Unexpected error validating %v", errs)
+	}
+	invalidPipeline := &buildapi.Pipeline{}
+	errs = Strategy.Validate(ctx, invalidPipeline)
+	if len(errs) == 0 {
+		t.Errorf("Expected error validating")
+	}
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -14,6 +15,9 @@ const (
 	BuildPodSuffix = "build"
 	// NoBuildLogsMessage reports that no build logs are available
 	NoBuildLogsMessage = "No logs are available."
+	// DeadlineExceededMessage reports that a build was terminated because it
+	// was active for longer than its completion deadline
+	DeadlineExceededMessage = "Build was terminated after exceeding its completion deadline."
 )
 
 // GetBuildPodName returns name of the build pod.
@@ -75,3 +79,35 @@ func GetBuildLabel(pod *kapi.Pod) (string, bool) {
 	}
 	return value, exists
 }
+
+// builderStatusReasons are the reasons of a build failure a builder reports in
+// its termination message.
+var builderStatusReasons = map[buildapi.StatusReason]bool{
+	buildapi.StatusReasonFetchSourceFailed:      true,
+	buildapi.StatusReasonPullBuilderImageFailed: true,
+	buildapi.StatusReasonAssembleFailed:         true,
+	buildapi.StatusReasonPostCommitHookFailed:   true,
+	buildapi.StatusReasonPushImageFailed:        true,
+	buildapi.StatusReasonGenericBuildFailed:     true,
+}
+
+// FormatTerminationMessage returns the termination message a builder writes
+// when a build fails, so the build controller can report the reason of the
+// failure in the status of the build.
+func FormatTerminationMessage(reason buildapi.StatusReason, message string) string {
+	return fmt.Sprintf("%s: %s", reason, message)
+}
+
+// ParseTerminationMessage returns the reason and the message of a build
+// failure from the termination message of the build container. Messages that
+// were not written by FormatTerminationMessage, including messages that merely
+// start with a word followed by a colon, are reported with the
+// GenericBuildFailed reason.
+func ParseTerminationMessage(terminationMessage string) (buildapi.StatusReason, string) {
+	terminationMessage = strings.TrimSpace(terminationMessage)
+	parts := strings.SplitN(terminationMessage, ": ", 2)
+	if len(parts) != 2 || !builderStatusReasons[buildapi.StatusReason(parts[0])] {
+		return buildapi.StatusReasonGenericBuildFailed, terminationMessage
+	}
+	return buildapi.StatusReason(parts[0]), parts[1]
+}
@@ -50,3 +50,43 @@ func TestGetBuildLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestParseTerminationMessage(t *testing.T) {
+	tests := []struct {
+		terminationMessage string
+		expectedReason     buildapi.StatusReason
+		expectedMessage    string
+	}{
+		{
+			terminationMessage: FormatTerminationMessage(buildapi.StatusReasonPushImageFailed, "Failed to push image: denied"),
+			expectedReason:     buildapi.StatusReasonPushImageFailed,
+			expectedMessage:    "Failed to push image: denied",
+		},
+		{
+			terminationMessage: "Failed to push image: denied\n",
+			expectedReason:     buildapi.StatusReasonGenericBuildFailed,
+			expectedMessage:    "Failed to push image: denied",
+		},
+		{
+			terminationMessage: "Error: unable to access the repository",
+			expectedReason:     buildapi.StatusReasonGenericBuildFailed,
+			expectedMessage:    "Error: unable to access the repository",
+		},
+		{
+			terminationMessage: "Timeout: the registry did not respond",
+			expectedReason:     buildapi.StatusReasonGenericBuildFailed,
+			expectedMessage:    "Timeout: the registry did not respond",
+		},
+		{
+			terminationMessage: "",
+			expectedReason:     buildapi.StatusReasonGenericBuildFailed,
+			expectedMessage:    "",
+		},
+	}
+	for i, test := range tests {
+		reason, message := ParseTerminationMessage(test.terminationMessage)
+		if reason != test.expectedReason || message != test.expectedMessage {
+			t.Errorf("(%d) expected %s %q, got %s %q", i, test.expectedReason, test.expectedMessage, reason, message)
+		}
+	}
+}
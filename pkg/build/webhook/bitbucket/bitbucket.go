@@ -0,0 +1,165 @@
+package bitbucket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+const (
+	pushEventName   = "repo:push"
+	signaturePrefix = "sha256="
+)
+
+// WebHook used for processing bitbucket webhook requests.
+type WebHook struct{}
+
+// New returns bitbucket webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type author struct {
+	// Raw holds the author in the "Name <email>" form
+	Raw string `json:"raw,omitempty"`
+}
+
+type target struct {
+	Hash    string `json:"hash,omitempty"`
+	Author  author `json:"author,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type reference struct {
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Target target `json:"target,omitempty"`
+}
+
+type change struct {
+	// New is nil when the branch was deleted
+	New *reference `json:"new,omitempty"`
+}
+
+type pushEvent struct {
+	Push struct {
+		Changes []change `json:"changes,omitempty"`
+	} `json:"push,omitempty"`
+}
+
+// Extract services webhooks from bitbucket.org
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.BitbucketWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if trigger.BitbucketWebHook.Secret != secret {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get("X-Event-Key"); event != pushEventName {
+		err = fmt.Errorf("Unknown X-Event-Key %s", event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	if signature := req.Header.Get("X-Hub-Signature"); len(signature) > 0 && !validSignature(signature, trigger.BitbucketWebHook.Secret, body) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	git := buildCfg.Spec.Source.Git
+	if git == nil {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s. No git source is configured", buildCfg.Namespace, buildCfg.Name)
+		return
+	}
+	// a single push may update several branches and tags, build the first
+	// one that matches
+	for _, c := range event.Push.Changes {
+		if c.New == nil {
+			continue
+		}
+		var eventRef string
+		switch c.New.Type {
+		case "branch":
+			eventRef = "refs/heads/" + c.New.Name
+		case "tag":
+			eventRef = "refs/tags/" + c.New.Name
+		default:
+			continue
+		}
+		revision = &api.SourceRevision{
+			Type: api.BuildSourceGit,
+			Git: &api.GitSourceRevision{
+				Commit:  c.New.Target.Hash,
+				Author:  parseAuthor(c.New.Target.Author.Raw),
+				Message: c.New.Target.Message,
+			},
+		}
+		if revision, proceed = webhook.MatchPushedRef(trigger.BitbucketWebHook, git, eventRef, revision); proceed {
+			return
+		}
+	}
+	glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No pushed branch matches the configuration", buildCfg.Namespace, buildCfg.Name)
+
+	return
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("Unsupported HTTP method %s", method)
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "application/json" {
+		return fmt.Errorf("Unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Event-Key")) == 0 {
+		return errors.New("Missing X-Event-Key")
+	}
+	return nil
+}
+
+// validSignature checks the HMAC-SHA256 signature of the request body sent in
+// the "sha256=<hex digest>" form.
+func validSignature(signature, secret string, body []byte) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// parseAuthor converts the raw "Name <email>" author of a commit.
+func parseAuthor(raw string) api.SourceControlUser {
+	address, err := mail.ParseAddress(raw)
+	if err != nil {
+		return api.SourceControlUser{Name: raw}
+	}
+	return api.SourceControlUser{Name: address.Name, Email: address.Address}
+}
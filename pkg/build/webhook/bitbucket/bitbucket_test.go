@@ -0,0 +1,215 @@
+package bitbucket
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.BitbucketWebHookBuildTriggerType,
+					BitbucketWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Type: api.BuildSourceGit,
+					Git: &api.GitBuildSource{
+						URI: "git://bitbucket.org/my/repo.git",
+					},
+				},
+				Strategy: mockBuildStrategy,
+			},
+		},
+	}
+}
+
+var mockBuildStrategy = api.BuildStrategy{
+	Type: "STI",
+	SourceStrategy: &api.SourceBuildStrategy{
+		From: kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/image",
+		},
+	},
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func newServer() *httptest.Server {
+	return httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/bitbucket", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongMethod(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	resp, _ := http.Get(server.URL + "/build100/secret101/bitbucket")
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "method") {
+		t.Errorf("Expected BadRequest , got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "Missing X-Event-Key") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongBitbucketEvent(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", nil)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", "repo:fork")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "Unknown X-Event-Key") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestJsonPushEventSignature(t *testing.T) {
+	data, err := ioutil.ReadFile("fixtures/pushevent.json")
+	if err != nil {
+		t.Fatalf("Failed to open pushevent.json: %v", err)
+	}
+	tests := []struct {
+		signature string
+		expected  int
+	}{
+		{signature: "", expected: http.StatusOK},
+		{signature: sign("secret101", data), expected: http.StatusOK},
+		{signature: sign("wrongsecret", data), expected: http.StatusBadRequest},
+		{signature: "sha1=0123", expected: http.StatusBadRequest},
+	}
+
+	server := newServer()
+	defer server.Close()
+
+	for _, test := range tests {
+		req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", bytes.NewReader(data))
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("X-Event-Key", pushEventName)
+		if len(test.signature) > 0 {
+			req.Header.Add("X-Hub-Signature", test.signature)
+		}
+		resp, err := (&http.Client{}).Do(req)
+		if err != nil {
+			t.Fatalf("Failed posting webhook: %v", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != test.expected {
+			t.Errorf("%q: wrong response code, expecting %d, got %s: %s!", test.signature, test.expected, resp.Status, string(body))
+		}
+	}
+}
+
+func setup(t *testing.T, filename string) (*api.BuildConfig, *http.Request) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", pushEventName)
+	return mockBuildConfig(), req
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "9bdc3a26ff933b32f3e558636b58aea86a69f051" {
+		t.Errorf("Expecting the revision to contain the commit id of the pushed branch, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Author.Name != "Anonymous User" || revision.Git.Author.Email != "anonUser@example.com" {
+		t.Errorf("Expecting the revision to contain the parsed author, got %#v", revision.Git.Author)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	for _, ref := range []string{"adfj32qrafdavckeaewra", "v1.0", "removed_branch"} {
+		buildCfg, req := setup(t, "pushevent.json")
+		buildCfg.Spec.Source.Git.Ref = ref
+
+		_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", ref, err)
+		}
+		if proceed {
+			t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", ref)
+		}
+	}
+}
@@ -81,8 +81,9 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 	request := &buildapi.BuildRequest{
-		ObjectMeta: kapi.ObjectMeta{Name: buildCfg.Name},
-		Revision:   revision,
+		ObjectMeta:  kapi.ObjectMeta{Name: buildCfg.Name},
+		Revision:    revision,
+		TriggeredBy: GenerateBuildTriggerInfo(revision, uv.plugin),
 	}
 	if _, err := c.buildConfigInstantiator.Instantiate(uv.namespace, request); err != nil {
 		glog.V(2).Infof("Failed to generate new Build from BuildConfig %s/%s: %v", buildCfg.Namespace, buildCfg.Name, err)
@@ -62,26 +62,27 @@ func (p *WebHookPlugin) Extract(buildCfg *api.BuildConfig, secret, path string,
 		}
 
 		if data.Git.Refs != nil {
-			for _, ref := range data.Git.Refs {
-				if webhook.GitRefMatches(ref.Ref, git.Ref) {
-					revision = &api.SourceRevision{
-						Type: api.BuildSourceGit,
-						Git:  &ref.GitSourceRevision,
-					}
+			for i := range data.Git.Refs {
+				ref := &data.Git.Refs[i]
+				revision = &api.SourceRevision{
+					Type: api.BuildSourceGit,
+					Git:  &ref.GitSourceRevision,
+				}
+				if revision, proceed = webhook.MatchPushedRef(trigger.GenericWebHook, git, ref.Ref, revision); proceed {
 					return revision, true, nil
 				}
 			}
-			glog.V(2).Infof("Skipping build for BuildConfig %s/%s. None of the supplied refs matched %q", buildCfg.Namespace, buildCfg, git.Ref)
-			return nil, false, nil
-		}
-		if !webhook.GitRefMatches(data.Git.Ref, git.Ref) {
-			glog.V(2).Infof("Skipping build for BuildConfig %s/%s. Branch reference from %q does not match configuration", buildCfg.Namespace, buildCfg.Name, data.Git.Ref)
+			glog.V(2).Infof("Skipping build for BuildConfig %s/%s. None of the supplied refs matched %q", buildCfg.Namespace, buildCfg.Name, git.Ref)
 			return nil, false, nil
 		}
 		revision = &api.SourceRevision{
 			Type: api.BuildSourceGit,
 			Git:  &data.Git.GitSourceRevision,
 		}
+		if revision, proceed = webhook.MatchPushedRef(trigger.GenericWebHook, git, data.Git.Ref, revision); !proceed {
+			glog.V(2).Infof("Skipping build for BuildConfig %s/%s. Branch reference from %q does not match configuration", buildCfg.Namespace, buildCfg.Name, data.Git.Ref)
+			return nil, false, nil
+		}
 	}
 	return revision, true, nil
 }
@@ -66,11 +66,11 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 	if err = json.Unmarshal(body, &event); err != nil {
 		return
 	}
-	proceed = webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
-	if !proceed {
-		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg, event)
+	git := buildCfg.Spec.Source.Git
+	if git == nil {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s. No git source is configured", buildCfg.Namespace, buildCfg.Name)
+		return
 	}
-
 	revision = &api.SourceRevision{
 		Type: api.BuildSourceGit,
 		Git: &api.GitSourceRevision{
@@ -80,6 +80,10 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 			Message:   event.HeadCommit.Message,
 		},
 	}
+	revision, proceed = webhook.MatchPushedRef(trigger.GitHubWebHook, git, event.Ref, revision)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
 
 	return
 }
@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+const (
+	pushEventName    = "Push Hook"
+	tagPushEventName = "Tag Push Hook"
+)
+
+// WebHook used for processing gitlab webhook requests.
+type WebHook struct{}
+
+// New returns gitlab webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type commit struct {
+	ID      string                `json:"id,omitempty"`
+	Author  api.SourceControlUser `json:"author,omitempty"`
+	Message string                `json:"message,omitempty"`
+}
+
+type pushEvent struct {
+	Ref         string   `json:"ref,omitempty"`
+	After       string   `json:"after,omitempty"`
+	CheckoutSHA string   `json:"checkout_sha,omitempty"`
+	Commits     []commit `json:"commits,omitempty"`
+}
+
+// headCommit returns the commit the pushed ref points to. GitLab lists the
+// pushed commits oldest first and omits them for large pushes, in which case
+// only the commit id is known.
+func (e *pushEvent) headCommit() commit {
+	id := e.CheckoutSHA
+	if len(id) == 0 {
+		id = e.After
+	}
+	for _, c := range e.Commits {
+		if c.ID == id {
+			return c
+		}
+	}
+	return commit{ID: id}
+}
+
+// Extract services webhooks from gitlab.com
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GitLabWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if trigger.GitLabWebHook.Secret != secret {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	// GitLab sends the secret token configured on the hook in a header
+	if token := req.Header.Get("X-Gitlab-Token"); len(token) > 0 && token != trigger.GitLabWebHook.Secret {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get("X-Gitlab-Event"); event != pushEventName && event != tagPushEventName {
+		err = fmt.Errorf("Unknown X-Gitlab-Event %s", event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	git := buildCfg.Spec.Source.Git
+	if git == nil {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s. No git source is configured", buildCfg.Namespace, buildCfg.Name)
+		return
+	}
+	head := event.headCommit()
+	revision = &api.SourceRevision{
+		Type: api.BuildSourceGit,
+		Git: &api.GitSourceRevision{
+			Commit:  head.ID,
+			Author:  head.Author,
+			Message: head.Message,
+		},
+	}
+	revision, proceed = webhook.MatchPushedRef(trigger.GitLabWebHook, git, event.Ref, revision)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+
+	return
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("Unsupported HTTP method %s", method)
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "application/json" {
+		return fmt.Errorf("Unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Gitlab-Event")) == 0 {
+		return errors.New("Missing X-Gitlab-Event")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/openshift/origin/pkg/build/api"
@@ -23,6 +24,61 @@ func GitRefMatches(eventRef, configRef string) bool {
 	return configRef == eventRef
 }
 
+// shortRefName strips the branch and tag prefixes from a git ref.
+func shortRefName(ref string) string {
+	for _, prefix := range []string{"refs/heads/", "refs/tags/"} {
+		if strings.HasPrefix(ref, prefix) {
+			return strings.TrimPrefix(ref, prefix)
+		}
+	}
+	return ref
+}
+
+// refMatchesPatterns determines if a git ref matches any of the glob patterns,
+// either by its short name or by its full name.
+func refMatchesPatterns(ref string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, shortRefName(ref)); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, ref); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchPushedRef determines if a push of eventRef triggers a build through the
+// webhook trigger and returns the revision to build. Without ref patterns on
+// the trigger only pushes to the ref of the git source match. A matching push
+// to another ref builds the pushed ref if the trigger asks for it, recording
+// it on the revision, and the latest commit of the configured ref otherwise,
+// in which case no revision is returned.
+func MatchPushedRef(trigger *api.WebHookTrigger, git *api.GitBuildSource, eventRef string, revision *api.SourceRevision) (*api.SourceRevision, bool) {
+	configured := GitRefMatches(eventRef, git.Ref)
+	if len(trigger.Refs) > 0 {
+		if !refMatchesPatterns(eventRef, trigger.Refs) {
+			return nil, false
+		}
+	} else if !configured {
+		return nil, false
+	}
+	if configured {
+		return revision, true
+	}
+	if !trigger.BuildPushedRef {
+		return nil, true
+	}
+	if revision == nil {
+		revision = &api.SourceRevision{Type: api.BuildSourceGit}
+	}
+	if revision.Git == nil {
+		revision.Git = &api.GitSourceRevision{}
+	}
+	revision.Git.PushedRef = shortRefName(eventRef)
+	return revision, true
+}
+
 // FindTriggerPolicy retrieves the BuildTrigger of a given type from a build configuration
 func FindTriggerPolicy(triggerType api.BuildTriggerType, config *api.BuildConfig) (*api.BuildTriggerPolicy, bool) {
 	for _, p := range config.Spec.Triggers {
@@ -32,3 +88,32 @@ func FindTriggerPolicy(triggerType api.BuildTriggerType, config *api.BuildConfig
 	}
 	return nil, false
 }
+
+// GenerateBuildTriggerInfo returns the causes to record on a Build started by
+// the webhook of the given type with the given source revision.
+func GenerateBuildTriggerInfo(revision *api.SourceRevision, hookType string) []api.BuildTriggerCause {
+	switch hookType {
+	case "github":
+		return []api.BuildTriggerCause{{
+			Message:       api.BuildTriggerCauseGithubMsg,
+			GitHubWebHook: &api.GitHubWebHookCause{Revision: revision},
+		}}
+	case "generic":
+		return []api.BuildTriggerCause{{
+			Message:        api.BuildTriggerCauseGenericMsg,
+			GenericWebHook: &api.GenericWebHookCause{Revision: revision},
+		}}
+	case "gitlab":
+		return []api.BuildTriggerCause{{
+			Message:       api.BuildTriggerCauseGitLabMsg,
+			GitLabWebHook: &api.GitLabWebHookCause{Revision: revision},
+		}}
+	case "bitbucket":
+		return []api.BuildTriggerCause{{
+			Message:          api.BuildTriggerCauseBitbucketMsg,
+			BitbucketWebHook: &api.BitbucketWebHookCause{Revision: revision},
+		}}
+	default:
+		return []api.BuildTriggerCause{{Message: fmt.Sprintf("%s webhook", hookType)}}
+	}
+}
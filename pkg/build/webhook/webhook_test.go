@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestGenerateBuildTriggerInfo(t *testing.T) {
+	revision := &api.SourceRevision{Git: &api.GitSourceRevision{Commit: "1234"}}
+
+	causes := GenerateBuildTriggerInfo(revision, "github")
+	if len(causes) != 1 || causes[0].Message != api.BuildTriggerCauseGithubMsg || causes[0].GitHubWebHook == nil || causes[0].GitHubWebHook.Revision != revision {
+		t.Errorf("Unexpected GitHub webhook causes %#v", causes)
+	}
+	causes = GenerateBuildTriggerInfo(revision, "generic")
+	if len(causes) != 1 || causes[0].Message != api.BuildTriggerCauseGenericMsg || causes[0].GenericWebHook == nil || causes[0].GenericWebHook.Revision != revision {
+		t.Errorf("Unexpected generic webhook causes %#v", causes)
+	}
+	causes = GenerateBuildTriggerInfo(revision, "gitlab")
+	if len(causes) != 1 || causes[0].Message != api.BuildTriggerCauseGitLabMsg || causes[0].GitLabWebHook == nil || causes[0].GitLabWebHook.Revision != revision {
+		t.Errorf("Unexpected GitLab webhook causes %#v", causes)
+	}
+	causes = GenerateBuildTriggerInfo(revision, "bitbucket")
+	if len(causes) != 1 || causes[0].Message != api.BuildTriggerCauseBitbucketMsg || causes[0].BitbucketWebHook == nil || causes[0].BitbucketWebHook.Revision != revision {
+		t.Errorf("Unexpected Bitbucket webhook causes %#v", causes)
+	}
+	causes = GenerateBuildTriggerInfo(nil, "other")
+	if len(causes) != 1 || causes[0].GitHubWebHook != nil || causes[0].GenericWebHook != nil {
+		t.Errorf("Unexpected causes %#v", causes)
+	}
+}
+
+func TestMatchPushedRef(t *testing.T) {
+	tests := []struct {
+		name      string
+		trigger   api.WebHookTrigger
+		configRef string
+		eventRef  string
+		proceed   bool
+		revision  bool
+		pushedRef string
+	}{
+		{
+			name:     "push to the default ref",
+			eventRef: "refs/heads/master",
+			proceed:  true,
+			revision: true,
+		},
+		{
+			name:     "push to another branch",
+			eventRef: "refs/heads/feature/x",
+		},
+		{
+			name:      "push to the configured ref matching no pattern",
+			trigger:   api.WebHookTrigger{Refs: []string{"feature/*"}},
+			configRef: "master",
+			eventRef:  "refs/heads/master",
+		},
+		{
+			name:     "pattern match builds the configured ref",
+			trigger:  api.WebHookTrigger{Refs: []string{"master", "feature/*"}},
+			eventRef: "refs/heads/feature/x",
+			proceed:  true,
+		},
+		{
+			name:      "pattern match builds the pushed branch",
+			trigger:   api.WebHookTrigger{Refs: []string{"feature/*"}, BuildPushedRef: true},
+			eventRef:  "refs/heads/feature/x",
+			proceed:   true,
+			revision:  true,
+			pushedRef: "feature/x",
+		},
+		{
+			name:      "pattern match builds the pushed tag",
+			trigger:   api.WebHookTrigger{Refs: []string{"refs/tags/v*"}, BuildPushedRef: true},
+			eventRef:  "refs/tags/v1.0",
+			proceed:   true,
+			revision:  true,
+			pushedRef: "v1.0",
+		},
+		{
+			name:     "pattern does not cross path separators",
+			trigger:  api.WebHookTrigger{Refs: []string{"feature/*"}, BuildPushedRef: true},
+			eventRef: "refs/heads/feature/x/y",
+		},
+		{
+			name:      "pushed ref equal to the configured ref",
+			trigger:   api.WebHookTrigger{Refs: []string{"*"}, BuildPushedRef: true},
+			configRef: "dev",
+			eventRef:  "refs/heads/dev",
+			proceed:   true,
+			revision:  true,
+		},
+	}
+
+	for _, test := range tests {
+		revision := &api.SourceRevision{Type: api.BuildSourceGit, Git: &api.GitSourceRevision{Commit: "1234"}}
+		git := &api.GitBuildSource{URI: "git://example.com/repo.git", Ref: test.configRef}
+		result, proceed := MatchPushedRef(&test.trigger, git, test.eventRef, revision)
+		if proceed != test.proceed {
+			t.Errorf("%s: expected proceed %v, got %v", test.name, test.proceed, proceed)
+		}
+		if (result != nil) != test.revision {
+			t.Errorf("%s: expected a revision %v, got %#v", test.name, test.revision, result)
+			continue
+		}
+		if result != nil && result.Git.PushedRef != test.pushedRef {
+			t.Errorf("%s: expected pushed ref %q, got %q", test.name, test.pushedRef, result.Git.PushedRef)
+		}
+	}
+}
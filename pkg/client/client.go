@@ -18,12 +18,14 @@ type Interface interface {
 	BuildsNamespacer
 	BuildConfigsNamespacer
 	BuildLogsNamespacer
+	PipelinesNamespacer
 	ImagesInterfacer
 	ImageStreamsNamespacer
 	ImageStreamMappingsNamespacer
 	ImageStreamTagsNamespacer
 	ImageStreamImagesNamespacer
 	DeploymentConfigsNamespacer
+	DeploymentLogsNamespacer
 	RoutesNamespacer
 	HostSubnetsInterface
 	NetNamespacesInterface
@@ -67,6 +69,11 @@ func (c *Client) BuildLogs(namespace string) BuildLogsInterface {
 	return newBuildLogs(c, namespace)
 }
 
+// Pipelines provides a REST client for Pipelines
+func (c *Client) Pipelines(namespace string) PipelineInterface {
+	return newPipelines(c, namespace)
+}
+
 // Images provides a REST client for Images
 func (c *Client) Images() ImageInterface {
 	return newImages(c)
@@ -97,6 +104,11 @@ func (c *Client) DeploymentConfigs(namespace string) DeploymentConfigInterface {
 	return newDeploymentConfigs(c, namespace)
 }
 
+// DeploymentLogs provides a REST client for DeploymentLogs
+func (c *Client) DeploymentLogs(namespace string) DeploymentLogsInterface {
+	return newDeploymentLogs(c, namespace)
+}
+
 // Routes provides a REST client for Route
 func (c *Client) Routes(namespace string) RouteInterface {
 	return newRoutes(c, namespace)
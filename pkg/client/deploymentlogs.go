@@ -0,0 +1,48 @@
+package client
+
+import (
+	"strconv"
+
+	kclient "k8s.io/kubernetes/pkg/client"
+
+	"github.com/openshift/origin/pkg/deploy/api"
+)
+
+// DeploymentLogsNamespacer has methods to work with DeploymentLogs resources in a namespace
+type DeploymentLogsNamespacer interface {
+	DeploymentLogs(namespace string) DeploymentLogsInterface
+}
+
+// DeploymentLogsInterface exposes methods on DeploymentLogs resources.
+type DeploymentLogsInterface interface {
+	Get(name string, opts api.DeploymentLogOptions) *kclient.Request
+}
+
+// deploymentLogs implements DeploymentLogsNamespacer interface
+type deploymentLogs struct {
+	r  *Client
+	ns string
+}
+
+// newDeploymentLogs returns a deploymentLogs
+func newDeploymentLogs(c *Client, namespace string) *deploymentLogs {
+	return &deploymentLogs{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Get builds and returns a deploymentLog request
+func (c *deploymentLogs) Get(name string, opt api.DeploymentLogOptions) *kclient.Request {
+	req := c.r.Get().Namespace(c.ns).Resource("deploymentConfigs").Name(name).SubResource("log")
+	if opt.NoWait {
+		req.Param("nowait", "true")
+	}
+	if opt.Follow {
+		req.Param("follow", "true")
+	}
+	if opt.Version > 0 {
+		req.Param("version", strconv.Itoa(opt.Version))
+	}
+	return req
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// PipelinesNamespacer has methods to work with Pipeline resources in a namespace
+type PipelinesNamespacer interface {
+	Pipelines(namespace string) PipelineInterface
+}
+
+// PipelineInterface exposes methods on Pipeline resources.
+type PipelineInterface interface {
+	List(label labels.Selector, field fields.Selector) (*buildapi.PipelineList, error)
+	Get(name string) (*buildapi.Pipeline, error)
+	Create(pipeline *buildapi.Pipeline) (*buildapi.Pipeline, error)
+	Update(pipeline *buildapi.Pipeline) (*buildapi.Pipeline, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// pipelines implements PipelinesNamespacer interface
+type pipelines struct {
+	r  *Client
+	ns string
+}
+
+// newPipelines returns a pipelines
+func newPipelines(c *Client, namespace string) *pipelines {
+	return &pipelines{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List returns a list of pipelines that match the label and field selectors.
+func (c *pipelines) List(label labels.Selector, field fields.Selector) (result *buildapi.PipelineList, err error) {
+	result = &buildapi.PipelineList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("pipelines").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get returns information about a particular pipeline and error if one occurs.
+func (c *pipelines) Get(name string) (result *buildapi.Pipeline, err error) {
+	result = &buildapi.Pipeline{}
+	err = c.r.Get().Namespace(c.ns).Resource("pipelines").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates new pipeline. Returns the server's representation of the pipeline and error if one occurs.
+func (c *pipelines) Create(pipeline *buildapi.Pipeline) (result *buildapi.Pipeline, err error) {
+	result = &buildapi.Pipeline{}
+	err = c.r.Post().Namespace(c.ns).Resource("pipelines").Body(pipeline).Do().Into(result)
+	return
+}
+
+// Update updates the pipeline on server. Returns the server's representation of the pipeline and error if one occurs.
+func (c *pipelines) Update(pipeline *buildapi.Pipeline) (result *buildapi.Pipeline, err error) {
+	result = &buildapi.Pipeline{}
+	err = c.r.Put().Namespace(c.ns).Resource("pipelines").Name(pipeline.Name).Body(pipeline).Do().Into(result)
+	return
+}
+
+// Delete deletes a pipeline, returns error if one occurs.
+func (c *pipelines) Delete(name string) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("pipelines").Name(name).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested pipelines
+func (c *pipelines) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("pipelines").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
@@ -101,6 +101,11 @@ func (c *Fake) BuildLogs(namespace string) client.BuildLogsInterface {
 	return &FakeBuildLogs{Fake: c, Namespace: namespace}
 }
 
+// Pipelines provides a fake REST client for Pipelines
+func (c *Fake) Pipelines(namespace string) client.PipelineInterface {
+	return &FakePipelines{Fake: c, Namespace: namespace}
+}
+
 // Images provides a fake REST client for Images
 func (c *Fake) Images() client.ImageInterface {
 	return &FakeImages{Fake: c}
@@ -131,6 +136,11 @@ func (c *Fake) DeploymentConfigs(namespace string) client.DeploymentConfigInterf
 	return &FakeDeploymentConfigs{Fake: c, Namespace: namespace}
 }
 
+// DeploymentLogs provides a fake REST client for DeploymentLogs
+func (c *Fake) DeploymentLogs(namespace string) client.DeploymentLogsInterface {
+	return &FakeDeploymentLogs{Fake: c, Namespace: namespace}
+}
+
 // Routes provides a fake REST client for Routes
 func (c *Fake) Routes(namespace string) client.RouteInterface {
 	return &FakeRoutes{Fake: c, Namespace: namespace}
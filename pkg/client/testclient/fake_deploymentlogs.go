@@ -0,0 +1,28 @@
+package testclient
+
+import (
+	kclient "k8s.io/kubernetes/pkg/client"
+
+	ktestclient "k8s.io/kubernetes/pkg/client/testclient"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+// FakeDeploymentLogs implements DeploymentLogsInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeDeploymentLogs struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeDeploymentLogs) Get(name string, opt deployapi.DeploymentLogOptions) *kclient.Request {
+	action := ktestclient.GenericActionImpl{}
+	action.Verb = "get"
+	action.Namespace = c.Namespace
+	action.Resource = "deploymentconfigs"
+	action.Subresource = "log"
+	action.Value = opt
+
+	_, _ = c.Fake.Invokes(action, &deployapi.DeploymentConfig{})
+	return &kclient.Request{}
+}
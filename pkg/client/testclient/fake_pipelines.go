@@ -0,0 +1,63 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// FakePipelines implements PipelineInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakePipelines struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakePipelines) Get(name string) (*buildapi.Pipeline, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("pipelines", c.Namespace, name), &buildapi.Pipeline{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*buildapi.Pipeline), err
+}
+
+func (c *FakePipelines) List(label labels.Selector, field fields.Selector) (*buildapi.PipelineList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("pipelines", c.Namespace, label, field), &buildapi.PipelineList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*buildapi.PipelineList), err
+}
+
+func (c *FakePipelines) Create(inObj *buildapi.Pipeline) (*buildapi.Pipeline, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("pipelines", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*buildapi.Pipeline), err
+}
+
+func (c *FakePipelines) Update(inObj *buildapi.Pipeline) (*buildapi.Pipeline, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("pipelines", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*buildapi.Pipeline), err
+}
+
+func (c *FakePipelines) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("pipelines", c.Namespace, name), &buildapi.Pipeline{})
+	return err
+}
+
+func (c *FakePipelines) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Invokes(ktestclient.NewWatchAction("pipelines", c.Namespace, label, field, resourceVersion), nil)
+	return c.Fake.Watch, nil
+}
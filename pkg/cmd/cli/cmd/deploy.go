@@ -34,12 +34,15 @@ type DeployOptions struct {
 	deployLatest         bool
 	retryDeploy          bool
 	cancelDeploy         bool
+	approveStep          bool
 	enableTriggers       bool
+	pause                bool
+	resume               bool
 }
 
 const (
 	deployLong = `
-View, start, cancel, or retry a deployment
+View, start, cancel, retry, approve, pause or resume a deployment
 
 This command allows you to control a deployment config. Each individual deployment is exposed
 as a new replication controller, and the deployment process manages scaling down old deployments
@@ -54,8 +57,18 @@ There are several deployment strategies defined:
   of code running at the same time (many web applications, scalable databases)
 * Recreate - scales the old deployment down to zero, then scales the new deployment up to full.
   Use when your application cannot tolerate two versions of code running at the same time
+* BlueGreen - scales up the new deployment next to the old one, and switches a service over to
+  it once it is ready and verified.
+* Canary - shifts replicas from the old deployment to the new one in steps, pausing after each
+  step or waiting for it to be approved with the '--approve' flag. If the new pods crash loop,
+  the old deployment is scaled back up.
 * Custom - run your own deployment process inside a Docker container using your own scripts.
 
+While you make several changes to a deployment config, you can pause it with the '--pause' flag so
+that its triggers don't start new deployments. Once resumed with '--resume', all the changes are
+rolled out in a single deployment, including the images pushed for its automatic image triggers
+while it was paused.
+
 If a deployment fails, you may opt to retry it (if the error was transient). Some deployments may
 never successfully complete - in which case you can use the '--latest' flag to force a redeployment.
 When rolling back to a previous deployment, a new deployment will be created with an identical copy
@@ -74,7 +87,14 @@ If no options are given, shows information about the latest deployment.`
   $ %[1]s deploy frontend --retry
 
   // Cancel the in-progress deployment based on 'frontend'
-  $ %[1]s deploy frontend --cancel`
+  $ %[1]s deploy frontend --cancel
+
+  // Approve the current step of the in-progress canary deployment based on 'frontend'
+  $ %[1]s deploy frontend --approve
+
+  // Pause the triggers of 'frontend', and resume them after making several changes
+  $ %[1]s deploy frontend --pause
+  $ %[1]s deploy frontend --resume`
 )
 
 // NewCmdDeploy creates a new `deploy` command.
@@ -85,7 +105,7 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 
 	cmd := &cobra.Command{
 		Use:     "deploy DEPLOYMENTCONFIG",
-		Short:   "View, start, cancel, or retry a deployment",
+		Short:   "View, start, cancel, retry, approve, pause or resume a deployment",
 		Long:    deployLong,
 		Example: fmt.Sprintf(deployExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -106,7 +126,10 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	cmd.Flags().BoolVar(&options.deployLatest, "latest", false, "Start a new deployment now.")
 	cmd.Flags().BoolVar(&options.retryDeploy, "retry", false, "Retry the latest failed deployment.")
 	cmd.Flags().BoolVar(&options.cancelDeploy, "cancel", false, "Cancel the in-progress deployment.")
+	cmd.Flags().BoolVar(&options.approveStep, "approve", false, "Approve the current step of the in-progress canary deployment.")
 	cmd.Flags().BoolVar(&options.enableTriggers, "enable-triggers", false, "Enables all image triggers for the deployment config.")
+	cmd.Flags().BoolVar(&options.pause, "pause", false, "Stop the triggers of the deployment config from starting new deployments.")
+	cmd.Flags().BoolVar(&options.resume, "resume", false, "Let the triggers of a paused deployment config start new deployments again.")
 
 	return cmd
 }
@@ -149,11 +172,20 @@ func (o DeployOptions) Validate(args []string) error {
 	if o.cancelDeploy {
 		numOptions++
 	}
+	if o.approveStep {
+		numOptions++
+	}
 	if o.enableTriggers {
 		numOptions++
 	}
+	if o.pause {
+		numOptions++
+	}
+	if o.resume {
+		numOptions++
+	}
 	if numOptions > 1 {
-		return errors.New("only one of --latest, --retry, --cancel, or --enable-triggers is allowed.")
+		return errors.New("only one of --latest, --retry, --cancel, --approve, --enable-triggers, --pause, or --resume is allowed.")
 	}
 	return nil
 }
@@ -205,6 +237,9 @@ func (o DeployOptions) RunDeploy() error {
 	case o.cancelDeploy:
 		c := &cancelDeploymentCommand{client: commandClient}
 		err = c.cancel(config, o.out)
+	case o.approveStep:
+		c := &approveStepCommand{client: commandClient}
+		err = c.approve(config, o.out)
 	case o.enableTriggers:
 		t := &triggerEnabler{
 			updateConfig: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
@@ -212,6 +247,16 @@ func (o DeployOptions) RunDeploy() error {
 			},
 		}
 		err = t.enableTriggers(config, o.out)
+	case o.pause, o.resume:
+		p := &pauseSetter{
+			updateConfig: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				return o.osClient.DeploymentConfigs(namespace).Update(config)
+			},
+			generateConfig: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return o.osClient.DeploymentConfigs(namespace).Generate(name)
+			},
+		}
+		err = p.setPaused(config, o.pause, o.out)
 	default:
 		describer := describe.NewLatestDeploymentsDescriber(o.osClient, o.kubeClient, -1)
 		desc, err := describer.Describe(config.Namespace, config.Name)
@@ -370,6 +415,46 @@ func (c *cancelDeploymentCommand) cancel(config *deployapi.DeploymentConfig, out
 	return nil
 }
 
+// approveStepCommand approves the steps of canary deployments.
+type approveStepCommand struct {
+	client deployCommandClient
+}
+
+// approve approves the current step of the latest deployment of config. An
+// error is returned if the deployment is not a running canary deployment.
+func (c *approveStepCommand) approve(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.LatestVersion == 0 {
+		return fmt.Errorf("no deployments found for %s/%s", config.Namespace, config.Name)
+	}
+	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
+	deployment, err := c.client.GetDeployment(config.Namespace, deploymentName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("Unable to find the latest deployment (#%d).", config.LatestVersion)
+		}
+		return err
+	}
+
+	if status := deployutil.DeploymentStatusFor(deployment); status != deployapi.DeploymentStatusRunning {
+		return fmt.Errorf("#%d is %s; only running deployments can be approved.", config.LatestVersion, status)
+	}
+	step, ok := deployment.Annotations[deployapi.DeploymentCanaryStepAnnotation]
+	if !ok {
+		return fmt.Errorf("#%d is not a canary deployment.", config.LatestVersion)
+	}
+	if deployment.Annotations[deployapi.DeploymentCanaryApprovedStepAnnotation] == step {
+		fmt.Fprintf(out, "step %s of deployment #%d is already approved\n", step, config.LatestVersion)
+		return nil
+	}
+
+	deployment.Annotations[deployapi.DeploymentCanaryApprovedStepAnnotation] = step
+	_, err = c.client.UpdateDeployment(deployment)
+	if err == nil {
+		fmt.Fprintf(out, "approved step %s of deployment #%d\n", step, config.LatestVersion)
+	}
+	return err
+}
+
 // triggerEnabler can enable image triggers for a config.
 type triggerEnabler struct {
 	// updateConfig persists config.
@@ -397,6 +482,76 @@ func (t *triggerEnabler) enableTriggers(config *deployapi.DeploymentConfig, out
 	return nil
 }
 
+// pauseSetter can pause and resume a config.
+type pauseSetter struct {
+	// updateConfig persists config.
+	updateConfig func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+	// generateConfig returns the config with the latest images of its image
+	// change triggers.
+	generateConfig func(namespace, name string) (*deployapi.DeploymentConfig, error)
+}
+
+// setPaused pauses or resumes config and then persists it. A resumed config is
+// deployed if images were pushed for its automatic image triggers while it was
+// paused, since the image change controller ignored them.
+func (p *pauseSetter) setPaused(config *deployapi.DeploymentConfig, paused bool, out io.Writer) error {
+	if config.Paused == paused {
+		if paused {
+			fmt.Fprintf(out, "%s is already paused\n", config.Name)
+		} else {
+			fmt.Fprintf(out, "%s is not paused\n", config.Name)
+		}
+		return nil
+	}
+	config.Paused = paused
+	updated, err := p.updateConfig(config.Namespace, config)
+	if err != nil {
+		return err
+	}
+	if paused {
+		fmt.Fprintf(out, "paused %s\n", config.Name)
+		return nil
+	}
+	fmt.Fprintf(out, "resumed %s\n", config.Name)
+	return p.deployPausedImageChanges(updated, out)
+}
+
+// deployPausedImageChanges starts a deployment of the resumed config if its
+// automatic image triggers point to images that are not deployed yet.
+func (p *pauseSetter) deployPausedImageChanges(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.LatestVersion == 0 || !hasAutomaticImageTrigger(config) {
+		return nil
+	}
+	generated, err := p.generateConfig(config.Namespace, config.Name)
+	if err != nil {
+		return fmt.Errorf("couldn't check the image triggers of %s: %v", config.Name, err)
+	}
+	// A config that was updated since it was resumed is deployed with the
+	// latest images by whichever trigger updated it.
+	if generated.ResourceVersion != config.ResourceVersion || generated.LatestVersion == config.LatestVersion {
+		return nil
+	}
+	if _, err := p.updateConfig(generated.Namespace, generated); err != nil {
+		if kerrors.IsConflict(err) {
+			return nil
+		}
+		return err
+	}
+	fmt.Fprintf(out, "started deployment #%d for the images pushed while %s was paused\n", generated.LatestVersion, config.Name)
+	return nil
+}
+
+// hasAutomaticImageTrigger returns true if config has an automatic image
+// change trigger.
+func hasAutomaticImageTrigger(config *deployapi.DeploymentConfig) bool {
+	for _, trigger := range config.Triggers {
+		if trigger.Type == deployapi.DeploymentTriggerOnImageChange && trigger.ImageChangeParams != nil && trigger.ImageChangeParams.Automatic {
+			return true
+		}
+	}
+	return false
+}
+
 // deployCommandClientImpl is a pluggable deployCommandClient.
 type deployCommandClientImpl struct {
 	GetDeploymentFn            func(namespace, name string) (*kapi.ReplicationController, error)
@@ -333,6 +333,64 @@ func TestCmdDeploy_cancelOk(t *testing.T) {
 	}
 }
 
+func TestCmdDeploy_approveOk(t *testing.T) {
+	var updatedDeployment *kapi.ReplicationController
+	config := deploytest.OkDeploymentConfig(1)
+	existingDeployment := deploymentFor(config, deployapi.DeploymentStatusRunning)
+	existingDeployment.Annotations[deployapi.DeploymentCanaryStepAnnotation] = "2"
+
+	commandClient := &deployCommandClientImpl{
+		GetDeploymentFn: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return existingDeployment, nil
+		},
+		UpdateDeploymentFn: func(deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+			updatedDeployment = deployment
+			return deployment, nil
+		},
+	}
+
+	c := &approveStepCommand{client: commandClient}
+	if err := c.approve(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updatedDeployment == nil {
+		t.Fatalf("expected the deployment to be updated")
+	}
+	if e, a := "2", updatedDeployment.Annotations[deployapi.DeploymentCanaryApprovedStepAnnotation]; e != a {
+		t.Errorf("expected approved step %s, got %s", e, a)
+	}
+}
+
+func TestCmdDeploy_approveRejectInvalid(t *testing.T) {
+	var existingDeployment *kapi.ReplicationController
+
+	commandClient := &deployCommandClientImpl{
+		GetDeploymentFn: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return existingDeployment, nil
+		},
+		UpdateDeploymentFn: func(deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+			t.Fatalf("unexpected call to UpdateDeployment")
+			return nil, nil
+		},
+	}
+
+	c := &approveStepCommand{client: commandClient}
+
+	// A completed canary deployment can't be approved.
+	config := deploytest.OkDeploymentConfig(1)
+	existingDeployment = deploymentFor(config, deployapi.DeploymentStatusComplete)
+	existingDeployment.Annotations[deployapi.DeploymentCanaryStepAnnotation] = "1"
+	if err := c.approve(config, ioutil.Discard); err == nil {
+		t.Errorf("expected an error approving a complete deployment")
+	}
+
+	// A running deployment made with another strategy can't be approved.
+	existingDeployment = deploymentFor(config, deployapi.DeploymentStatusRunning)
+	if err := c.approve(config, ioutil.Discard); err == nil {
+		t.Errorf("expected an error approving a deployment without canary steps")
+	}
+}
+
 func TestDeploy_triggerEnable(t *testing.T) {
 	var updated *deployapi.DeploymentConfig
 	triggerEnabler := &triggerEnabler{
@@ -373,3 +431,99 @@ func TestDeploy_triggerEnable(t *testing.T) {
 		}
 	}
 }
+
+func TestDeploy_pauseAndResume(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	pauseSetter := &pauseSetter{
+		updateConfig: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+			updated = config
+			return config, nil
+		},
+		generateConfig: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+			generated := *updated
+			return &generated, nil
+		},
+	}
+
+	config := deploytest.OkDeploymentConfig(1)
+	if err := pauseSetter.setPaused(config, true, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || !updated.Paused {
+		t.Fatalf("expected a paused config, got %#v", updated)
+	}
+
+	// Pausing a paused config is a no-op.
+	updated = nil
+	if err := pauseSetter.setPaused(config, true, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatalf("unexpected update of a paused config")
+	}
+
+	if err := pauseSetter.setPaused(config, false, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || updated.Paused {
+		t.Fatalf("expected a resumed config, got %#v", updated)
+	}
+}
+
+func TestDeploy_resumeDeploysPausedImageChanges(t *testing.T) {
+	tests := []struct {
+		name            string
+		resourceVersion string
+		latestVersion   int
+		expected        int
+	}{
+		{
+			name:            "image pushed while paused",
+			resourceVersion: "1",
+			latestVersion:   2,
+			expected:        2,
+		},
+		{
+			name:            "no image pushed while paused",
+			resourceVersion: "1",
+			latestVersion:   1,
+			expected:        1,
+		},
+		{
+			name:            "config deployed by a trigger since it was resumed",
+			resourceVersion: "2",
+			latestVersion:   3,
+			expected:        1,
+		},
+	}
+
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(1)
+		config.Paused = true
+		var updated *deployapi.DeploymentConfig
+		pauseSetter := &pauseSetter{
+			updateConfig: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				updated = config
+				config.ResourceVersion = "1"
+				return config, nil
+			},
+			generateConfig: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				generated := *updated
+				generated.ResourceVersion = test.resourceVersion
+				generated.LatestVersion = test.latestVersion
+				return &generated, nil
+			},
+		}
+
+		if err := pauseSetter.setPaused(config, false, ioutil.Discard); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if updated.Paused {
+			t.Errorf("%s: expected a resumed config", test.name)
+		}
+		if e, a := test.expected, updated.LatestVersion; e != a {
+			t.Errorf("%s: expected latest version %d, got %d", test.name, e, a)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -26,6 +27,9 @@ If you specify a source code URL, it will set up a build that takes your source
 it into an image that can run inside of a pod. Local source must be in a git repository that has a
 remote repository that the server can see.
 
+You may also pass the contents of a Dockerfile with --dockerfile to build without a source
+repository, or to replace the Dockerfile of the provided repository.
+
 Once the build configuration is created you may need to run a build with 'start-build'.`
 
 	newBuildExample = `  // Create a build config based on the source code in the current git repository (with a public remote) and a Docker image
@@ -35,7 +39,10 @@ Once the build configuration is created you may need to run a build with 'start-
   $ %[1]s new-build openshift/nodejs-010-centos7~https://bitbucket.com/user/nodejs-app
 
   // Create a build config from a remote repository using its beta2 branch
-  $ %[1]s new-build https://github.com/openshift/ruby-hello-world#beta2`
+  $ %[1]s new-build https://github.com/openshift/ruby-hello-world#beta2
+
+  // Create a build config using a Dockerfile specified as an argument
+  $ %[1]s new-build -D $'FROM centos:7\nRUN yum install -y httpd'`
 )
 
 // NewCmdNewBuild implements the OpenShift cli new-build command
@@ -59,6 +66,7 @@ func NewCmdNewBuild(fullName string, f *clientcmd.Factory, out io.Writer) *cobra
 	}
 
 	cmd.Flags().Var(&config.SourceRepositories, "code", "Source code in the build configuration.")
+	cmd.Flags().StringVarP(&config.Dockerfile, "dockerfile", "D", "", "Specify the contents of a Dockerfile to build directly, implies --strategy=docker. Pass '-' to read from STDIN.")
 	cmd.Flags().VarP(&config.ImageStreams, "image", "i", "Name of an image stream to to use as a builder.")
 	cmd.Flags().Var(&config.DockerImages, "docker-image", "Name of a Docker image to use as a builder.")
 	cmd.Flags().StringVar(&config.Name, "name", "", "Set name to use for generated build artifacts")
@@ -72,6 +80,14 @@ func NewCmdNewBuild(fullName string, f *clientcmd.Factory, out io.Writer) *cobra
 
 // RunNewBuild contains all the necessary functionality for the OpenShift cli new-build command
 func RunNewBuild(fullName string, f *clientcmd.Factory, out io.Writer, c *cobra.Command, args []string, config *newcmd.AppConfig) error {
+	if config.Dockerfile == "-" {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		config.Dockerfile = string(data)
+	}
+
 	if err := setupAppConfig(f, c, args, config); err != nil {
 		return err
 	}
@@ -88,7 +104,7 @@ func RunNewBuild(fullName string, f *clientcmd.Factory, out io.Writer, c *cobra.
 		}
 		if err == newcmd.ErrNoInputs {
 			// TODO: suggest things to the user
-			return cmdutil.UsageError(c, "You must specify one or more images, image streams and source code locations to create a build configuration.")
+			return cmdutil.UsageError(c, "You must specify one or more images, image streams, source code locations or a Dockerfile to create a build configuration.")
 		}
 		return err
 	}
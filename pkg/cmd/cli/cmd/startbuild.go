@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"archive/tar"
 	"bytes"
 	"encoding/json"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/golang/glog"
@@ -16,10 +18,15 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client"
+	"k8s.io/kubernetes/pkg/client/remotecommand"
+	"k8s.io/kubernetes/pkg/fields"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/generate/git"
@@ -30,7 +37,10 @@ const (
 Start a build
 
 This command starts a build for the provided BuildConfig or re-runs an existing build using
---from-build=<name>. You may pass the --follow flag to see output from the build.`
+--from-build=<name>. You may pass the --follow flag to see output from the build.
+
+BuildConfigs with a binary source must be started with one of --from-file, --from-dir, or
+--from-archive. The contents are uploaded to the build as it starts.`
 
 	startBuildExample = `  // Starts build from BuildConfig matching the name "3bd2ug53b"
   $ %[1]s start-build 3bd2ug53b
@@ -40,7 +50,11 @@ This command starts a build for the provided BuildConfig or re-runs an existing
 
   // Starts build from BuildConfig matching the name "3bd2ug53b" and watches the logs until the build
   // completes or fails
-  $ %[1]s start-build 3bd2ug53b --follow`
+  $ %[1]s start-build 3bd2ug53b --follow
+
+  // Starts build from BuildConfig matching the name "3bd2ug53b" using the contents of the
+  // current directory as the source of the build
+  $ %[1]s start-build 3bd2ug53b --from-dir=.`
 )
 
 // NewCmdStartBuild implements the OpenShift cli start-build command
@@ -60,10 +74,13 @@ func NewCmdStartBuild(fullName string, f *clientcmd.Factory, out io.Writer) *cob
 	}
 	cmd.Flags().String("from-build", "", "Specify the name of a build which should be re-run")
 	cmd.Flags().Bool("follow", false, "Start a build and watch its logs until it completes or fails")
-	cmd.Flags().Var(&webhooks, "list-webhooks", "List the webhooks for the specified BuildConfig or build; accepts 'all', 'generic', or 'github'")
+	cmd.Flags().Var(&webhooks, "list-webhooks", "List the webhooks for the specified BuildConfig or build; accepts 'all', 'generic', 'github', 'gitlab', or 'bitbucket'")
 	cmd.Flags().String("from-webhook", "", "Specify a webhook URL for an existing BuildConfig to trigger")
 	cmd.Flags().String("git-post-receive", "", "The contents of the post-receive hook to trigger a build")
 	cmd.Flags().String("git-repository", "", "The path to the git repository for post-receive; defaults to the current directory")
+	cmd.Flags().String("from-file", "", "A file to use as the binary input for the build; example a pom.xml or Dockerfile. Will be the only file in the build source.")
+	cmd.Flags().String("from-dir", "", "A directory to archive and use as the binary input for a build.")
+	cmd.Flags().String("from-archive", "", "A tar or tar.gz archive to use as the binary input for a build.")
 	return cmd
 }
 
@@ -72,11 +89,21 @@ func RunStartBuild(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args
 	webhook := cmdutil.GetFlagString(cmd, "from-webhook")
 	buildName := cmdutil.GetFlagString(cmd, "from-build")
 	follow := cmdutil.GetFlagBool(cmd, "follow")
+	fromFile := cmdutil.GetFlagString(cmd, "from-file")
+	fromDir := cmdutil.GetFlagString(cmd, "from-dir")
+	fromArchive := cmdutil.GetFlagString(cmd, "from-archive")
+
+	binaryInputs := 0
+	for _, s := range []string{fromFile, fromDir, fromArchive} {
+		if len(s) > 0 {
+			binaryInputs++
+		}
+	}
 
 	switch {
 	case len(webhook) > 0:
-		if len(args) > 0 || len(buildName) > 0 {
-			return cmdutil.UsageError(cmd, "The '--from-webhook' flag is incompatible with arguments or '--from-build'")
+		if len(args) > 0 || len(buildName) > 0 || binaryInputs > 0 {
+			return cmdutil.UsageError(cmd, "The '--from-webhook' flag is incompatible with arguments, '--from-build', '--from-file', '--from-dir', or '--from-archive'")
 		}
 		path := cmdutil.GetFlagString(cmd, "git-repository")
 		postReceivePath := cmdutil.GetFlagString(cmd, "git-post-receive")
@@ -84,6 +111,8 @@ func RunStartBuild(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args
 		return RunStartBuildWebHook(f, out, webhook, path, postReceivePath, repo)
 	case len(args) != 1 && len(buildName) == 0:
 		return cmdutil.UsageError(cmd, "Must pass a name of a BuildConfig or specify build name with '--from-build' flag")
+	case binaryInputs > 1:
+		return cmdutil.UsageError(cmd, "Only one of '--from-file', '--from-dir', or '--from-archive' may be specified")
 	}
 
 	name := buildName
@@ -97,7 +126,7 @@ func RunStartBuild(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args
 		return RunListBuildWebHooks(f, out, cmd.Out(), name, isBuild, webhooks.String())
 	}
 
-	client, _, err := f.Clients()
+	client, kclient, err := f.Clients()
 	if err != nil {
 		return err
 	}
@@ -110,6 +139,29 @@ func RunStartBuild(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args
 	request := &buildapi.BuildRequest{
 		ObjectMeta: kapi.ObjectMeta{Name: name},
 	}
+
+	var input io.ReadCloser
+	switch {
+	case len(fromFile) > 0:
+		if input, err = fileInput(fromFile); err != nil {
+			return err
+		}
+		request.Binary = &buildapi.BinaryBuildSource{AsFile: filepath.Base(fromFile)}
+	case len(fromDir) > 0:
+		if input, err = dirInput(fromDir); err != nil {
+			return err
+		}
+		request.Binary = &buildapi.BinaryBuildSource{}
+	case len(fromArchive) > 0:
+		if input, err = os.Open(fromArchive); err != nil {
+			return fmt.Errorf("unable to open the archive %s: %v", fromArchive, err)
+		}
+		request.Binary = &buildapi.BinaryBuildSource{}
+	}
+	if input != nil {
+		defer input.Close()
+	}
+
 	var newBuild *buildapi.Build
 	if isBuild {
 		if newBuild, err = client.Builds(namespace).Clone(request); err != nil {
@@ -122,6 +174,16 @@ func RunStartBuild(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args
 	}
 	fmt.Fprintf(out, "%s\n", newBuild.Name)
 
+	if input != nil {
+		config, err := f.ClientConfig()
+		if err != nil {
+			return err
+		}
+		if err := streamBuildInput(client, kclient, config, newBuild, input); err != nil {
+			return err
+		}
+	}
+
 	if follow {
 		opts := buildapi.BuildLogOptions{
 			Follow: true,
@@ -140,20 +202,154 @@ func RunStartBuild(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args
 	return nil
 }
 
+// streamBuildInput waits for the build pod of a binary build to start and then sends the
+// provided input to the stdin of the build container.
+func streamBuildInput(oclient osclient.Interface, kclient *client.Client, config *client.Config, build *buildapi.Build, input io.Reader) error {
+	w, err := oclient.Builds(build.Namespace).Watch(labels.Everything(), fields.Set{"metadata.name": build.Name}.AsSelector(), build.ResourceVersion)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	for running := false; !running; {
+		event, ok := <-w.ResultChan()
+		if !ok {
+			return fmt.Errorf("the watch on build %s was closed before the build started", build.Name)
+		}
+		if event.Type == watch.Error {
+			return fmt.Errorf("unable to watch build %s: %v", build.Name, event.Object)
+		}
+		b, ok := event.Object.(*buildapi.Build)
+		if !ok || b.Name != build.Name {
+			continue
+		}
+		switch b.Status.Phase {
+		case buildapi.BuildPhaseRunning:
+			running = true
+		case buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled:
+			return fmt.Errorf("build %s finished with status %s before the input could be uploaded", build.Name, b.Status.Phase)
+		}
+	}
+
+	podName := buildutil.GetBuildPodName(build)
+	glog.V(4).Infof("Uploading binary input to pod %s/%s", build.Namespace, podName)
+	req := kclient.RESTClient.Post().
+		Resource("pods").
+		Name(podName).
+		Namespace(build.Namespace).
+		SubResource("attach")
+	if err := remotecommand.NewAttach(req, config, input, nil, nil, false).Execute(); err != nil {
+		return fmt.Errorf("unable to upload the binary input to build %s: %v", build.Name, err)
+	}
+	return nil
+}
+
+// fileInput returns a tar archive stream containing only the provided file.
+func fileInput(path string) (io.ReadCloser, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the file %s: %v", path, err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory, use '--from-dir' instead", path)
+	}
+	return archiveInput(func(tw *tar.Writer) error {
+		return addFileToArchive(tw, path, filepath.Base(path), info)
+	}), nil
+}
+
+// dirInput returns a tar archive stream of the contents of the provided directory,
+// excluding any .git metadata.
+func dirInput(dir string) (io.ReadCloser, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the directory %s: %v", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory, use '--from-file' or '--from-archive' instead", dir)
+	}
+	return archiveInput(func(tw *tar.Writer) error {
+		return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			if name == "." {
+				return nil
+			}
+			if info.IsDir() && info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return addFileToArchive(tw, path, filepath.ToSlash(name), info)
+		})
+	}), nil
+}
+
+// archiveInput runs fn against a tar writer and returns the resulting archive as a stream.
+func archiveInput(fn func(*tar.Writer) error) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		tw := tar.NewWriter(w)
+		if err := fn(tw); err != nil {
+			w.CloseWithError(err)
+			return
+		}
+		w.CloseWithError(tw.Close())
+	}()
+	return r
+}
+
+// addFileToArchive writes the file at path into the archive under name.
+func addFileToArchive(tw *tar.Writer, path, name string, info os.FileInfo) error {
+	link := ""
+	if info.Mode()&os.ModeSymlink != 0 {
+		var err error
+		if link, err = os.Readlink(path); err != nil {
+			return err
+		}
+	}
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	glog.V(5).Infof("Adding %s to the archive as %s", path, name)
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(tw, file)
+	return err
+}
+
 // RunListBuildWebHooks prints the webhooks for the provided build config.
 func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name string, isBuild bool, webhookFilter string) error {
-	generic, github := false, false
+	generic, github, gitlab, bitbucket := false, false, false, false
 	prefix := false
 	switch webhookFilter {
 	case "all":
-		generic, github = true, true
+		generic, github, gitlab, bitbucket = true, true, true, true
 		prefix = true
 	case "generic":
 		generic = true
 	case "github":
 		github = true
+	case "gitlab":
+		gitlab = true
+	case "bitbucket":
+		bitbucket = true
 	default:
-		return fmt.Errorf("--list-webhooks must be 'all', 'generic', or 'github'")
+		return fmt.Errorf("--list-webhooks must be 'all', 'generic', 'github', 'gitlab', or 'bitbucket'")
 	}
 	client, _, err := f.Clients()
 	if err != nil {
@@ -194,6 +390,14 @@ func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name stri
 			if prefix {
 				hookType = "github "
 			}
+		case t.GitLabWebHook != nil && gitlab:
+			if prefix {
+				hookType = "gitlab "
+			}
+		case t.BitbucketWebHook != nil && bitbucket:
+			if prefix {
+				hookType = "bitbucket "
+			}
 		default:
 			continue
 		}
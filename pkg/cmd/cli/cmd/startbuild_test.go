@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"archive/tar"
 	"bytes"
 	"encoding/json"
 	"errors"
@@ -9,6 +10,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -119,3 +123,45 @@ func TestStartBuildHookPostReceive(t *testing.T) {
 		t.Fatalf("unexpected ref: %#v", event.Git.Refs[0])
 	}
 }
+
+func TestStartBuildDirInput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "start-build")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	for _, path := range []string{"Dockerfile", "src/main.go", ".git/HEAD"} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, path), []byte(path), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	input, err := dirInput(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer input.Close()
+
+	names := []string{}
+	tr := tar.NewReader(input)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		if header.Typeflag == tar.TypeReg {
+			names = append(names, header.Name)
+		}
+	}
+	sort.Strings(names)
+	if expected := []string{"Dockerfile", "src/main.go"}; !reflect.DeepEqual(expected, names) {
+		t.Errorf("expected %v in the archive, got %v", expected, names)
+	}
+
+	if _, err := dirInput(filepath.Join(dir, "Dockerfile")); err == nil {
+		t.Errorf("expected an error for a file passed as a directory")
+	}
+}
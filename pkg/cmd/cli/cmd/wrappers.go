@@ -8,10 +8,12 @@ import (
 
 	"github.com/spf13/cobra"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	kutil "k8s.io/kubernetes/pkg/util"
 
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 )
 
 func tab(original string) string {
@@ -131,25 +133,90 @@ func NewCmdDelete(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 
 const (
 	logsLong = `
-Print the logs for a container in a pod
+Print the logs for a container in a pod or for a deployment
 
-If the pod has only one container, the container name is optional.`
+If the pod has only one container, the container name is optional.
+
+Passing a deployment config as dc/NAME prints the logs of the deployer pod of its latest
+deployment, or of the deployment selected with --version. If the deployment has not started
+yet, the command waits for it unless --nowait is set.`
 
 	logsExample = `  // Returns snapshot of ruby-container logs from pod 123456-7890.
   $ %[1]s logs 123456-7890 -c ruby-container
 
   // Starts streaming of ruby-container logs from pod 123456-7890.
-  $ %[1]s logs -f 123456-7890 -c ruby-container`
+  $ %[1]s logs -f 123456-7890 -c ruby-container
+
+  // Starts streaming the logs of the latest deployment of the frontend deployment config.
+  $ %[1]s logs -f dc/frontend
+
+  // Returns the logs of the second deployment of the frontend deployment config.
+  $ %[1]s logs dc/frontend --version=2`
 )
 
 // NewCmdLogs is a wrapper for the Kubernetes cli logs command
 func NewCmdLogs(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	cmd := kcmd.NewCmdLog(f.Factory, out)
+	cmd.Use = "logs [-f] [-p] (POD | dc/NAME) [-c CONTAINER]"
 	cmd.Long = logsLong
 	cmd.Example = fmt.Sprintf(logsExample, fullName)
+	opts := deployapi.DeploymentLogOptions{}
+	run := cmd.Run
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		name, ok := deploymentConfigName(args)
+		if !ok {
+			run(cmd, args)
+			return
+		}
+		opts.Follow = cmdutil.GetFlagBool(cmd, "follow")
+		err := RunDeploymentLogs(f, out, name, opts)
+		cmdutil.CheckErr(err)
+	}
+	cmd.Flags().IntVar(&opts.Version, "version", 0, "The version of the deployment to print the logs of; only valid for dc/NAME, defaults to the latest version.")
+	cmd.Flags().BoolVarP(&opts.NoWait, "nowait", "w", false, "Return immediately if the deployment has not started yet; only valid for dc/NAME.")
 	return cmd
 }
 
+// deploymentConfigName returns the name of the deployment config the logs
+// command was invoked for, if its only argument is of the form dc/NAME.
+func deploymentConfigName(args []string) (string, bool) {
+	if len(args) != 1 {
+		return "", false
+	}
+	parts := strings.SplitN(args[0], "/", 2)
+	if len(parts) != 2 || len(parts[1]) == 0 {
+		return "", false
+	}
+	switch strings.ToLower(parts[0]) {
+	case "dc", "deploymentconfig", "deploymentconfigs":
+		return parts[1], true
+	}
+	return "", false
+}
+
+// RunDeploymentLogs streams the logs of a deployment of the named deployment
+// config to out.
+func RunDeploymentLogs(f *clientcmd.Factory, out io.Writer, name string, opts deployapi.DeploymentLogOptions) error {
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	c, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+
+	readCloser, err := c.DeploymentLogs(namespace).Get(name, opts).Stream()
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+
+	_, err = io.Copy(out, readCloser)
+	return err
+}
+
 const (
 	createLong = `Create a resource by filename or stdin
 
@@ -9,6 +9,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/openshift/origin/pkg/api/graph"
+	"github.com/openshift/origin/pkg/api/latest"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
@@ -133,6 +134,16 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 			formatString(out, "Latest Version", strconv.Itoa(deploymentConfig.LatestVersion))
 		}
 
+		if deploymentConfig.Paused {
+			formatString(out, "Paused", "yes")
+		}
+		if deploymentConfig.AutoRollback {
+			formatString(out, "Auto Rollback", "yes")
+		}
+		if deploymentConfig.RevisionHistoryLimit != nil {
+			formatString(out, "Revision History Limit", *deploymentConfig.RevisionHistoryLimit)
+		}
+
 		printTriggers(deploymentConfig.Triggers, out)
 
 		formatString(out, "Strategy", deploymentConfig.Template.Strategy.Type)
@@ -176,16 +187,29 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 	case deployapi.DeploymentStrategyTypeRecreate:
 		if strategy.RecreateParams != nil {
 			pre := strategy.RecreateParams.Pre
+			mid := strategy.RecreateParams.Mid
 			post := strategy.RecreateParams.Post
 			if pre != nil {
 				printHook("Pre-deployment", pre, w)
 			}
+			if mid != nil {
+				printHook("Mid-deployment", mid, w)
+			}
 			if post != nil {
 				printHook("Post-deployment", post, w)
 			}
 		}
 	case deployapi.DeploymentStrategyTypeRolling:
 		if strategy.RollingParams != nil {
+			if maxUnavailable := strategy.RollingParams.MaxUnavailable; maxUnavailable != nil {
+				fmt.Fprintf(w, "\t  Max Unavailable:\t%s\n", maxUnavailable.String())
+			}
+			if maxSurge := strategy.RollingParams.MaxSurge; maxSurge != nil {
+				fmt.Fprintf(w, "\t  Max Surge:\t%s\n", maxSurge.String())
+			}
+			if minReady := strategy.RollingParams.MinReadySeconds; minReady > 0 {
+				fmt.Fprintf(w, "\t  Min Ready Seconds:\t%d\n", minReady)
+			}
 			pre := strategy.RollingParams.Pre
 			post := strategy.RollingParams.Post
 			if pre != nil {
@@ -195,6 +219,31 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 				printHook("Post-deployment", post, w)
 			}
 		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams != nil {
+			fmt.Fprintf(w, "\t  Service:\t%s\n", strategy.BlueGreenParams.ServiceName)
+			if pre := strategy.BlueGreenParams.Pre; pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			if verify := strategy.BlueGreenParams.Verify; verify != nil {
+				printHook("Verification", verify, w)
+			}
+			if post := strategy.BlueGreenParams.Post; post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams != nil {
+			for i, step := range strategy.CanaryParams.Steps {
+				fmt.Fprintf(w, "\t  Step %d:\t%s\n", i+1, formatCanaryStep(step))
+			}
+			if pre := strategy.CanaryParams.Pre; pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			if post := strategy.CanaryParams.Post; post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		fmt.Fprintf(w, "\t  Image:\t%s\n", strategy.CustomParams.Image)
 
@@ -208,12 +257,42 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 	}
 }
 
+func formatCanaryStep(step deployapi.CanaryStep) string {
+	var desc string
+	switch {
+	case step.Percent != nil:
+		desc = fmt.Sprintf("%d%% of replicas", *step.Percent)
+	case step.Replicas != nil:
+		desc = fmt.Sprintf("%d replicas", *step.Replicas)
+	}
+	if step.PauseSeconds != nil && *step.PauseSeconds > 0 {
+		desc += fmt.Sprintf(", pause %ds", *step.PauseSeconds)
+	}
+	if step.ManualApproval {
+		desc += ", manual approval"
+	}
+	return desc
+}
+
 func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
 	if hook.ExecNewPod != nil {
 		fmt.Fprintf(w, "\t  %s hook (pod type, failure policy: %s)\n", prefix, hook.FailurePolicy)
 		fmt.Fprintf(w, "\t    Container:\t%s\n", hook.ExecNewPod.ContainerName)
 		fmt.Fprintf(w, "\t    Command:\t%v\n", strings.Join(hook.ExecNewPod.Command, " "))
 		fmt.Fprintf(w, "\t    Env:\t%s\n", formatLabels(convertEnv(hook.ExecNewPod.Env)))
+		if len(hook.ExecNewPod.Volumes) > 0 {
+			fmt.Fprintf(w, "\t    Volumes:\t%s\n", strings.Join(hook.ExecNewPod.Volumes, ", "))
+		}
+	}
+	if len(hook.TagImages) > 0 {
+		fmt.Fprintf(w, "\t  %s hook (tag images, failure policy: %s)\n", prefix, hook.FailurePolicy)
+		for _, image := range hook.TagImages {
+			to := image.To.Name
+			if len(image.To.Namespace) > 0 {
+				to = image.To.Namespace + "/" + to
+			}
+			fmt.Fprintf(w, "\t    Tag:\tcontainer %s to %s %s\n", image.ContainerName, image.To.Kind, to)
+		}
 	}
 }
 
@@ -271,6 +350,9 @@ func printDeploymentRc(deployment *kapi.ReplicationController, client deployment
 	fmt.Fprintf(w, "\tCreated:\t%s ago\n", timeAt)
 	fmt.Fprintf(w, "\tStatus:\t%s\n", deployutil.DeploymentStatusFor(deployment))
 	fmt.Fprintf(w, "\tReplicas:\t%d current / %d desired\n", deployment.Status.Replicas, deployment.Spec.Replicas)
+	if progress := canaryProgress(deployment); len(progress) > 0 {
+		fmt.Fprintf(w, "\tCanary Step:\t%s\n", progress)
+	}
 
 	if verbose {
 		fmt.Fprintf(w, "\tSelector:\t%s\n", formatLabels(deployment.Spec.Selector))
@@ -285,6 +367,22 @@ func printDeploymentRc(deployment *kapi.ReplicationController, client deployment
 	return nil
 }
 
+// canaryProgress returns the progress of a deployment made with the Canary
+// strategy, or an empty string for other deployments.
+func canaryProgress(deployment *kapi.ReplicationController) string {
+	progress, ok := deployment.Annotations[deployapi.DeploymentCanaryStepAnnotation]
+	if !ok {
+		return ""
+	}
+	if config, err := deployutil.DecodeDeploymentConfig(deployment, latest.Codec); err == nil && config.Template.Strategy.CanaryParams != nil {
+		progress = fmt.Sprintf("%s of %d", progress, len(config.Template.Strategy.CanaryParams.Steps))
+	}
+	if deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] == deployapi.DeploymentWaitingForApproval {
+		progress += " (waiting for approval)"
+	}
+	return progress
+}
+
 func getPodStatusForDeployment(deployment *kapi.ReplicationController, client deploymentDescriberClient) (running, waiting, succeeded, failed int, err error) {
 	rcPods, err := client.listPods(deployment.Namespace, labels.SelectorFromSet(deployment.Spec.Selector))
 	if err != nil {
@@ -370,6 +468,9 @@ func (d *LatestDeploymentsDescriber) Describe(namespace, name string) (string, e
 
 	return tabbedString(func(out *tabwriter.Writer) error {
 		descriptions := describeDeployments(dcNode, activeDeployment, inactiveDeployments, d.count)
+		if config.Paused {
+			descriptions = append([]string{"is paused, triggers won't start new deployments"}, descriptions...)
+		}
 		for i, description := range descriptions {
 			descriptions[i] = fmt.Sprintf("%v %v", name, description)
 		}
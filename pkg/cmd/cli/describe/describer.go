@@ -36,6 +36,7 @@ func describerMap(c *client.Client, kclient kclient.Interface, host string) map[
 		"Build":                &BuildDescriber{c, kclient},
 		"BuildConfig":          &BuildConfigDescriber{c, host},
 		"BuildLog":             &BuildLogDescriber{c},
+		"Pipeline":             &PipelineDescriber{c},
 		"DeploymentConfig":     NewDeploymentConfigDescriber(c, kclient),
 		"Identity":             &IdentityDescriber{c},
 		"Image":                &ImageDescriber{c},
@@ -129,6 +130,9 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 			status += " (" + build.Status.Message + ")"
 		}
 		formatString(out, "Status", status)
+		if len(build.Status.Reason) > 0 {
+			formatString(out, "Reason", build.Status.Reason)
+		}
 		if build.Status.StartTimestamp != nil {
 			formatString(out, "Started", build.Status.StartTimestamp.Time)
 		}
@@ -139,6 +143,9 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 		// output like "duration: 1.2724395728934s"
 		formatString(out, "Duration", describeBuildDuration(build))
 		formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		for _, cause := range build.Status.TriggeredBy {
+			formatString(out, "Triggered By", describeBuildTriggerCause(cause))
+		}
 		describeBuildSpec(build.Spec, out)
 		kctl.DescribeEvents(events, out)
 
@@ -146,6 +153,44 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 	})
 }
 
+// describeBuildTriggerCause returns a one line description of why a build was
+// started.
+func describeBuildTriggerCause(cause buildapi.BuildTriggerCause) string {
+	var revision *buildapi.SourceRevision
+	desc := cause.Message
+	switch {
+	case cause.GitHubWebHook != nil:
+		revision = cause.GitHubWebHook.Revision
+	case cause.GenericWebHook != nil:
+		revision = cause.GenericWebHook.Revision
+	case cause.GitLabWebHook != nil:
+		revision = cause.GitLabWebHook.Revision
+	case cause.BitbucketWebHook != nil:
+		revision = cause.BitbucketWebHook.Revision
+	case cause.ImageChangeBuild != nil:
+		desc = fmt.Sprintf("%s: %s", desc, cause.ImageChangeBuild.ImageID)
+		if ref := cause.ImageChangeBuild.FromRef; ref != nil {
+			desc = fmt.Sprintf("%s (%s %s)", desc, ref.Kind, ref.Name)
+		}
+	case cause.Manual != nil && len(cause.Manual.User) > 0:
+		desc = fmt.Sprintf("%s by %s", desc, cause.Manual.User)
+	}
+	if revision != nil && revision.Git != nil && len(revision.Git.Commit) > 0 {
+		commit := revision.Git.Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		desc = fmt.Sprintf("%s: commit %s", desc, commit)
+		if len(revision.Git.Message) > 0 {
+			desc = fmt.Sprintf("%s %q", desc, strings.SplitN(revision.Git.Message, "\n", 2)[0])
+		}
+		if len(revision.Git.Author.Name) > 0 {
+			desc = fmt.Sprintf("%s by %s", desc, revision.Git.Author.Name)
+		}
+	}
+	return desc
+}
+
 func describeBuildDuration(build *buildapi.Build) string {
 	t := util.Now().Rfc3339Copy()
 	if build.Status.StartTimestamp == nil &&
@@ -213,6 +258,35 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 			formatString(out, "Message", rev.Message)
 		}
 	}
+	if p.Source.Binary != nil {
+		if len(p.Source.Binary.AsFile) > 0 {
+			formatString(out, "Binary", fmt.Sprintf("provided as file %q on build", p.Source.Binary.AsFile))
+		} else {
+			formatString(out, "Binary", "provided on build")
+		}
+	}
+	for _, image := range p.Source.Images {
+		if len(image.From.Namespace) != 0 {
+			formatString(out, "Image Source", fmt.Sprintf("copies %d paths from %s %s/%s", len(image.Paths), image.From.Kind, image.From.Namespace, image.From.Name))
+		} else {
+			formatString(out, "Image Source", fmt.Sprintf("copies %d paths from %s %s", len(image.Paths), image.From.Kind, image.From.Name))
+		}
+	}
+	for _, secret := range p.Source.Secrets {
+		destination := secret.DestinationDir
+		if len(destination) == 0 {
+			destination = "."
+		}
+		formatString(out, "Build Secret", fmt.Sprintf("%s copied to %s", secret.Secret.Name, destination))
+	}
+	if p.Source.Dockerfile != nil {
+		if dockerfile := strings.TrimSpace(*p.Source.Dockerfile); len(dockerfile) > 0 {
+			formatString(out, "Dockerfile", "")
+			for _, line := range strings.Split(dockerfile, "\n") {
+				fmt.Fprintf(out, "  %s\n", line)
+			}
+		}
+	}
 	if p.Output.To != nil {
 		if len(p.Output.To.Namespace) != 0 {
 			formatString(out, "Output to", fmt.Sprintf("%s %s/%s", p.Output.To.Kind, p.Output.To.Namespace, p.Output.To.Name))
@@ -225,6 +299,10 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		formatString(out, "Push Secret", p.Output.PushSecret.Name)
 	}
 
+	if hook := describePostCommitHook(p.PostCommit); len(hook) > 0 {
+		formatString(out, "Post Commit Hook", hook)
+	}
+
 	if p.Revision != nil && p.Revision.Type == buildapi.BuildSourceGit && p.Revision.Git != nil {
 		buildDescriber := &BuildDescriber{}
 
@@ -237,6 +315,21 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 	}
 }
 
+// describePostCommitHook returns the command line run by the post commit hook,
+// or an empty string if the build has none.
+func describePostCommitHook(hook buildapi.BuildPostCommitSpec) string {
+	var command []string
+	switch {
+	case len(hook.Script) > 0:
+		command = append([]string{"/bin/sh", "-ic", strconv.Quote(hook.Script)}, hook.Args...)
+	case len(hook.Command) > 0:
+		command = append(append([]string{}, hook.Command...), hook.Args...)
+	default:
+		command = hook.Args
+	}
+	return strings.Join(command, " ")
+}
+
 func describeSourceStrategy(s *buildapi.SourceBuildStrategy, out *tabwriter.Writer) {
 	if len(s.From.Name) != 0 {
 		if len(s.From.Namespace) != 0 {
@@ -339,6 +432,9 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(buildConfig.Status.LastVersion))
 		}
+		if len(buildConfig.Spec.RunPolicy) > 0 {
+			formatString(out, "Run Policy", buildConfig.Spec.RunPolicy)
+		}
 		describeBuildSpec(buildConfig.Spec.BuildSpec, out)
 		d.DescribeTriggers(buildConfig, out)
 		if len(buildList.Items) == 0 {
@@ -364,6 +460,46 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 	})
 }
 
+// PipelineDescriber generates information about a Pipeline
+type PipelineDescriber struct {
+	client.Interface
+}
+
+// Describe returns the description of a pipeline
+func (d *PipelineDescriber) Describe(namespace, name string) (string, error) {
+	pipeline, err := d.Pipelines(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, pipeline.ObjectMeta)
+		formatString(out, "Status", pipeline.Status.Phase)
+		if len(pipeline.Status.Message) > 0 {
+			formatString(out, "Message", pipeline.Status.Message)
+		}
+		if pipeline.Status.StartTimestamp != nil {
+			formatString(out, "Started", pipeline.Status.StartTimestamp.Rfc3339Copy())
+		}
+		if pipeline.Status.CompletionTimestamp != nil {
+			formatString(out, "Finished", pipeline.Status.CompletionTimestamp.Rfc3339Copy())
+		}
+		fmt.Fprintf(out, "Stages:\n  Name\tType\tStatus\tReference\tMessage\n")
+		for i, stage := range pipeline.Spec.Stages {
+			phase, reference, message := buildapi.PipelineStagePhasePending, "<none>", ""
+			if i < len(pipeline.Status.Stages) {
+				status := pipeline.Status.Stages[i]
+				phase, message = status.Phase, status.Message
+				if status.Reference != nil {
+					reference = fmt.Sprintf("%s/%s", strings.ToLower(status.Reference.Kind), status.Reference.Name)
+				}
+			}
+			fmt.Fprintf(out, "  %s \t%s \t%s \t%s \t%s\n", stage.Name, stage.Type, strings.ToLower(string(phase)), reference, message)
+		}
+		return nil
+	})
+}
+
 // BuildLogDescriber generates information about a BuildLog
 type BuildLogDescriber struct {
 	client.Interface
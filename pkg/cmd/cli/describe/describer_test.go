@@ -43,6 +43,8 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&oauthapi.OAuthAuthorizeToken{}),                   // normal users don't ever look at these
 	reflect.TypeOf(&oauthapi.OAuthClientAuthorization{}),              // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentConfigRollback{}),             // normal users don't ever look at these
+	reflect.TypeOf(&deployapi.DeploymentLog{}),                        // just a marker type
+	reflect.TypeOf(&deployapi.DeploymentLogOptions{}),                 // normal users don't ever look at these
 	reflect.TypeOf(&projectapi.ProjectRequest{}),                      // normal users don't ever look at these
 	reflect.TypeOf(&authorizationapi.IsPersonalSubjectAccessReview{}), // not a top level resource
 }
@@ -205,6 +207,66 @@ func TestDeploymentConfigDescriber(t *testing.T) {
 	describe()
 }
 
+func TestDescribeBuildTriggerCause(t *testing.T) {
+	tests := []struct {
+		cause    buildapi.BuildTriggerCause
+		expected string
+	}{
+		{
+			cause:    buildapi.BuildTriggerCause{Message: buildapi.BuildTriggerCauseConfigMsg},
+			expected: "Build configuration change",
+		},
+		{
+			cause: buildapi.BuildTriggerCause{
+				Message: buildapi.BuildTriggerCauseManualMsg,
+				Manual:  &buildapi.ManualCause{User: "developer"},
+			},
+			expected: "Manually triggered by developer",
+		},
+		{
+			cause: buildapi.BuildTriggerCause{
+				Message: buildapi.BuildTriggerCauseImageMsg,
+				ImageChangeBuild: &buildapi.ImageChangeCause{
+					ImageID: "registry/ns/builder@sha256:abc",
+					FromRef: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "builder:latest"},
+				},
+			},
+			expected: "Image change: registry/ns/builder@sha256:abc (ImageStreamTag builder:latest)",
+		},
+		{
+			cause: buildapi.BuildTriggerCause{
+				Message: buildapi.BuildTriggerCauseGithubMsg,
+				GitHubWebHook: &buildapi.GitHubWebHookCause{
+					Revision: &buildapi.SourceRevision{
+						Git: &buildapi.GitSourceRevision{
+							Commit:  "0123456789abcdef",
+							Message: "Fix the build\n\nDetails",
+							Author:  buildapi.SourceControlUser{Name: "Jane"},
+						},
+					},
+				},
+			},
+			expected: `GitHub WebHook: commit 0123456 "Fix the build" by Jane`,
+		},
+		{
+			cause: buildapi.BuildTriggerCause{
+				Message: buildapi.BuildTriggerCauseGitLabMsg,
+				GitLabWebHook: &buildapi.GitLabWebHookCause{
+					Revision: &buildapi.SourceRevision{
+						Git: &buildapi.GitSourceRevision{Commit: "fedcba9876543210", Message: "Update README"},
+					},
+				},
+			},
+			expected: `GitLab WebHook: commit fedcba9 "Update README"`,
+		},
+	}
+	for i, test := range tests {
+		if actual := describeBuildTriggerCause(test.cause); actual != test.expected {
+			t.Errorf("(%d) expected %q, got %q", i, test.expected, actual)
+		}
+	}
+}
+
 func TestDescribeBuildDuration(t *testing.T) {
 	type testBuild struct {
 		build  *buildapi.Build
@@ -28,6 +28,7 @@ import (
 var (
 	buildColumns            = []string{"NAME", "TYPE", "STATUS", "POD"}
 	buildConfigColumns      = []string{"NAME", "TYPE", "SOURCE"}
+	pipelineColumns         = []string{"NAME", "STAGES", "STATUS"}
 	imageColumns            = []string{"NAME", "DOCKER REF"}
 	imageStreamTagColumns   = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
 	imageStreamImageColumns = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
@@ -68,6 +69,8 @@ func NewHumanReadablePrinter(noHeaders, withNamespace, wide bool, columnLabels [
 	p.Handler(buildColumns, printBuildList)
 	p.Handler(buildConfigColumns, printBuildConfig)
 	p.Handler(buildConfigColumns, printBuildConfigList)
+	p.Handler(pipelineColumns, printPipeline)
+	p.Handler(pipelineColumns, printPipelineList)
 	p.Handler(imageColumns, printImage)
 	p.Handler(imageStreamTagColumns, printImageStreamTag)
 	p.Handler(imageStreamImageColumns, printImageStreamImage)
@@ -225,8 +228,15 @@ func printBuildConfig(bc *buildapi.BuildConfig, w io.Writer, withNamespace, wide
 	}
 
 	uri := "MISSING"
-	if bc.Spec.Source.Git != nil {
+	switch {
+	case bc.Spec.Source.Git != nil:
 		uri = bc.Spec.Source.Git.URI
+	case bc.Spec.Source.Binary != nil:
+		uri = "<binary>"
+	case bc.Spec.Source.Dockerfile != nil:
+		uri = "<dockerfile>"
+	case len(bc.Spec.Source.Images) > 0:
+		uri = "<image>"
 	}
 
 	if withNamespace {
@@ -247,6 +257,25 @@ func printBuildConfigList(buildList *buildapi.BuildConfigList, w io.Writer, with
 	return nil
 }
 
+func printPipeline(pipeline *buildapi.Pipeline, w io.Writer, withNamespace, wide bool, columnLabels []string) error {
+	if withNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", pipeline.Namespace); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%s\t%d\t%s\n", pipeline.Name, len(pipeline.Spec.Stages), pipeline.Status.Phase)
+	return err
+}
+
+func printPipelineList(list *buildapi.PipelineList, w io.Writer, withNamespace, wide bool, columnLabels []string) error {
+	for _, pipeline := range list.Items {
+		if err := printPipeline(&pipeline, w, withNamespace, wide, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printImage(image *imageapi.Image, w io.Writer, withNamespace, wide bool, columnLabels []string) error {
 	_, err := fmt.Fprintf(w, "%s\t%s\n", image.Name, image.DockerImageReference)
 	return err
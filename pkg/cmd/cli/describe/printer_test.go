@@ -22,6 +22,8 @@ import (
 var PrinterCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&imageapi.DockerImage{}), // not a top level resource
 	reflect.TypeOf(&buildapi.BuildLog{}),    // just a marker type
+	reflect.TypeOf(&deployapi.DeploymentLog{}),
+	reflect.TypeOf(&deployapi.DeploymentLogOptions{}),
 }
 
 // MissingPrinterCoverageExceptions is the list of types that were missing printer methods when I started
@@ -463,6 +463,9 @@ func describeBuildPhase(build *buildapi.Build, t *util.Time, parentName string,
 	case buildapi.BuildPhaseError:
 		return fmt.Sprintf("build %s stopped with an error %s ago%s%s", name, time, revision, imageStreamFailure)
 	case buildapi.BuildPhaseFailed:
+		if build.Status.Reason == buildapi.StatusReasonTimeout {
+			return fmt.Sprintf("build %s timed out %s ago%s%s", name, time, revision, imageStreamFailure)
+		}
 		return fmt.Sprintf("build %s failed %s ago%s%s", name, time, revision, imageStreamFailure)
 	default:
 		status := strings.ToLower(string(build.Status.Phase))
@@ -523,6 +526,12 @@ func describeSourceInPipeline(source *buildapi.BuildSource) (string, bool) {
 			return source.Git.URI, true
 		}
 		return fmt.Sprintf("%s#%s", source.Git.URI, source.Git.Ref), true
+	case buildapi.BuildSourceBinary:
+		return "binary input", true
+	case buildapi.BuildSourceDockerfile:
+		return "an inline Dockerfile", true
+	case buildapi.BuildSourceImage:
+		return "files from images", true
 	}
 	return "", false
 }
@@ -579,6 +588,9 @@ func describeDeploymentStatus(deploy *kapi.ReplicationController, first bool) st
 		// TODO: pod status output
 		return fmt.Sprintf("#%d deployed %s ago%s", version, timeAt, describePodSummaryInline(deploy, first))
 	case deployapi.DeploymentStatusRunning:
+		if progress := canaryProgress(deploy); len(progress) > 0 {
+			return fmt.Sprintf("#%d deployment running for %s, canary step %s%s", version, timeAt, progress, describePodSummaryInline(deploy, false))
+		}
 		return fmt.Sprintf("#%d deployment running for %s%s", version, timeAt, describePodSummaryInline(deploy, false))
 	default:
 		return fmt.Sprintf("#%d deployment %s %s ago%s", version, strings.ToLower(string(status)), timeAt, describePodSummaryInline(deploy, false))
@@ -10,7 +10,11 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
 
+	"github.com/openshift/origin/pkg/api/latest"
 	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	projectapi "github.com/openshift/origin/pkg/project/api"
 )
 
@@ -280,3 +284,17 @@ func TestProjectStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestDescribeDeploymentStatusCanary(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Template.Strategy = deploytest.OkCanaryStrategy()
+	deployment, _ := deployutil.MakeDeployment(config, latest.Codec)
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+	deployment.Annotations[deployapi.DeploymentCanaryStepAnnotation] = "1"
+	deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentWaitingForApproval
+
+	out := describeDeploymentStatus(deployment, true)
+	if e := "canary step 1 of 2 (waiting for approval)"; !strings.Contains(out, e) {
+		t.Errorf("expected %q in %q", e, out)
+	}
+}
@@ -8,14 +8,17 @@ import (
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client"
 	"k8s.io/kubernetes/pkg/kubectl"
 
 	"github.com/openshift/origin/pkg/api/latest"
+	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	"github.com/openshift/origin/pkg/deploy/strategy"
+	"github.com/openshift/origin/pkg/deploy/strategy/bluegreen"
 	"github.com/openshift/origin/pkg/deploy/strategy/recreate"
 	"github.com/openshift/origin/pkg/deploy/strategy/rolling"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
@@ -46,7 +49,7 @@ func NewCommandDeployer(name string) *cobra.Command {
 		Short: "Run the deployer",
 		Long:  deployerLong,
 		Run: func(c *cobra.Command, args []string) {
-			_, kClient, err := cfg.Config.Clients()
+			osClient, kClient, err := cfg.Config.Clients()
 			if err != nil {
 				glog.Fatal(err)
 			}
@@ -59,7 +62,7 @@ func NewCommandDeployer(name string) *cobra.Command {
 				glog.Fatal("namespace is required")
 			}
 
-			deployer := NewDeployer(kClient)
+			deployer := NewDeployer(osClient, kClient)
 			if err = deployer.Deploy(cfg.Namespace, cfg.DeploymentName); err != nil {
 				glog.Fatal(err)
 			}
@@ -76,8 +79,9 @@ func NewCommandDeployer(name string) *cobra.Command {
 	return cmd
 }
 
-// NewDeployer makes a new Deployer from a kube client.
-func NewDeployer(client kclient.Interface) *Deployer {
+// NewDeployer makes a new Deployer from a kube client and an OpenShift client
+// used for tagging images from lifecycle hooks.
+func NewDeployer(osClient osclient.Interface, client kclient.Interface) *Deployer {
 	scaler, _ := kubectl.ScalerFor("ReplicationController", kubectl.NewScalerClient(client))
 	return &Deployer{
 		getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
@@ -86,14 +90,25 @@ func NewDeployer(client kclient.Interface) *Deployer {
 		getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
 			return client.ReplicationControllers(namespace).List(deployutil.ConfigSelector(configName))
 		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			return client.Services(namespace).Get(name)
+		},
+		updateService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+			return client.Services(namespace).Update(service)
+		},
 		scaler: scaler,
 		strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
 			switch config.Template.Strategy.Type {
 			case deployapi.DeploymentStrategyTypeRecreate:
-				return recreate.NewRecreateDeploymentStrategy(client, latest.Codec), nil
+				return recreate.NewRecreateDeploymentStrategy(client, osClient, latest.Codec), nil
 			case deployapi.DeploymentStrategyTypeRolling:
-				recreate := recreate.NewRecreateDeploymentStrategy(client, latest.Codec)
-				return rolling.NewRollingDeploymentStrategy(config.Namespace, client, latest.Codec, recreate), nil
+				recreate := recreate.NewRecreateDeploymentStrategy(client, osClient, latest.Codec)
+				return rolling.NewRollingDeploymentStrategy(config.Namespace, client, osClient, latest.Codec, recreate), nil
+			case deployapi.DeploymentStrategyTypeCanary:
+				recreate := recreate.NewRecreateDeploymentStrategy(client, osClient, latest.Codec)
+				return rolling.NewCanaryDeploymentStrategy(config.Namespace, client, osClient, latest.Codec, recreate), nil
+			case deployapi.DeploymentStrategyTypeBlueGreen:
+				return bluegreen.NewBlueGreenDeploymentStrategy(client, osClient, latest.Codec), nil
 			default:
 				return nil, fmt.Errorf("unsupported strategy type: %s", config.Template.Strategy.Type)
 			}
@@ -107,7 +122,9 @@ func NewDeployer(client kclient.Interface) *Deployer {
 // 2. Find the last completed deployment.
 // 3. Scale down to 0 any old deployments which aren't the new deployment or
 // the last complete deployment.
-// 4. Pass the last completed deployment and the new deployment to a strategy
+// 4. Unpin the service of a last completed BlueGreen deployment when the new
+// deployment uses another strategy.
+// 5. Pass the last completed deployment and the new deployment to a strategy
 // to perform the deployment.
 type Deployer struct {
 	// strategyFor returns a DeploymentStrategy for config.
@@ -116,6 +133,10 @@ type Deployer struct {
 	getDeployment func(namespace, name string) (*kapi.ReplicationController, error)
 	// getDeployments finds all deployments associated with a config.
 	getDeployments func(namespace, configName string) (*kapi.ReplicationControllerList, error)
+	// getService knows how to get a service.
+	getService func(namespace, name string) (*kapi.Service, error)
+	// updateService knows how to update a service.
+	updateService func(namespace string, service *kapi.Service) (*kapi.Service, error)
 	// scaler is used to scale replication controllers.
 	scaler kubectl.Scaler
 }
@@ -190,6 +211,15 @@ func (d *Deployer) Deploy(namespace, deploymentName string) error {
 		}
 	}
 
+	// The BlueGreen strategy pins its service to the deployment it switched to.
+	// Other strategies expect the service to select the pods of every
+	// deployment, so release the service before handing over to them.
+	if from != nil && config.Template.Strategy.Type != deployapi.DeploymentStrategyTypeBlueGreen {
+		if err := d.unpinService(from); err != nil {
+			return err
+		}
+	}
+
 	// Perform the deployment.
 	if from == nil {
 		glog.Infof("Deploying %s for the first time (replicas: %d)", deployutil.LabelForDeployment(to), desiredReplicas)
@@ -198,3 +228,38 @@ func (d *Deployer) Deploy(namespace, deploymentName string) error {
 	}
 	return strategy.Deploy(from, to, desiredReplicas)
 }
+
+// unpinService removes the deployment label the BlueGreen strategy added to
+// the selector of its service, if from was deployed with that strategy.
+func (d *Deployer) unpinService(from *kapi.ReplicationController) error {
+	fromConfig, err := deployutil.DecodeDeploymentConfig(from, latest.Codec)
+	if err != nil {
+		return fmt.Errorf("couldn't decode deployment config from deployment %s: %v", deployutil.LabelForDeployment(from), err)
+	}
+	params := fromConfig.Template.Strategy.BlueGreenParams
+	if fromConfig.Template.Strategy.Type != deployapi.DeploymentStrategyTypeBlueGreen || params == nil {
+		return nil
+	}
+	service, err := d.getService(from.Namespace, params.ServiceName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("couldn't get service %s/%s: %v", from.Namespace, params.ServiceName, err)
+	}
+	if _, pinned := service.Spec.Selector[deployapi.DeploymentLabel]; !pinned {
+		return nil
+	}
+	selector := map[string]string{}
+	for k, v := range service.Spec.Selector {
+		if k != deployapi.DeploymentLabel {
+			selector[k] = v
+		}
+	}
+	service.Spec.Selector = selector
+	if _, err := d.updateService(service.Namespace, service); err != nil {
+		return fmt.Errorf("couldn't unpin service %s/%s from %s: %v", service.Namespace, service.Name, deployutil.LabelForDeployment(from), err)
+	}
+	glog.Infof("Unpinned service %s from %s", service.Name, deployutil.LabelForDeployment(from))
+	return nil
+}
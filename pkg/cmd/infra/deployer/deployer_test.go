@@ -189,6 +189,92 @@ func TestDeployer_deployScenarios(t *testing.T) {
 	}
 }
 
+func TestDeployer_unpinBlueGreenService(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		fromStrategy  deployapi.DeploymentStrategy
+		toStrategy    deployapi.DeploymentStrategy
+		unpinExpected bool
+	}{
+		{"blue-green to rolling", deploytest.OkBlueGreenStrategy(), deploytest.OkRollingStrategy(), true},
+		{"blue-green to recreate", deploytest.OkBlueGreenStrategy(), deploytest.OkStrategy(), true},
+		{"blue-green to blue-green", deploytest.OkBlueGreenStrategy(), deploytest.OkBlueGreenStrategy(), false},
+		{"rolling to recreate", deploytest.OkRollingStrategy(), deploytest.OkStrategy(), false},
+	}
+
+	for _, s := range scenarios {
+		t.Logf("executing scenario %s", s.name)
+
+		fromConfig := deploytest.OkDeploymentConfig(1)
+		fromConfig.Template.Strategy = s.fromStrategy
+		from, _ := deployutil.MakeDeployment(fromConfig, kapi.Codec)
+		from.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+		toConfig := deploytest.OkDeploymentConfig(2)
+		toConfig.Template.Strategy = s.toStrategy
+		to, _ := deployutil.MakeDeployment(toConfig, kapi.Codec)
+		to.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusNew)
+		to.Annotations[deployapi.DesiredReplicasAnnotation] = "1"
+
+		service := &kapi.Service{
+			ObjectMeta: kapi.ObjectMeta{Name: "service", Namespace: from.Namespace},
+			Spec: kapi.ServiceSpec{
+				Selector: map[string]string{"app": "test", deployapi.DeploymentLabel: from.Name},
+			},
+		}
+		var updated *kapi.Service
+		unpinnedBeforeDeploy := false
+
+		deployer := &Deployer{
+			strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
+				return &testStrategy{
+					deployFunc: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+						unpinnedBeforeDeploy = updated != nil
+						return nil
+					},
+				}, nil
+			},
+			getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return to, nil
+			},
+			getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*from, *to}}, nil
+			},
+			getService: func(namespace, name string) (*kapi.Service, error) {
+				return service, nil
+			},
+			updateService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+				updated = service
+				return service, nil
+			},
+			scaler: &scalertest.FakeScaler{},
+		}
+
+		if err := deployer.Deploy(to.Namespace, to.Name); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !s.unpinExpected {
+			if updated != nil {
+				t.Errorf("unexpected service update: %#v", updated.Spec.Selector)
+			}
+			continue
+		}
+		if updated == nil {
+			t.Errorf("expected the service to be unpinned")
+			continue
+		}
+		if !unpinnedBeforeDeploy {
+			t.Errorf("expected the service to be unpinned before the deployment")
+		}
+		if _, pinned := updated.Spec.Selector[deployapi.DeploymentLabel]; pinned {
+			t.Errorf("expected the deployment label to be removed, got %#v", updated.Spec.Selector)
+		}
+		if e, a := "test", updated.Spec.Selector["app"]; e != a {
+			t.Errorf("expected selector app=%s to be kept, got %q", e, a)
+		}
+	}
+}
+
 func mkdeployment(version int, status deployapi.DeploymentStatus) *kapi.ReplicationController {
 	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codec)
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
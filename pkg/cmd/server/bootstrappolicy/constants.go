@@ -15,6 +15,7 @@ const (
 	InfraBuildControllerServiceAccountName       = "build-controller"
 	InfraReplicationControllerServiceAccountName = "replication-controller"
 	InfraDeploymentControllerServiceAccountName  = "deployment-controller"
+	InfraPipelineControllerServiceAccountName    = "pipeline-controller"
 
 	MasterUnqualifiedUsername   = "openshift-master"
 	RouterUnqualifiedUsername   = "openshift-router"
@@ -53,6 +54,7 @@ const (
 	BuildControllerRoleName       = "system:build-controller"
 	ReplicationControllerRoleName = "system:replication-controller"
 	DeploymentControllerRoleName  = "system:deployment-controller"
+	PipelineControllerRoleName    = "system:pipeline-controller"
 
 	ImagePullerRoleName       = "system:image-puller"
 	ImageBuilderRoleName      = "system:image-builder"
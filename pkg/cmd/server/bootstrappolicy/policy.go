@@ -209,9 +209,20 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				{
 					// RecreateDeploymentStrategy.hookExecutor
 					// RollingDeploymentStrategy.hookExecutor
+					// BlueGreenDeploymentStrategy.hookExecutor
 					Verbs:     util.NewStringSet("get", "list", "watch", "create"),
 					Resources: util.NewStringSet("pods"),
 				},
+				{
+					// BlueGreenDeploymentStrategy.updateService
+					Verbs:     util.NewStringSet("get", "update"),
+					Resources: util.NewStringSet("services"),
+				},
+				{
+					// HookExecutor.tagImages
+					Verbs:     util.NewStringSet("get", "create", "update"),
+					Resources: util.NewStringSet("imagestreams"),
+				},
 			},
 		},
 		{
@@ -272,7 +283,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				},
 				// DeploymentControllerFactory.deploymentClient
 				{
-					Verbs:     util.NewStringSet("get", "update"),
+					Verbs:     util.NewStringSet("get", "update", "delete"),
 					Resources: util.NewStringSet("replicationcontrollers"),
 				},
 				// DeploymentController.podClient
@@ -285,6 +296,58 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     util.NewStringSet("create", "update"),
 					Resources: util.NewStringSet("events"),
 				},
+				// DeploymentController.deploymentConfigClient
+				{
+					Verbs:     util.NewStringSet("get", "update"),
+					Resources: util.NewStringSet("deploymentconfigs"),
+				},
+			},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: PipelineControllerRoleName,
+			},
+			Rules: []authorizationapi.PolicyRule{
+				// PipelineControllerFactory.pipelineLW
+				// PipelineController.HandlePipeline
+				{
+					Verbs:     util.NewStringSet("list", "watch", "update"),
+					Resources: util.NewStringSet("pipelines"),
+				},
+				// PipelineController.handleBuildStage
+				// PipelineController.addBuildOutputs
+				{
+					Verbs:     util.NewStringSet("get"),
+					Resources: util.NewStringSet("builds", "buildconfigs"),
+				},
+				{
+					Verbs:     util.NewStringSet("create"),
+					Resources: util.NewStringSet("buildconfigs/instantiate"),
+				},
+				// PipelineController.handleDeployStage
+				{
+					Verbs:     util.NewStringSet("get", "update"),
+					Resources: util.NewStringSet("deploymentconfigs"),
+				},
+				{
+					Verbs:     util.NewStringSet("get"),
+					Resources: util.NewStringSet("replicationcontrollers"),
+				},
+				// PipelineController.handleVerifyStage
+				{
+					Verbs:     util.NewStringSet("get", "create"),
+					Resources: util.NewStringSet("pods"),
+				},
+				// PipelineController.isTriggerCurrent
+				// PipelineController.handlePromoteStage
+				{
+					Verbs:     util.NewStringSet("get", "update"),
+					Resources: util.NewStringSet("imagestreams"),
+				},
+				{
+					Verbs:     util.NewStringSet("get"),
+					Resources: util.NewStringSet("imagestreamtags"),
+				},
 			},
 		},
 		{
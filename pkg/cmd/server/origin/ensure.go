@@ -47,7 +47,7 @@ func (c *MasterConfig) ensureOpenShiftInfraNamespace() {
 	}
 
 	// Ensure service accounts exist
-	serviceAccounts := []string{c.BuildControllerServiceAccount, c.DeploymentControllerServiceAccount, c.ReplicationControllerServiceAccount}
+	serviceAccounts := []string{c.BuildControllerServiceAccount, c.DeploymentControllerServiceAccount, c.ReplicationControllerServiceAccount, c.PipelineControllerServiceAccount}
 	for _, serviceAccountName := range serviceAccounts {
 		_, err := c.KubeClient().ServiceAccounts(ns).Create(&kapi.ServiceAccount{ObjectMeta: kapi.ObjectMeta{Name: serviceAccountName}})
 		if err != nil && !kapierror.IsAlreadyExists(err) {
@@ -60,6 +60,7 @@ func (c *MasterConfig) ensureOpenShiftInfraNamespace() {
 		bootstrappolicy.BuildControllerRoleName:       {serviceaccount.MakeUsername(ns, c.BuildControllerServiceAccount)},
 		bootstrappolicy.DeploymentControllerRoleName:  {serviceaccount.MakeUsername(ns, c.DeploymentControllerServiceAccount)},
 		bootstrappolicy.ReplicationControllerRoleName: {serviceaccount.MakeUsername(ns, c.ReplicationControllerServiceAccount)},
+		bootstrappolicy.PipelineControllerRoleName:    {serviceaccount.MakeUsername(ns, c.PipelineControllerServiceAccount)},
 	}
 	roleAccessor := policy.NewClusterRoleBindingAccessor(c.ServiceAccountRoleBindingClient())
 	for clusterRole, usernames := range clusterRolesToUsernames {
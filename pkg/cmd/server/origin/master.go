@@ -18,6 +18,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/apiserver"
 	kclient "k8s.io/kubernetes/pkg/client"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	kmaster "k8s.io/kubernetes/pkg/master"
 	"k8s.io/kubernetes/pkg/util"
 
@@ -31,13 +32,18 @@ import (
 	buildconfigregistry "github.com/openshift/origin/pkg/build/registry/buildconfig"
 	buildconfigetcd "github.com/openshift/origin/pkg/build/registry/buildconfig/etcd"
 	buildlogregistry "github.com/openshift/origin/pkg/build/registry/buildlog"
+	pipelineetcd "github.com/openshift/origin/pkg/build/registry/pipeline/etcd"
 	"github.com/openshift/origin/pkg/build/webhook"
+	"github.com/openshift/origin/pkg/build/webhook/bitbucket"
 	"github.com/openshift/origin/pkg/build/webhook/generic"
 	"github.com/openshift/origin/pkg/build/webhook/github"
+	"github.com/openshift/origin/pkg/build/webhook/gitlab"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	deployconfiggenerator "github.com/openshift/origin/pkg/deploy/generator"
 	deployconfigregistry "github.com/openshift/origin/pkg/deploy/registry/deployconfig"
 	deployconfigetcd "github.com/openshift/origin/pkg/deploy/registry/deployconfig/etcd"
+	deploylogregistry "github.com/openshift/origin/pkg/deploy/registry/deploylog"
 	deployrollback "github.com/openshift/origin/pkg/deploy/registry/rollback"
 	"github.com/openshift/origin/pkg/image/registry/image"
 	imageetcd "github.com/openshift/origin/pkg/image/registry/image/etcd"
@@ -384,6 +390,12 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		},
 		ServiceAccounts: c.KubeClient(),
 		Secrets:         c.KubeClient(),
+		// the master serves the webhooks and runs the image change controller,
+		// the other controllers run as service accounts of the infra namespace
+		TriggerCauseGroups: util.NewStringSet(
+			bootstrappolicy.MastersGroup,
+			serviceaccount.MakeNamespaceGroupName(c.Options.PolicyConfig.OpenShiftInfrastructureNamespace),
+		),
 	}
 
 	// TODO: with sharding, this needs to be changed
@@ -416,8 +428,10 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		buildConfigRegistry,
 		buildclient.NewOSClientBuildConfigInstantiatorClient(bcClient),
 		map[string]webhook.Plugin{
-			"generic": generic.New(),
-			"github":  github.New(),
+			"generic":   generic.New(),
+			"github":    github.New(),
+			"gitlab":    gitlab.New(),
+			"bitbucket": bitbucket.New(),
 		},
 	)
 
@@ -431,6 +445,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 
 		"deploymentConfigs":         deployConfigStorage,
 		"generateDeploymentConfigs": deployconfiggenerator.NewREST(deployConfigGenerator, c.EtcdHelper.Codec()),
+		"deploymentConfigs/log":     deploylogregistry.NewREST(deployConfigRegistry, c.DeploymentLogClient(), kubeletClient),
 		"deploymentConfigRollbacks": deployrollback.NewREST(deployRollbackClient, c.EtcdHelper.Codec()),
 
 		"processedTemplates": templateregistry.NewREST(),
@@ -475,6 +490,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		storage["buildConfigs/webhooks"] = buildConfigWebHooks
 		storage["builds/clone"] = buildclonestorage.NewStorage(buildGenerator)
 		storage["buildConfigs/instantiate"] = buildinstantiatestorage.NewStorage(buildGenerator)
+		storage["pipelines"] = pipelineetcd.NewStorage(c.EtcdHelper)
 		storage["builds/log"] = buildlogregistry.NewREST(buildRegistry, c.BuildLogClient(), kubeletClient)
 	}
 
@@ -126,6 +126,8 @@ type MasterConfig struct {
 	DeploymentControllerServiceAccount string
 	// ReplicationControllerServiceAccount is the name of the service account in the infra namespace to use to run the replication controller
 	ReplicationControllerServiceAccount string
+	// PipelineControllerServiceAccount is the name of the service account in the infra namespace to use to run the pipeline controller
+	PipelineControllerServiceAccount string
 }
 
 func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
@@ -215,6 +217,7 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		BuildControllerServiceAccount:       bootstrappolicy.InfraBuildControllerServiceAccountName,
 		DeploymentControllerServiceAccount:  bootstrappolicy.InfraDeploymentControllerServiceAccountName,
 		ReplicationControllerServiceAccount: bootstrappolicy.InfraReplicationControllerServiceAccountName,
+		PipelineControllerServiceAccount:    bootstrappolicy.InfraPipelineControllerServiceAccountName,
 	}
 
 	return config, nil
@@ -397,6 +400,11 @@ func (c *MasterConfig) BuildLogClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
 }
 
+// DeploymentLogClient returns the deployment log client object
+func (c *MasterConfig) DeploymentLogClient() *kclient.Client {
+	return c.PrivilegedLoopbackKubernetesClient
+}
+
 // BuildConfigWebHookClient returns the webhook client object
 func (c *MasterConfig) BuildConfigWebHookClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
@@ -426,6 +434,15 @@ func (c *MasterConfig) BuildConfigChangeControllerClients() (*osclient.Client, *
 	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
 }
 
+// PipelineControllerClients returns the pipeline controller client objects
+func (c *MasterConfig) PipelineControllerClients() (*osclient.Client, *kclient.Client) {
+	osClient, kClient, err := c.GetServiceAccountClients(c.PipelineControllerServiceAccount)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	return osClient, kClient
+}
+
 // ImageChangeControllerClient returns the openshift client object
 func (c *MasterConfig) ImageChangeControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
@@ -252,9 +252,16 @@ func (c *MasterConfig) RunBuildConfigChangeController() {
 	factory.Create().Run()
 }
 
+// RunPipelineController starts the pipeline controller process.
+func (c *MasterConfig) RunPipelineController() {
+	osclient, kclient := c.PipelineControllerClients()
+	factory := buildcontrollerfactory.PipelineControllerFactory{Client: osclient, KubeClient: kclient}
+	factory.Create().Run()
+}
+
 // RunDeploymentController starts the deployment controller process.
 func (c *MasterConfig) RunDeploymentController() {
-	_, kclient := c.DeploymentControllerClients()
+	osclient, kclient := c.DeploymentControllerClients()
 
 	_, kclientConfig, err := configapi.GetKubeClient(c.Options.MasterClients.OpenShiftLoopbackKubeConfig)
 	if err != nil {
@@ -269,6 +276,7 @@ func (c *MasterConfig) RunDeploymentController() {
 	)
 
 	factory := deploycontroller.DeploymentControllerFactory{
+		Client:         osclient,
 		KubeClient:     kclient,
 		Codec:          c.EtcdHelper.Codec(),
 		Environment:    env,
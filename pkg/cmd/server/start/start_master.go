@@ -537,6 +537,7 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 		oc.RunBuildPodController()
 		oc.RunBuildConfigChangeController()
 		oc.RunBuildImageChangeTriggerController()
+		oc.RunPipelineController()
 	}
 	oc.RunBuildController()
 	oc.RunBuildPodController()
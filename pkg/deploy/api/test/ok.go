@@ -67,6 +67,37 @@ func OkRollingStrategy() deployapi.DeploymentStrategy {
 	}
 }
 
+func OkBlueGreenStrategy() deployapi.DeploymentStrategy {
+	timeout := int64(20)
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeBlueGreen,
+		BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{
+			ServiceName:    "service",
+			TimeoutSeconds: &timeout,
+		},
+	}
+}
+
+func OkCanaryStrategy() deployapi.DeploymentStrategy {
+	mkintp := func(i int) *int64 {
+		v := int64(i)
+		return &v
+	}
+	one, half, restarts := 1, 50, 3
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeCanary,
+		CanaryParams: &deployapi.CanaryDeploymentStrategyParams{
+			Steps: []deployapi.CanaryStep{
+				{Replicas: &one},
+				{Percent: &half},
+			},
+			IntervalSeconds:      mkintp(1),
+			TimeoutSeconds:       mkintp(20),
+			MaxContainerRestarts: &restarts,
+		},
+	}
+}
+
 func OkControllerTemplate() kapi.ReplicationControllerSpec {
 	return kapi.ReplicationControllerSpec{
 		Replicas: 1,
@@ -2,6 +2,7 @@ package api
 
 import (
 	kapi "k8s.io/kubernetes/pkg/api"
+	kutil "k8s.io/kubernetes/pkg/util"
 )
 
 // DeploymentStatus describes the possible states a deployment can be in.
@@ -32,6 +33,10 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements
 }
@@ -46,6 +51,12 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen scales up the new deployment next to the old one
+	// and switches a service over once the new deployment is ready.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
+	// DeploymentStrategyTypeCanary shifts replicas from the old deployment to the new one
+	// in configured steps, pausing between them.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -64,6 +75,11 @@ type RecreateDeploymentStrategyParams struct {
 	// Pre is a lifecycle hook which is executed before the strategy manipulates
 	// the deployment. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook
+	// Mid is a lifecycle hook which is executed after the old deployment is
+	// scaled down to zero and before the new deployment is scaled up, so that no
+	// pods are running. Only the Recreate strategy supports a mid hook. All
+	// LifecycleHookFailurePolicy values are supported.
+	Mid *LifecycleHook
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -76,6 +92,10 @@ type LifecycleHook struct {
 	FailurePolicy LifecycleHookFailurePolicy
 	// ExecNewPod specifies the options for a lifecycle hook backed by a pod.
 	ExecNewPod *ExecNewPodHook
+	// TagImages instructs the deployer to tag the current image referenced
+	// by the named containers onto image stream tags. The deployer performs
+	// the tagging itself, no pod is launched.
+	TagImages []TagImageHook
 }
 
 // LifecycleHookFailurePolicy describes possibles actions to take if a hook fails.
@@ -101,6 +121,22 @@ type ExecNewPodHook struct {
 	// ContainerName is the name of a container in the deployment pod template
 	// whose Docker image will be used for the hook pod's container.
 	ContainerName string
+	// Volumes is a list of named volumes from the deployment pod template
+	// which should be included in the hook pod. The volumes are mounted where
+	// the container named by ContainerName mounts them.
+	Volumes []string
+}
+
+// TagImageHook is a request to tag the image of a container in the
+// deployment pod template onto an ImageStreamTag.
+type TagImageHook struct {
+	// ContainerName is the name of a container in the deployment pod template
+	// whose image is tagged.
+	ContainerName string
+	// To is the target ImageStreamTag. Kind must be ImageStreamTag and Name
+	// must be of the form <stream>:<tag>. If Namespace is empty, the
+	// namespace of the deployment is used.
+	To kapi.ObjectReference
 }
 
 // RollingDeploymentStrategyParams are the input to the Rolling deployment
@@ -118,9 +154,34 @@ type RollingDeploymentStrategyParams struct {
 	// UpdatePercent is the percentage of replicas to scale up or down each
 	// interval. If nil, one replica will be scaled up and down each interval.
 	UpdatePercent *int
+	// MaxUnavailable is the maximum number of pods that can be unavailable
+	// during the update. Value can be an absolute number (ex: 5) or a
+	// percentage of the desired pods (ex: 10%). The absolute number is
+	// calculated from the percentage by rounding down. Defaults to 0 if
+	// MaxSurge or MinReadySeconds is set.
+	//
+	// If MaxUnavailable, MaxSurge and MinReadySeconds are all unset, replicas
+	// are replaced as described by UpdatePercent.
+	MaxUnavailable *kutil.IntOrString
+	// MaxSurge is the maximum number of pods that can be scheduled above the
+	// desired number of pods. Value can be an absolute number (ex: 5) or a
+	// percentage of the desired pods (ex: 10%). The absolute number is
+	// calculated from the percentage by rounding up. Defaults to 1 if
+	// MaxUnavailable or MinReadySeconds is set.
+	MaxSurge *kutil.IntOrString
+	// MinReadySeconds is the minimum number of seconds for which a new pod
+	// must be ready before it is considered available. Scale steps only
+	// proceed once pods are available, and the deployment fails if no new
+	// pod becomes available within TimeoutSeconds.
+	MinReadySeconds int64
 	// Pre is a lifecycle hook which is executed before the deployment process
 	// begins. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook
+	// Mid is not supported by the Rolling strategy, since pods of the old and
+	// the new deployment are running at the same time. Use the Recreate
+	// strategy for a hook that runs while no pods are deployed, such as a
+	// schema migration. Deployment configs that set it are rejected.
+	Mid *LifecycleHook
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -136,6 +197,87 @@ const (
 	DefaultRollingUpdatePeriodSeconds int64 = 1
 )
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// ServiceName is the name of the service which is switched from the old
+	// deployment to the new one. Until the switch, the service selects only the
+	// pods of the old deployment. The strategy pins the service to a deployment
+	// by adding the deployment label to its selector; the label is removed again
+	// when a later deployment of the config uses another strategy.
+	ServiceName string
+	// TimeoutSeconds is the time to wait for the pods of the new deployment to
+	// become ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// Pre is a lifecycle hook which is executed before the new deployment is
+	// scaled up. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Verify is a lifecycle hook which is executed once the new deployment is
+	// ready and before the service is switched to it. If it fails, the service
+	// keeps selecting the old deployment. All LifecycleHookFailurePolicy values
+	// are supported.
+	Verify *LifecycleHook
+	// Post is a lifecycle hook which is executed after the service was switched
+	// to the new deployment. The LifecycleHookFailurePolicyAbort policy is NOT
+	// supported.
+	Post *LifecycleHook
+}
+
+// DefaultBlueGreenTimeoutSeconds is the default TimeoutSeconds for BlueGreenDeploymentStrategyParams.
+const DefaultBlueGreenTimeoutSeconds int64 = 10 * 60
+
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// Steps are the stages of the deployment, in order. Each step scales the new
+	// deployment up to a share of the desired replicas and scales the old one
+	// down by the same amount. After the last step, the rest of the deployment
+	// is rolled out like a Rolling deployment.
+	Steps []CanaryStep
+	// IntervalSeconds is the time to wait between polling deployment status,
+	// approvals and pod restarts. If the value is nil, a default will be used.
+	IntervalSeconds *int64
+	// TimeoutSeconds is the time to wait for the pods of a step to become ready
+	// before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// MaxContainerRestarts is the number of restarts of any container of the new
+	// deployment after which the pods are considered crash looping and the
+	// deployment is aborted. If the value is nil, a default will be used.
+	MaxContainerRestarts *int
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook
+}
+
+// CanaryStep is a single stage of a Canary deployment. Exactly one of Percent
+// and Replicas must be set.
+type CanaryStep struct {
+	// Percent is the share of the desired replicas the new deployment is scaled
+	// up to, between 1 and 100.
+	Percent *int
+	// Replicas is the number of replicas the new deployment is scaled up to.
+	Replicas *int
+	// PauseSeconds is the time to wait once the pods of the step are ready
+	// before the next step starts.
+	PauseSeconds *int64
+	// ManualApproval makes the deployment wait once the pods of the step are
+	// ready until the step is approved with the DeploymentCanaryApprovedStepAnnotation.
+	ManualApproval bool
+}
+
+const (
+	// DefaultCanaryIntervalSeconds is the default IntervalSeconds for CanaryDeploymentStrategyParams.
+	DefaultCanaryIntervalSeconds int64 = 1
+	// DefaultCanaryTimeoutSeconds is the default TimeoutSeconds for CanaryDeploymentStrategyParams.
+	DefaultCanaryTimeoutSeconds int64 = 10 * 60
+	// DefaultCanaryMaxContainerRestarts is the default MaxContainerRestarts for CanaryDeploymentStrategyParams.
+	DefaultCanaryMaxContainerRestarts = 3
+)
+
 // These constants represent keys used for correlating objects related to deployments.
 const (
 	// DeploymentConfigAnnotation is an annotation name used to correlate a deployment with the
@@ -182,6 +324,18 @@ const (
 	// DeploymentCancelledAnnotation indicates that the deployment has been cancelled
 	// The annotation value does not matter and its mere presence indicates cancellation
 	DeploymentCancelledAnnotation = "openshift.io/deployment.cancelled"
+	// DeploymentCanaryStepAnnotation is an annotation on a deployment (a ReplicationController)
+	// made with the Canary strategy. The annotation value is the number of the step, starting
+	// from 1, the deployment is in.
+	DeploymentCanaryStepAnnotation = "openshift.io/deployment.canary-step"
+	// DeploymentCanaryApprovedStepAnnotation is an annotation on a deployment made with the
+	// Canary strategy. The annotation value is the number of the last step which was approved
+	// to continue.
+	DeploymentCanaryApprovedStepAnnotation = "openshift.io/deployment.canary-approved-step"
+	// DeploymentConfigRollbackTargetAnnotation is an annotation on a DeploymentConfig made by
+	// a rollback. The annotation value is the name of the deployment the config was most
+	// recently rolled back to, which is never removed to enforce the RevisionHistoryLimit.
+	DeploymentConfigRollbackTargetAnnotation = "openshift.io/deployment-config.rollback-target"
 )
 
 // These constants represent the various reasons for cancelling a deployment
@@ -191,6 +345,7 @@ const (
 	DeploymentCancelledNewerDeploymentExists  = "The deployment was cancelled as a newer deployment was found running"
 	DeploymentFailedUnrelatedDeploymentExists = "The deployment failed as an unrelated pod with the same name as this deployment is already running"
 	DeploymentFailedDeployerPodNoLongerExists = "The deployment failed as the deployer pod no longer exists"
+	DeploymentWaitingForApproval              = "The deployment is waiting for the current canary step to be approved"
 )
 
 // MaxDeploymentDurationSeconds represents the maximum duration that a deployment is allowed to run
@@ -213,6 +368,17 @@ type DeploymentConfig struct {
 	// are defined, a new deployment can only occur as a result of an explicit client update to the
 	// DeploymentConfig with a new LatestVersion.
 	Triggers []DeploymentTriggerPolicy
+	// Paused indicates that triggers don't result in new deployments. Changes made to a paused
+	// DeploymentConfig are deployed together once it is resumed.
+	Paused bool
+	// AutoRollback indicates that when a deployment fails, the last successful deployment is
+	// scaled back up and the DeploymentConfig is rolled back to it.
+	AutoRollback bool
+	// RevisionHistoryLimit is the number of old complete and the number of old failed deployments
+	// to retain. Older deployments are deleted once a deployment of the DeploymentConfig succeeds.
+	// The deployment targeted by the most recent rollback is never deleted. If nil, all old
+	// deployments are retained.
+	RevisionHistoryLimit *int
 	// Template represents a desired deployment state and how to deploy it.
 	Template DeploymentTemplate
 	// LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig
@@ -252,6 +418,9 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerRollback is the cause of deployments created by an automatic rollback
+	// after a failed deployment. It can't be used as a trigger.
+	DeploymentTriggerRollback DeploymentTriggerType = "Rollback"
 )
 
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
@@ -328,3 +497,26 @@ type DeploymentConfigRollbackSpec struct {
 	// IncludeStrategy specifies whether to include the deployment Strategy.
 	IncludeStrategy bool
 }
+
+// DeploymentLog is the (unused) resource associated with the deployment log redirector
+type DeploymentLog struct {
+	kapi.TypeMeta
+	kapi.ListMeta
+}
+
+// DeploymentLogOptions is the REST options for a deployment log
+type DeploymentLogOptions struct {
+	kapi.TypeMeta
+
+	// Follow if true indicates that the deployment log should be streamed until
+	// the deployment terminates.
+	Follow bool
+
+	// NoWait if true causes the call to return immediately even if the deployment
+	// is not available yet. Otherwise the server will wait until the deployment has started.
+	NoWait bool
+
+	// Version of the deployment for which to view logs. If 0, the log of the latest
+	// deployment is returned.
+	Version int
+}
@@ -38,6 +38,25 @@ func init() {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *BlueGreenDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultBlueGreenTimeoutSeconds)
+			}
+		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.IntervalSeconds == nil {
+				obj.IntervalSeconds = mkintp(deployapi.DefaultCanaryIntervalSeconds)
+			}
+
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultCanaryTimeoutSeconds)
+			}
+
+			if obj.MaxContainerRestarts == nil {
+				restarts := deployapi.DefaultCanaryMaxContainerRestarts
+				obj.MaxContainerRestarts = &restarts
+			}
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -20,6 +20,11 @@ func convert_v1beta3_DeploymentConfig_To_api_DeploymentConfig(in *DeploymentConf
 	if err := s.Convert(&in.Spec.Triggers, &out.Triggers, 0); err != nil {
 		return err
 	}
+	out.Paused = in.Spec.Paused
+	out.AutoRollback = in.Spec.AutoRollback
+	if err := s.Convert(&in.Spec.RevisionHistoryLimit, &out.RevisionHistoryLimit, 0); err != nil {
+		return err
+	}
 	out.LatestVersion = in.Status.LatestVersion
 	if err := s.Convert(&in.Status.Details, &out.Details, 0); err != nil {
 		return err
@@ -37,6 +42,11 @@ func convert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in *newer.Deployme
 	if err := s.Convert(&in.Triggers, &out.Spec.Triggers, 0); err != nil {
 		return err
 	}
+	out.Spec.Paused = in.Paused
+	out.Spec.AutoRollback = in.AutoRollback
+	if err := s.Convert(&in.RevisionHistoryLimit, &out.Spec.RevisionHistoryLimit, 0); err != nil {
+		return err
+	}
 	out.Status.LatestVersion = in.LatestVersion
 	if err := s.Convert(&in.Details, &out.Status.Details, 0); err != nil {
 		return err
@@ -76,6 +86,12 @@ func convert_v1beta3_DeploymentStrategy_To_api_DeploymentStrategy(in *Deployment
 	if err := s.Convert(&in.RollingParams, &out.RollingParams, 0); err != nil {
 		return err
 	}
+	if err := s.Convert(&in.BlueGreenParams, &out.BlueGreenParams, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.CanaryParams, &out.CanaryParams, 0); err != nil {
+		return err
+	}
 	if err := s.Convert(&in.Resources, &out.Resources, 0); err != nil {
 		return err
 	}
@@ -95,6 +111,12 @@ func convert_api_DeploymentStrategy_To_v1beta3_DeploymentStrategy(in *newer.Depl
 	if err := s.Convert(&in.RollingParams, &out.RollingParams, 0); err != nil {
 		return err
 	}
+	if err := s.Convert(&in.BlueGreenParams, &out.BlueGreenParams, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.CanaryParams, &out.CanaryParams, 0); err != nil {
+		return err
+	}
 	if err := s.Convert(&in.Resources, &out.Resources, 0); err != nil {
 		return err
 	}
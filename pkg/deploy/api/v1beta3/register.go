@@ -9,9 +9,13 @@ func init() {
 		&DeploymentConfig{},
 		&DeploymentConfigList{},
 		&DeploymentConfigRollback{},
+		&DeploymentLog{},
+		&DeploymentLogOptions{},
 	)
 }
 
 func (*DeploymentConfig) IsAnAPIObject()         {}
 func (*DeploymentConfigList) IsAnAPIObject()     {}
 func (*DeploymentConfigRollback) IsAnAPIObject() {}
+func (*DeploymentLog) IsAnAPIObject()            {}
+func (*DeploymentLogOptions) IsAnAPIObject()     {}
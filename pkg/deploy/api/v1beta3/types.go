@@ -2,6 +2,7 @@ package v1beta3
 
 import (
 	kapi "k8s.io/kubernetes/pkg/api/v1beta3"
+	kutil "k8s.io/kubernetes/pkg/util"
 )
 
 // DeploymentPhase describes the possible states a deployment can be in.
@@ -32,6 +33,10 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty" description:"input to the Recreate deployment strategy"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty" description:"input to the Rolling deployment strategy"`
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams `json:"blueGreenParams,omitempty" description:"input to the BlueGreen deployment strategy"`
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams `json:"canaryParams,omitempty" description:"input to the Canary deployment strategy"`
 	// Compute resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements `json:"resources,omitempty" description:"resource requirements to execute the deployment"`
 }
@@ -46,6 +51,12 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen scales up the new deployment next to the old one
+	// and switches a service over once the new deployment is ready.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
+	// DeploymentStrategyTypeCanary shifts replicas from the old deployment to the new one
+	// in configured steps, pausing between them.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomParams are the input to the Custom deployment strategy.
@@ -64,6 +75,11 @@ type RecreateDeploymentStrategyParams struct {
 	// Pre is a lifecycle hook which is executed before the strategy manipulates
 	// the deployment. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Mid is a lifecycle hook which is executed after the old deployment is
+	// scaled down to zero and before the new deployment is scaled up, so that no
+	// pods are running. Only the Recreate strategy supports a mid hook. All
+	// LifecycleHookFailurePolicy values are supported.
+	Mid *LifecycleHook `json:"mid,omitempty" description:"a hook executed after the old deployment is scaled down and before the new deployment is scaled up"`
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -76,6 +92,10 @@ type LifecycleHook struct {
 	FailurePolicy LifecycleHookFailurePolicy `json:"failurePolicy" description:"what action to take if the hook fails"`
 	// ExecNewPod specifies the options for a lifecycle hook backed by a pod.
 	ExecNewPod *ExecNewPodHook `json:"execNewPod,omitempty" description:"options for an ExecNewPodHook"`
+	// TagImages instructs the deployer to tag the current image referenced
+	// by the named containers onto image stream tags. The deployer performs
+	// the tagging itself, no pod is launched.
+	TagImages []TagImageHook `json:"tagImages,omitempty" description:"images to tag onto image stream tags without launching a pod"`
 }
 
 // HandlerFailurePolicy describes possibles actions to take if a hook fails.
@@ -101,6 +121,22 @@ type ExecNewPodHook struct {
 	// ContainerName is the name of a container in the deployment pod template
 	// whose Docker image will be used for the hook pod's container.
 	ContainerName string `json:"containerName" description:"the name of a container from the pod template whose image will be used for the hook container"`
+	// Volumes is a list of named volumes from the deployment pod template
+	// which should be included in the hook pod. The volumes are mounted where
+	// the container named by ContainerName mounts them.
+	Volumes []string `json:"volumes,omitempty" description:"the names of volumes from the pod template to mount in the hook container"`
+}
+
+// TagImageHook is a request to tag the image of a container in the
+// deployment pod template onto an ImageStreamTag.
+type TagImageHook struct {
+	// ContainerName is the name of a container in the deployment pod template
+	// whose image is tagged.
+	ContainerName string `json:"containerName" description:"the name of a container from the pod template whose image will be tagged"`
+	// To is the target ImageStreamTag. Kind must be ImageStreamTag and Name
+	// must be of the form <stream>:<tag>. If Namespace is empty, the
+	// namespace of the deployment is used.
+	To kapi.ObjectReference `json:"to" description:"the ImageStreamTag to tag the container image onto"`
 }
 
 // RollingDeploymentStrategyParams are the input to the Rolling deployment
@@ -119,6 +155,84 @@ type RollingDeploymentStrategyParams struct {
 	// interval. If nil, one replica will be scaled up and down each interval.
 	// If negative, the scale order will be down/up instead of up/down.
 	UpdatePercent *int `json:"updatePercent,omitempty" description:"the percentage of replicas to scale up or down each interval (negative value switches scale order to down/up instead of up/down)"`
+	// MaxUnavailable is the maximum number of pods that can be unavailable
+	// during the update. Value can be an absolute number (ex: 5) or a
+	// percentage of the desired pods (ex: 10%). The absolute number is
+	// calculated from the percentage by rounding down. Defaults to 0 if
+	// MaxSurge or MinReadySeconds is set.
+	//
+	// If MaxUnavailable, MaxSurge and MinReadySeconds are all unset, replicas
+	// are replaced as described by UpdatePercent.
+	MaxUnavailable *kutil.IntOrString `json:"maxUnavailable,omitempty" description:"the maximum number or percentage of pods that can be unavailable during the update"`
+	// MaxSurge is the maximum number of pods that can be scheduled above the
+	// desired number of pods. Value can be an absolute number (ex: 5) or a
+	// percentage of the desired pods (ex: 10%). The absolute number is
+	// calculated from the percentage by rounding up. Defaults to 1 if
+	// MaxUnavailable or MinReadySeconds is set.
+	MaxSurge *kutil.IntOrString `json:"maxSurge,omitempty" description:"the maximum number or percentage of pods that can be scheduled above the desired number of pods"`
+	// MinReadySeconds is the minimum number of seconds for which a new pod
+	// must be ready before it is considered available. Scale steps only
+	// proceed once pods are available, and the deployment fails if no new
+	// pod becomes available within TimeoutSeconds.
+	MinReadySeconds int64 `json:"minReadySeconds,omitempty" description:"the minimum number of seconds for which a new pod must be ready before it is considered available"`
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Mid is not supported by the Rolling strategy, since pods of the old and
+	// the new deployment are running at the same time. Use the Recreate
+	// strategy for a hook that runs while no pods are deployed, such as a
+	// schema migration. Deployment configs that set it are rejected.
+	Mid *LifecycleHook `json:"mid,omitempty" description:"not supported, the mid hook is only executed by the Recreate strategy"`
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
+}
+
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// ServiceName is the name of the service which is switched from the old
+	// deployment to the new one. Until the switch, the service selects only the
+	// pods of the old deployment. The strategy pins the service to a deployment
+	// by adding the deployment label to its selector; the label is removed again
+	// when a later deployment of the config uses another strategy.
+	ServiceName string `json:"serviceName" description:"the name of the service switched from the old deployment to the new one"`
+	// TimeoutSeconds is the time to wait for the pods of the new deployment to
+	// become ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for the new deployment to become ready before giving up"`
+	// Pre is a lifecycle hook which is executed before the new deployment is
+	// scaled up. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Verify is a lifecycle hook which is executed once the new deployment is
+	// ready and before the service is switched to it. If it fails, the service
+	// keeps selecting the old deployment. All LifecycleHookFailurePolicy values
+	// are supported.
+	Verify *LifecycleHook `json:"verify,omitempty" description:"a hook executed before the service is switched to the new deployment"`
+	// Post is a lifecycle hook which is executed after the service was switched
+	// to the new deployment. The LifecycleHookFailurePolicyAbort policy is NOT
+	// supported.
+	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
+}
+
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// Steps are the stages of the deployment, in order. Each step scales the new
+	// deployment up to a share of the desired replicas and scales the old one
+	// down by the same amount. After the last step, the rest of the deployment
+	// is rolled out like a Rolling deployment.
+	Steps []CanaryStep `json:"steps" description:"the stages of the deployment, in order"`
+	// IntervalSeconds is the time to wait between polling deployment status,
+	// approvals and pod restarts. If the value is nil, a default will be used.
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty" description:"the time to wait between polling deployment status, approvals and pod restarts"`
+	// TimeoutSeconds is the time to wait for the pods of a step to become ready
+	// before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for the pods of a step to become ready before giving up"`
+	// MaxContainerRestarts is the number of restarts of any container of the new
+	// deployment after which the pods are considered crash looping and the
+	// deployment is aborted. If the value is nil, a default will be used.
+	MaxContainerRestarts *int `json:"maxContainerRestarts,omitempty" description:"the number of container restarts after which the deployment is aborted"`
 	// Pre is a lifecycle hook which is executed before the deployment process
 	// begins. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
@@ -128,6 +242,22 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
 }
 
+// CanaryStep is a single stage of a Canary deployment. Exactly one of Percent
+// and Replicas must be set.
+type CanaryStep struct {
+	// Percent is the share of the desired replicas the new deployment is scaled
+	// up to, between 1 and 100.
+	Percent *int `json:"percent,omitempty" description:"the share of the desired replicas the new deployment is scaled up to"`
+	// Replicas is the number of replicas the new deployment is scaled up to.
+	Replicas *int `json:"replicas,omitempty" description:"the number of replicas the new deployment is scaled up to"`
+	// PauseSeconds is the time to wait once the pods of the step are ready
+	// before the next step starts.
+	PauseSeconds *int64 `json:"pauseSeconds,omitempty" description:"the time to wait once the pods of the step are ready"`
+	// ManualApproval makes the deployment wait once the pods of the step are
+	// ready until the step is approved with the openshift.io/deployment.canary-approved-step annotation.
+	ManualApproval bool `json:"manualApproval,omitempty" description:"wait for the step to be approved before continuing"`
+}
+
 // These constants represent keys used for correlating objects related to deployments.
 const (
 	// DeploymentConfigAnnotation is an annotation name used to correlate a deployment with the
@@ -214,6 +344,18 @@ type DeploymentConfigSpec struct {
 	// DeploymentConfig with a new LatestVersion.
 	Triggers []DeploymentTriggerPolicy `json:"triggers,omitempty" description:"how new deployments are triggered"`
 
+	// Paused indicates that triggers don't result in new deployments. Changes made to a paused
+	// DeploymentConfig are deployed together once it is resumed.
+	Paused bool `json:"paused,omitempty" description:"indicates that triggers don't result in new deployments"`
+
+	// AutoRollback indicates that when a deployment fails, the last successful deployment is
+	// scaled back up and the DeploymentConfig is rolled back to it.
+	AutoRollback bool `json:"autoRollback,omitempty" description:"indicates that failed deployments are automatically rolled back to the last successful deployment"`
+
+	// RevisionHistoryLimit is the number of old complete and the number of old failed deployments
+	// to retain. If nil, all old deployments are retained.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty" description:"the number of old complete and old failed deployments to retain; all are retained if unset"`
+
 	// Replicas is the number of desired replicas.
 	Replicas int `json:"replicas" description:"the desired number of replicas"`
 
@@ -259,6 +401,9 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerRollback is the cause of deployments created by an automatic rollback
+	// after a failed deployment. It can't be used as a trigger.
+	DeploymentTriggerRollback DeploymentTriggerType = "Rollback"
 )
 
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
@@ -327,3 +472,26 @@ type DeploymentConfigRollbackSpec struct {
 	// IncludeStrategy specifies whether to include the deployment Strategy.
 	IncludeStrategy bool `json:"includeStrategy" description:"whether to include the deployment strategy in the rollback"`
 }
+
+// DeploymentLog is the (unused) resource associated with the deployment log redirector
+type DeploymentLog struct {
+	kapi.TypeMeta `json:",inline"`
+	kapi.ListMeta `json:"metadata,omitempty"`
+}
+
+// DeploymentLogOptions is the REST options for a deployment log
+type DeploymentLogOptions struct {
+	kapi.TypeMeta
+
+	// Follow if true indicates that the deployment log should be streamed until
+	// the deployment terminates.
+	Follow bool `json:"follow,omitempty" description:"if true indicates that the log should be streamed; defaults to false"`
+
+	// NoWait if true causes the call to return immediately even if the deployment
+	// is not available yet. Otherwise the server will wait until the deployment has started.
+	NoWait bool `json:"nowait,omitempty" description:"if true indicates that the server should not wait for a log to be available before returning; defaults to false"`
+
+	// Version of the deployment for which to view logs. If 0, the log of the latest
+	// deployment is returned.
+	Version int `json:"version,omitempty" description:"the version of the deployment for which to view logs; defaults to the latest deployment"`
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -10,6 +11,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/fielderrors"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
 // TODO: These tests validate the ReplicationControllerState in a Deployment or DeploymentConfig.
@@ -23,11 +25,18 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) fielderrors.Va
 	for i := range config.Triggers {
 		allErrs = append(allErrs, validateTrigger(&config.Triggers[i]).PrefixIndex(i).Prefix("triggers")...)
 	}
-	allErrs = append(allErrs, validateDeploymentStrategy(&config.Template.Strategy).Prefix("template.strategy")...)
+	var podSpec *kapi.PodSpec
+	if config.Template.ControllerTemplate.Template != nil {
+		podSpec = &config.Template.ControllerTemplate.Template.Spec
+	}
+	allErrs = append(allErrs, validateDeploymentStrategy(&config.Template.Strategy, podSpec).Prefix("template.strategy")...)
 	allErrs = append(allErrs, validation.ValidateReplicationControllerSpec(&config.Template.ControllerTemplate).Prefix("template.controllerTemplate")...)
 	if config.LatestVersion < 0 {
 		allErrs = append(allErrs, fielderrors.NewFieldInvalid("latestVersion", config.LatestVersion, "latestVersion cannot be negative"))
 	}
+	if config.RevisionHistoryLimit != nil && *config.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("revisionHistoryLimit", *config.RevisionHistoryLimit, "revisionHistoryLimit cannot be negative"))
+	}
 	return allErrs
 }
 
@@ -61,7 +70,17 @@ func ValidateDeploymentConfigRollback(rollback *deployapi.DeploymentConfigRollba
 	return result
 }
 
-func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy) fielderrors.ValidationErrorList {
+func ValidateDeploymentLogOptions(opts *deployapi.DeploymentLogOptions) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+
+	if opts.Version < 0 {
+		result = append(result, fielderrors.NewFieldInvalid("version", opts.Version, "version must not be negative"))
+	}
+
+	return result
+}
+
+func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
 	errs := fielderrors.ValidationErrorList{}
 
 	if len(strategy.Type) == 0 {
@@ -71,13 +90,25 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy) fielderr
 	switch strategy.Type {
 	case deployapi.DeploymentStrategyTypeRecreate:
 		if strategy.RecreateParams != nil {
-			errs = append(errs, validateRecreateParams(strategy.RecreateParams).Prefix("recreateParams")...)
+			errs = append(errs, validateRecreateParams(strategy.RecreateParams, pod).Prefix("recreateParams")...)
 		}
 	case deployapi.DeploymentStrategyTypeRolling:
 		if strategy.RollingParams == nil {
 			errs = append(errs, fielderrors.NewFieldRequired("rollingParams"))
 		} else {
-			errs = append(errs, validateRollingParams(strategy.RollingParams).Prefix("rollingParams")...)
+			errs = append(errs, validateRollingParams(strategy.RollingParams, pod).Prefix("rollingParams")...)
+		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams == nil {
+			errs = append(errs, fielderrors.NewFieldRequired("blueGreenParams"))
+		} else {
+			errs = append(errs, validateBlueGreenParams(strategy.BlueGreenParams, pod).Prefix("blueGreenParams")...)
+		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams == nil {
+			errs = append(errs, fielderrors.NewFieldRequired("canaryParams"))
+		} else {
+			errs = append(errs, validateCanaryParams(strategy.CanaryParams, pod).Prefix("canaryParams")...)
 		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
@@ -102,36 +133,48 @@ func validateCustomParams(params *deployapi.CustomDeploymentStrategyParams) fiel
 	return errs
 }
 
-func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams) fielderrors.ValidationErrorList {
+func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
 	errs := fielderrors.ValidationErrorList{}
 
 	if params.Pre != nil {
-		errs = append(errs, validateLifecycleHook(params.Pre).Prefix("pre")...)
+		errs = append(errs, validateLifecycleHook(params.Pre, pod).Prefix("pre")...)
+	}
+	if params.Mid != nil {
+		errs = append(errs, validateLifecycleHook(params.Mid, pod).Prefix("mid")...)
 	}
 	if params.Post != nil {
-		errs = append(errs, validateLifecycleHook(params.Post).Prefix("post")...)
+		errs = append(errs, validateLifecycleHook(params.Post, pod).Prefix("post")...)
 	}
 
 	return errs
 }
 
-func validateLifecycleHook(hook *deployapi.LifecycleHook) fielderrors.ValidationErrorList {
+func validateLifecycleHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
 	errs := fielderrors.ValidationErrorList{}
 
 	if len(hook.FailurePolicy) == 0 {
 		errs = append(errs, fielderrors.NewFieldRequired("failurePolicy"))
 	}
 
-	if hook.ExecNewPod == nil {
-		errs = append(errs, fielderrors.NewFieldRequired("execNewPod"))
-	} else {
-		errs = append(errs, validateExecNewPod(hook.ExecNewPod).Prefix("execNewPod")...)
+	switch {
+	case hook.ExecNewPod != nil && len(hook.TagImages) > 0:
+		errs = append(errs, fielderrors.NewFieldInvalid("tagImages", hook.TagImages, "only one of execNewPod or tagImages may be specified"))
+	case hook.ExecNewPod != nil:
+		errs = append(errs, validateExecNewPod(hook.ExecNewPod, pod).Prefix("execNewPod")...)
+	case len(hook.TagImages) > 0:
+		for i := range hook.TagImages {
+			errs = append(errs, validateTagImage(&hook.TagImages[i]).PrefixIndex(i).Prefix("tagImages")...)
+		}
+	default:
+		err := fielderrors.NewFieldRequired("execNewPod")
+		err.Detail = "one of execNewPod or tagImages must be specified"
+		errs = append(errs, err)
 	}
 
 	return errs
 }
 
-func validateExecNewPod(hook *deployapi.ExecNewPodHook) fielderrors.ValidationErrorList {
+func validateExecNewPod(hook *deployapi.ExecNewPodHook, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
 	errs := fielderrors.ValidationErrorList{}
 
 	if len(hook.Command) == 0 {
@@ -146,6 +189,58 @@ func validateExecNewPod(hook *deployapi.ExecNewPodHook) fielderrors.ValidationEr
 		errs = append(errs, validateEnv(hook.Env).Prefix("env")...)
 	}
 
+	if len(hook.Volumes) > 0 {
+		errs = append(errs, validateHookVolumes(hook.Volumes, pod).Prefix("volumes")...)
+	}
+
+	return errs
+}
+
+// validateHookVolumes ensures the volumes of a hook are named uniquely and
+// exist in the pod template of the deployment. Template volumes are only
+// checked when the deployment has a pod template.
+func validateHookVolumes(volumes []string, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	templateVolumes := util.NewStringSet()
+	if pod != nil {
+		for _, volume := range pod.Volumes {
+			templateVolumes.Insert(volume.Name)
+		}
+	}
+
+	seen := util.NewStringSet()
+	for i, name := range volumes {
+		switch {
+		case len(name) == 0:
+			errs = append(errs, fielderrors.NewFieldRequired(fmt.Sprintf("[%d]", i)))
+		case seen.Has(name):
+			errs = append(errs, fielderrors.NewFieldDuplicate(fmt.Sprintf("[%d]", i), name))
+		case pod != nil && !templateVolumes.Has(name):
+			errs = append(errs, fielderrors.NewFieldNotFound(fmt.Sprintf("[%d]", i), name))
+		}
+		seen.Insert(name)
+	}
+
+	return errs
+}
+
+func validateTagImage(hook *deployapi.TagImageHook) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	if len(hook.ContainerName) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("containerName"))
+	}
+
+	if hook.To.Kind != "ImageStreamTag" {
+		errs = append(errs, fielderrors.NewFieldInvalid("to.kind", hook.To.Kind, "only ImageStreamTag is supported"))
+	}
+	if len(hook.To.Name) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("to.name"))
+	} else if _, _, ok := imageapi.SplitImageStreamTag(hook.To.Name); !ok {
+		errs = append(errs, fielderrors.NewFieldInvalid("to.name", hook.To.Name, "must be of the form <stream>:<tag>"))
+	}
+
 	return errs
 }
 
@@ -165,7 +260,7 @@ func validateEnv(vars []kapi.EnvVar) fielderrors.ValidationErrorList {
 	return allErrs
 }
 
-func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams) fielderrors.ValidationErrorList {
+func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
 	errs := fielderrors.ValidationErrorList{}
 
 	if params.IntervalSeconds != nil && *params.IntervalSeconds < 1 {
@@ -187,11 +282,151 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams) fi
 		}
 	}
 
+	if params.MaxUnavailable != nil {
+		errs = append(errs, validateIntOrPercent("maxUnavailable", params.MaxUnavailable)...)
+	}
+	if params.MaxSurge != nil {
+		errs = append(errs, validateIntOrPercent("maxSurge", params.MaxSurge)...)
+	}
+	if params.MaxUnavailable != nil && params.MaxSurge != nil && isZeroIntOrPercent(params.MaxUnavailable) && isZeroIntOrPercent(params.MaxSurge) {
+		errs = append(errs, fielderrors.NewFieldInvalid("maxUnavailable", params.MaxUnavailable.String(), "cannot be 0 when maxSurge is 0"))
+	}
+	if params.MinReadySeconds < 0 {
+		errs = append(errs, fielderrors.NewFieldInvalid("minReadySeconds", params.MinReadySeconds, "must be >=0"))
+	} else if params.MinReadySeconds > 0 && params.TimeoutSeconds != nil && params.MinReadySeconds >= *params.TimeoutSeconds {
+		errs = append(errs, fielderrors.NewFieldInvalid("minReadySeconds", params.MinReadySeconds, "must be less than timeoutSeconds"))
+	}
+	if params.UpdatePercent != nil && (params.MaxUnavailable != nil || params.MaxSurge != nil || params.MinReadySeconds > 0) {
+		errs = append(errs, fielderrors.NewFieldInvalid("updatePercent", *params.UpdatePercent, "cannot be combined with maxUnavailable, maxSurge or minReadySeconds"))
+	}
+
 	if params.Pre != nil {
-		errs = append(errs, validateLifecycleHook(params.Pre).Prefix("pre")...)
+		errs = append(errs, validateLifecycleHook(params.Pre, pod).Prefix("pre")...)
+	}
+	if params.Mid != nil {
+		err := fielderrors.NewFieldForbidden("mid", "")
+		err.Detail = "the mid hook is only supported by the Recreate strategy"
+		errs = append(errs, err)
 	}
 	if params.Post != nil {
-		errs = append(errs, validateLifecycleHook(params.Post).Prefix("post")...)
+		errs = append(errs, validateLifecycleHook(params.Post, pod).Prefix("post")...)
+	}
+
+	return errs
+}
+
+// validateIntOrPercent validates value is a non-negative number or a
+// percentage between 0% and 100%.
+func validateIntOrPercent(field string, value *util.IntOrString) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+	switch value.Kind {
+	case util.IntstrInt:
+		if value.IntVal < 0 {
+			errs = append(errs, fielderrors.NewFieldInvalid(field, value.IntVal, "must be >=0"))
+		}
+	case util.IntstrString:
+		if p, ok := parsePercent(value.StrVal); !ok || p > 100 {
+			errs = append(errs, fielderrors.NewFieldInvalid(field, value.StrVal, "must be a percentage between 0% and 100%"))
+		}
+	}
+	return errs
+}
+
+// isZeroIntOrPercent returns true if value is 0 or 0%.
+func isZeroIntOrPercent(value *util.IntOrString) bool {
+	if value.Kind == util.IntstrInt {
+		return value.IntVal == 0
+	}
+	p, ok := parsePercent(value.StrVal)
+	return ok && p == 0
+}
+
+// parsePercent parses a non-negative percentage of the form "N%".
+func parsePercent(value string) (int, bool) {
+	if !strings.HasSuffix(value, "%") {
+		return 0, false
+	}
+	p, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil || p < 0 {
+		return 0, false
+	}
+	return p, true
+}
+
+func validateBlueGreenParams(params *deployapi.BlueGreenDeploymentStrategyParams, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	if len(params.ServiceName) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("serviceName"))
+	} else if ok, msg := validation.ValidateServiceName(params.ServiceName, false); !ok {
+		errs = append(errs, fielderrors.NewFieldInvalid("serviceName", params.ServiceName, msg))
+	}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("timeoutSeconds", *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod).Prefix("pre")...)
+	}
+	if params.Verify != nil {
+		errs = append(errs, validateLifecycleHook(params.Verify, pod).Prefix("verify")...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod).Prefix("post")...)
+	}
+
+	return errs
+}
+
+func validateCanaryParams(params *deployapi.CanaryDeploymentStrategyParams, pod *kapi.PodSpec) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	if len(params.Steps) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("steps"))
+	}
+	for i := range params.Steps {
+		errs = append(errs, validateCanaryStep(&params.Steps[i]).PrefixIndex(i).Prefix("steps")...)
+	}
+
+	if params.IntervalSeconds != nil && *params.IntervalSeconds < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("intervalSeconds", *params.IntervalSeconds, "must be >0"))
+	}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("timeoutSeconds", *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.MaxContainerRestarts != nil && *params.MaxContainerRestarts < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("maxContainerRestarts", *params.MaxContainerRestarts, "must be >0"))
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod).Prefix("pre")...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod).Prefix("post")...)
+	}
+
+	return errs
+}
+
+func validateCanaryStep(step *deployapi.CanaryStep) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	switch {
+	case step.Percent == nil && step.Replicas == nil:
+		errs = append(errs, fielderrors.NewFieldRequired("percent"))
+	case step.Percent != nil && step.Replicas != nil:
+		errs = append(errs, fielderrors.NewFieldInvalid("replicas", *step.Replicas, "only one of percent or replicas may be specified"))
+	case step.Percent != nil && (*step.Percent < 1 || *step.Percent > 100):
+		errs = append(errs, fielderrors.NewFieldInvalid("percent", *step.Percent, "must be between 1 and 100 (inclusive)"))
+	case step.Replicas != nil && *step.Replicas < 1:
+		errs = append(errs, fielderrors.NewFieldInvalid("replicas", *step.Replicas, "must be >0"))
+	}
+
+	if step.PauseSeconds != nil && *step.PauseSeconds < 0 {
+		errs = append(errs, fielderrors.NewFieldInvalid("pauseSeconds", *step.PauseSeconds, "must be >=0"))
 	}
 
 	return errs
@@ -1,9 +1,12 @@
 package validation
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/fielderrors"
 
 	"github.com/openshift/origin/pkg/deploy/api"
@@ -38,6 +41,66 @@ func rollingConfig(interval, updatePeriod, timeout int) api.DeploymentConfig {
 	}
 }
 
+func blueGreenConfig(serviceName string, timeout int) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Triggers:   manualTrigger(),
+		Template: api.DeploymentTemplate{
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeBlueGreen,
+				BlueGreenParams: &api.BlueGreenDeploymentStrategyParams{
+					ServiceName:    serviceName,
+					TimeoutSeconds: mkint64p(timeout),
+				},
+			},
+			ControllerTemplate: test.OkControllerTemplate(),
+		},
+	}
+}
+
+func canaryConfig(steps ...api.CanaryStep) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Triggers:   manualTrigger(),
+		Template: api.DeploymentTemplate{
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeCanary,
+				CanaryParams: &api.CanaryDeploymentStrategyParams{
+					Steps: steps,
+				},
+			},
+			ControllerTemplate: test.OkControllerTemplate(),
+		},
+	}
+}
+
+func hookVolumesConfig(volumes ...string) api.DeploymentConfig {
+	template := test.OkControllerTemplate()
+	template.Template.Spec.Volumes = []kapi.Volume{
+		{Name: "data", VolumeSource: kapi.VolumeSource{EmptyDir: &kapi.EmptyDirVolumeSource{}}},
+	}
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Triggers:   manualTrigger(),
+		Template: api.DeploymentTemplate{
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeRecreate,
+				RecreateParams: &api.RecreateDeploymentStrategyParams{
+					Pre: &api.LifecycleHook{
+						FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+						ExecNewPod: &api.ExecNewPodHook{
+							Command:       []string{"cmd"},
+							ContainerName: "container1",
+							Volumes:       volumes,
+						},
+					},
+				},
+			},
+			ControllerTemplate: template,
+		},
+	}
+}
+
 func rollingConfigPct(interval, updatePeriod, timeout, percent int) api.DeploymentConfig {
 	return api.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -57,6 +120,28 @@ func rollingConfigPct(interval, updatePeriod, timeout, percent int) api.Deployme
 	}
 }
 
+func rollingConfigLimits(maxUnavailable, maxSurge string, minReadySeconds int64) api.DeploymentConfig {
+	config := rollingConfigPct(1, 1, 10, 1)
+	params := config.Template.Strategy.RollingParams
+	params.UpdatePercent = nil
+	if len(maxUnavailable) > 0 {
+		v := util.NewIntOrStringFromString(maxUnavailable)
+		if i, err := strconv.Atoi(maxUnavailable); err == nil {
+			v = util.NewIntOrStringFromInt(i)
+		}
+		params.MaxUnavailable = &v
+	}
+	if len(maxSurge) > 0 {
+		v := util.NewIntOrStringFromString(maxSurge)
+		if i, err := strconv.Atoi(maxSurge); err == nil {
+			v = util.NewIntOrStringFromInt(i)
+		}
+		params.MaxSurge = &v
+	}
+	params.MinReadySeconds = minReadySeconds
+	return config
+}
+
 // TODO: test validation errors for ReplicationControllerTemplates
 
 func TestValidateDeploymentConfigOK(t *testing.T) {
@@ -261,6 +346,76 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			fielderrors.ValidationErrorTypeRequired,
 			"template.strategy.recreateParams.pre.failurePolicy",
 		},
+		"missing template.strategy.recreateParams.mid.execNewPod": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Template: api.DeploymentTemplate{
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Mid: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+							},
+						},
+					},
+					ControllerTemplate: test.OkControllerTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"template.strategy.recreateParams.mid.execNewPod",
+		},
+		"invalid template.strategy.recreateParams.post.tagImages[0].to.kind": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Template: api.DeploymentTemplate{
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyIgnore,
+								TagImages: []api.TagImageHook{
+									{
+										ContainerName: "container1",
+										To:            kapi.ObjectReference{Kind: "DockerImage", Name: "stream:tag"},
+									},
+								},
+							},
+						},
+					},
+					ControllerTemplate: test.OkControllerTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.recreateParams.post.tagImages[0].to.kind",
+		},
+		"invalid template.strategy.recreateParams.post.tagImages": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Template: api.DeploymentTemplate{
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyIgnore,
+								ExecNewPod: &api.ExecNewPodHook{
+									Command:       []string{"cmd"},
+									ContainerName: "container1",
+								},
+								TagImages: []api.TagImageHook{
+									{
+										ContainerName: "container1",
+										To:            kapi.ObjectReference{Kind: "ImageStreamTag", Name: "stream:tag"},
+									},
+								},
+							},
+						},
+					},
+					ControllerTemplate: test.OkControllerTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.recreateParams.post.tagImages",
+		},
 		"missing template.strategy.recreateParams.pre.execNewPod": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -360,6 +515,31 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			fielderrors.ValidationErrorTypeRequired,
 			"template.strategy.rollingParams.pre.failurePolicy",
 		},
+		"forbidden template.strategy.rollingParams.mid": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Template: api.DeploymentTemplate{
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRolling,
+						RollingParams: &api.RollingDeploymentStrategyParams{
+							IntervalSeconds:     mkint64p(1),
+							UpdatePeriodSeconds: mkint64p(1),
+							TimeoutSeconds:      mkint64p(20),
+							Mid: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+								ExecNewPod: &api.ExecNewPodHook{
+									Command:       []string{"cmd"},
+									ContainerName: "container",
+								},
+							},
+						},
+					},
+					ControllerTemplate: test.OkControllerTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeForbidden,
+			"template.strategy.rollingParams.mid",
+		},
 		"invalid zero template.strategy.rollingParams.updatePercent": {
 			rollingConfigPct(1, 1, 1, 0),
 			fielderrors.ValidationErrorTypeInvalid,
@@ -395,6 +575,121 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			"",
 			"",
 		},
+		"invalid template.strategy.rollingParams.maxUnavailable": {
+			rollingConfigLimits("-1", "", 0),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.rollingParams.maxUnavailable",
+		},
+		"invalid percentage template.strategy.rollingParams.maxSurge": {
+			rollingConfigLimits("", "110%", 0),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.rollingParams.maxSurge",
+		},
+		"invalid zero template.strategy.rollingParams.maxUnavailable and maxSurge": {
+			rollingConfigLimits("0", "0%", 0),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.rollingParams.maxUnavailable",
+		},
+		"invalid template.strategy.rollingParams.minReadySeconds": {
+			rollingConfigLimits("", "", 10),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.rollingParams.minReadySeconds",
+		},
+		"invalid template.strategy.rollingParams.updatePercent with maxSurge": {
+			func() api.DeploymentConfig {
+				config := rollingConfigLimits("", "1", 0)
+				config.Template.Strategy.RollingParams.UpdatePercent = mkintp(10)
+				return config
+			}(),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.rollingParams.updatePercent",
+		},
+		"valid template.strategy.rollingParams limits": {
+			rollingConfigLimits("25%", "1", 5),
+			"",
+			"",
+		},
+		"missing template.strategy.blueGreenParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Template: api.DeploymentTemplate{
+					Strategy:           api.DeploymentStrategy{Type: api.DeploymentStrategyTypeBlueGreen},
+					ControllerTemplate: test.OkControllerTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"template.strategy.blueGreenParams",
+		},
+		"missing template.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("", 1),
+			fielderrors.ValidationErrorTypeRequired,
+			"template.strategy.blueGreenParams.serviceName",
+		},
+		"invalid template.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("Frontend", 1),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.blueGreenParams.serviceName",
+		},
+		"invalid template.strategy.blueGreenParams.timeoutSeconds": {
+			blueGreenConfig("frontend", -20),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.blueGreenParams.timeoutSeconds",
+		},
+		"valid template.strategy.blueGreenParams": {
+			blueGreenConfig("frontend", 20),
+			"",
+			"",
+		},
+		"missing template.strategy.canaryParams.steps": {
+			canaryConfig(),
+			fielderrors.ValidationErrorTypeRequired,
+			"template.strategy.canaryParams.steps",
+		},
+		"missing template.strategy.canaryParams.steps[0].percent": {
+			canaryConfig(api.CanaryStep{ManualApproval: true}),
+			fielderrors.ValidationErrorTypeRequired,
+			"template.strategy.canaryParams.steps[0].percent",
+		},
+		"invalid template.strategy.canaryParams.steps[1].percent": {
+			canaryConfig(api.CanaryStep{Replicas: mkintp(1)}, api.CanaryStep{Percent: mkintp(120)}),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.canaryParams.steps[1].percent",
+		},
+		"both template.strategy.canaryParams.steps[0].percent and replicas": {
+			canaryConfig(api.CanaryStep{Percent: mkintp(10), Replicas: mkintp(1)}),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.canaryParams.steps[0].replicas",
+		},
+		"invalid template.strategy.canaryParams.steps[0].pauseSeconds": {
+			canaryConfig(api.CanaryStep{Percent: mkintp(10), PauseSeconds: mkint64p(-1)}),
+			fielderrors.ValidationErrorTypeInvalid,
+			"template.strategy.canaryParams.steps[0].pauseSeconds",
+		},
+		"valid template.strategy.canaryParams": {
+			canaryConfig(api.CanaryStep{Replicas: mkintp(1), ManualApproval: true}, api.CanaryStep{Percent: mkintp(50), PauseSeconds: mkint64p(30)}),
+			"",
+			"",
+		},
+		"empty template.strategy.recreateParams.pre.execNewPod.volumes[1]": {
+			hookVolumesConfig("data", ""),
+			fielderrors.ValidationErrorTypeRequired,
+			"template.strategy.recreateParams.pre.execNewPod.volumes[1]",
+		},
+		"duplicate template.strategy.recreateParams.pre.execNewPod.volumes[1]": {
+			hookVolumesConfig("data", "data"),
+			fielderrors.ValidationErrorTypeDuplicate,
+			"template.strategy.recreateParams.pre.execNewPod.volumes[1]",
+		},
+		"unknown template.strategy.recreateParams.pre.execNewPod.volumes[0]": {
+			hookVolumesConfig("missing"),
+			fielderrors.ValidationErrorTypeNotFound,
+			"template.strategy.recreateParams.pre.execNewPod.volumes[0]",
+		},
+		"valid template.strategy.recreateParams.pre.execNewPod.volumes": {
+			hookVolumesConfig("data"),
+			"",
+			"",
+		},
 	}
 
 	for k, v := range errorCases {
@@ -421,6 +716,20 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 	}
 }
 
+func TestValidateLifecycleHookMissingAction(t *testing.T) {
+	errs := validateLifecycleHook(&api.LifecycleHook{FailurePolicy: api.LifecycleHookFailurePolicyAbort}, nil)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	err := errs[0].(*fielderrors.ValidationError)
+	if err.Type != fielderrors.ValidationErrorTypeRequired || err.Field != "execNewPod" {
+		t.Errorf("expected execNewPod to be required, got %v", err)
+	}
+	if !strings.Contains(err.Detail, "one of execNewPod or tagImages") {
+		t.Errorf("expected the error to mention both actions, got %v", err)
+	}
+}
+
 func TestValidateDeploymentConfigUpdate(t *testing.T) {
 	oldConfig := &api.DeploymentConfig{
 		ObjectMeta:    kapi.ObjectMeta{Name: "foo", Namespace: "bar", ResourceVersion: "1"},
@@ -470,6 +779,27 @@ func TestValidateDeploymentConfigUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateDeploymentConfigUpdateHookVolumes(t *testing.T) {
+	oldConfig := hookVolumesConfig("data")
+	oldConfig.ResourceVersion = "1"
+	newConfig := hookVolumesConfig("data")
+	newConfig.ResourceVersion = "1"
+	// Removing a volume from the template must not leave the hook behind.
+	newConfig.Template.ControllerTemplate.Template.Spec.Volumes = nil
+
+	errs := ValidateDeploymentConfigUpdate(&newConfig, &oldConfig)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	err := errs[0].(*fielderrors.ValidationError)
+	if e, a := fielderrors.ValidationErrorTypeNotFound, err.Type; e != a {
+		t.Errorf("expected error type %s, got %s", e, a)
+	}
+	if e, a := "template.strategy.recreateParams.pre.execNewPod.volumes[0]", err.Field; e != a {
+		t.Errorf("expected error field %s, got %s", e, a)
+	}
+}
+
 func TestValidateDeploymentConfigRollbackOK(t *testing.T) {
 	rollback := &api.DeploymentConfigRollback{
 		Spec: api.DeploymentConfigRollbackSpec{
@@ -534,6 +864,20 @@ func TestValidateDeploymentConfigRollbackInvalidFields(t *testing.T) {
 	}
 }
 
+func TestValidateDeploymentLogOptions(t *testing.T) {
+	if errs := ValidateDeploymentLogOptions(&api.DeploymentLogOptions{Version: 2}); len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %v", errs)
+	}
+
+	errs := ValidateDeploymentLogOptions(&api.DeploymentLogOptions{Version: -1})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if e, a := "version", errs[0].(*fielderrors.ValidationError).Field; e != a {
+		t.Errorf("expected error for field %s, got %s", e, a)
+	}
+}
+
 func TestValidateDeploymentConfigDefaultImageStreamKind(t *testing.T) {
 	config := &api.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -36,6 +36,11 @@ func (e fatalError) Error() string {
 
 // Handle processes change triggers for config.
 func (c *DeploymentConfigChangeController) Handle(config *deployapi.DeploymentConfig) error {
+	if config.Paused {
+		glog.V(4).Infof("Ignoring DeploymentConfig %s; paused", deployutil.LabelForDeploymentConfig(config))
+		return nil
+	}
+
 	hasChangeTrigger := false
 	for _, trigger := range config.Triggers {
 		if trigger.Type == deployapi.DeploymentTriggerOnConfigChange {
@@ -39,6 +39,35 @@ func TestHandle_newConfigNoTriggers(t *testing.T) {
 	}
 }
 
+// TestHandle_pausedConfig ensures that a change to a paused config with a
+// config change trigger doesn't result in a new config version bump.
+func TestHandle_pausedConfig(t *testing.T) {
+	controller := &DeploymentConfigChangeController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, api.Codec)
+		},
+		changeStrategy: &changeStrategyImpl{
+			generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected generation of deploymentConfig")
+				return nil, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected update of deploymentConfig")
+				return config, nil
+			},
+		},
+	}
+
+	config := deployapitest.OkDeploymentConfig(0)
+	config.Triggers = []deployapi.DeploymentTriggerPolicy{deployapitest.OkConfigChangeTrigger()}
+	config.Paused = true
+	err := controller.Handle(config)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // TestHandle_newConfigTriggers ensures that the creation of a new config
 // (with version 0) with a config change trigger results in a version bump and
 // cause update for initial deployment.
@@ -2,6 +2,7 @@ package deployment
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/golang/glog"
 
@@ -11,6 +12,7 @@ import (
 	kutil "k8s.io/kubernetes/pkg/util"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployprune "github.com/openshift/origin/pkg/deploy/prune"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
@@ -22,7 +24,12 @@ import (
 // When the deployment enters a terminal status:
 //
 //   1. If the deployment finished normally, the deployer pod is deleted.
-//   2. If the deployment failed, the deployer pod is not deleted.
+//   2. If the deployment failed, the deployer pod is not deleted. If the
+//      config of the deployment has AutoRollback set, the last successful
+//      deployment is scaled back up and the config is rolled back to it.
+//
+// After a successful deployment, old deployments exceeding the
+// RevisionHistoryLimit of the config are deleted.
 //
 // Use the DeploymentControllerFactory to create this controller.
 type DeploymentController struct {
@@ -30,13 +37,17 @@ type DeploymentController struct {
 	serviceAccount string
 	// deploymentClient provides access to deployments.
 	deploymentClient deploymentClient
+	// deploymentConfigClient provides access to deployment configs.
+	deploymentConfigClient deploymentConfigClient
 	// podClient provides access to pods.
 	podClient podClient
 	// makeContainer knows how to make a container appropriate to execute a deployment strategy.
 	makeContainer func(strategy *deployapi.DeploymentStrategy) (*kapi.Container, error)
 	// decodeConfig knows how to decode the deploymentConfig from a deployment's annotations.
 	decodeConfig func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error)
-	recorder     record.EventRecorder
+	// generateRollback generates a config which rolls back from to to.
+	generateRollback func(from, to *deployapi.DeploymentConfig, spec *deployapi.DeploymentConfigRollbackSpec) (*deployapi.DeploymentConfig, error)
+	recorder         record.EventRecorder
 }
 
 // fatalError is an error which can't be retried.
@@ -154,7 +165,10 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 			c.recorder.Eventf(deployment, "cancelled", "Cancelled deployment")
 		}
 	case deployapi.DeploymentStatusFailed:
-		// Nothing to do in this terminal state.
+		// Roll back to the last successful deployment if the config asks for it.
+		if err := c.rollback(deployment); err != nil {
+			return err
+		}
 	case deployapi.DeploymentStatusComplete:
 		// now list any pods in the namespace that have the specified label
 		deployerPods, err := c.podClient.getDeployerPodsFor(deployment.Namespace, deployment.Name)
@@ -182,6 +196,11 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 		if !cleanedAll {
 			return fmt.Errorf("couldn't clean up all deployer pods for %s", deployutil.LabelForDeployment(deployment))
 		}
+
+		// Delete old deployments beyond the revision history limit.
+		if err := c.cleanupOldDeployments(deployment); err != nil {
+			return err
+		}
 	}
 
 	if currentStatus != nextStatus {
@@ -195,6 +214,155 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 	return nil
 }
 
+// rollback scales the last successful deployment of the config of a failed
+// deployment back up and rolls the config back to it, if the config has
+// AutoRollback set. Cancelled deployments, deployments which are rollbacks
+// themselves and deployments which are no longer the latest one of their
+// config are left alone.
+func (c *DeploymentController) rollback(deployment *kapi.ReplicationController) error {
+	if deployutil.IsDeploymentCancelled(deployment) {
+		return nil
+	}
+	failedConfig, err := c.decodeConfig(deployment)
+	if err != nil {
+		return fatalError(fmt.Sprintf("couldn't decode config from deployment %s: %v", deployutil.LabelForDeployment(deployment), err))
+	}
+	if !failedConfig.AutoRollback || isRollback(failedConfig) {
+		return nil
+	}
+
+	config, err := c.deploymentConfigClient.getDeploymentConfig(deployment.Namespace, failedConfig.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("couldn't get config of failed deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	if !config.AutoRollback || config.LatestVersion != failedConfig.LatestVersion {
+		return nil
+	}
+
+	// Find the last successful deployment.
+	deployments, err := c.deploymentClient.listDeploymentsForConfig(deployment.Namespace, config.Name)
+	if err != nil {
+		return fmt.Errorf("couldn't list deployments for config %s/%s: %v", config.Namespace, config.Name, err)
+	}
+	sort.Sort(deployutil.DeploymentsByLatestVersionDesc(deployments.Items))
+	var last *kapi.ReplicationController
+	for i := range deployments.Items {
+		candidate := &deployments.Items[i]
+		if candidate.Name != deployment.Name && deployutil.DeploymentStatusFor(candidate) == deployapi.DeploymentStatusComplete {
+			last = candidate
+			break
+		}
+	}
+	if last == nil {
+		glog.V(2).Infof("Not rolling back failed deployment %s, no successful deployment exists", deployutil.LabelForDeployment(deployment))
+		return nil
+	}
+
+	// Restore the capacity of the last successful deployment right away, the
+	// deployment of the rolled back config takes over from it.
+	if desiredReplicas, ok := deployutil.DeploymentDesiredReplicas(deployment); ok && last.Spec.Replicas != desiredReplicas {
+		last.Spec.Replicas = desiredReplicas
+		if _, err := c.deploymentClient.updateDeployment(last.Namespace, last); err != nil {
+			return fmt.Errorf("couldn't scale %s back up to %d: %v", deployutil.LabelForDeployment(last), desiredReplicas, err)
+		}
+		glog.V(4).Infof("Scaled %s back up to %d after %s failed", deployutil.LabelForDeployment(last), desiredReplicas, deployutil.LabelForDeployment(deployment))
+	}
+
+	lastConfig, err := c.decodeConfig(last)
+	if err != nil {
+		return fatalError(fmt.Sprintf("couldn't decode config from deployment %s: %v", deployutil.LabelForDeployment(last), err))
+	}
+	rollback, err := c.generateRollback(config, lastConfig, &deployapi.DeploymentConfigRollbackSpec{
+		From:                   kapi.ObjectReference{Name: last.Name},
+		IncludeTemplate:        true,
+		IncludeReplicationMeta: true,
+		IncludeStrategy:        true,
+	})
+	if err != nil {
+		return fatalError(fmt.Sprintf("couldn't generate rollback to %s: %v", deployutil.LabelForDeployment(last), err))
+	}
+	rollback.Details = &deployapi.DeploymentDetails{
+		Message: fmt.Sprintf("rolled back to %s after %s failed", deployutil.LabelForDeployment(last), deployutil.LabelForDeployment(deployment)),
+		Causes: []*deployapi.DeploymentCause{
+			{Type: deployapi.DeploymentTriggerRollback},
+		},
+	}
+	if _, err := c.deploymentConfigClient.updateDeploymentConfig(rollback.Namespace, rollback); err != nil {
+		c.recorder.Eventf(deployment, "failedRollback", "Error rolling back to %s: %v", deployutil.LabelForDeployment(last), err)
+		return fmt.Errorf("couldn't roll back config %s/%s to %s: %v", config.Namespace, config.Name, deployutil.LabelForDeployment(last), err)
+	}
+	c.recorder.Eventf(deployment, "rolledBack", "Rolled back to %s", deployutil.LabelForDeployment(last))
+	glog.V(2).Infof("Rolled back config %s/%s to %s after %s failed", config.Namespace, config.Name, deployutil.LabelForDeployment(last), deployutil.LabelForDeployment(deployment))
+	return nil
+}
+
+// cleanupOldDeployments deletes the old deployments of the config of the
+// successful deployment which exceed the RevisionHistoryLimit of the config.
+// Only deployments which are scaled down are candidates, and the deployment
+// targeted by the most recent rollback of the config is always retained.
+// Deployments which aren't the latest one of their config are left alone.
+func (c *DeploymentController) cleanupOldDeployments(deployment *kapi.ReplicationController) error {
+	deployedConfig, err := c.decodeConfig(deployment)
+	if err != nil {
+		return fatalError(fmt.Sprintf("couldn't decode config from deployment %s: %v", deployutil.LabelForDeployment(deployment), err))
+	}
+	if deployedConfig.RevisionHistoryLimit == nil {
+		return nil
+	}
+
+	config, err := c.deploymentConfigClient.getDeploymentConfig(deployment.Namespace, deployedConfig.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("couldn't get config of deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	if config.RevisionHistoryLimit == nil || config.LatestVersion != deployutil.DeploymentVersionFor(deployment) {
+		return nil
+	}
+
+	deployments, err := c.deploymentClient.listDeploymentsForConfig(deployment.Namespace, config.Name)
+	if err != nil {
+		return fmt.Errorf("couldn't list deployments for config %s/%s: %v", config.Namespace, config.Name, err)
+	}
+	rollbackTarget := config.Annotations[deployapi.DeploymentConfigRollbackTargetAnnotation]
+	candidates := []*kapi.ReplicationController{}
+	for i := range deployments.Items {
+		candidate := &deployments.Items[i]
+		if candidate.Name == deployment.Name || candidate.Name == rollbackTarget {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	limit := *config.RevisionHistoryLimit
+	pruneFunc := func(old *kapi.ReplicationController) error {
+		if err := c.deploymentClient.deleteDeployment(old.Namespace, old.Name); err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("couldn't delete old deployment %s: %v", deployutil.LabelForDeployment(old), err)
+		}
+		glog.V(4).Infof("Deleted old deployment %s exceeding the revision history limit of %d", deployutil.LabelForDeployment(old), limit)
+		return nil
+	}
+	tasker := deployprune.NewPruneTasker([]*deployapi.DeploymentConfig{config}, candidates, 0, false, limit, limit, pruneFunc)
+	return tasker.PruneTask()
+}
+
+// isRollback returns true if config was created by an automatic rollback.
+func isRollback(config *deployapi.DeploymentConfig) bool {
+	if config.Details == nil {
+		return false
+	}
+	for _, cause := range config.Details.Causes {
+		if cause.Type == deployapi.DeploymentTriggerRollback {
+			return true
+		}
+	}
+	return false
+}
+
 // makeDeployerPod creates a pod which implements deployment behavior. The pod is correlated to
 // the deployment with an annotation.
 func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationController) (*kapi.Pod, error) {
@@ -258,6 +426,16 @@ func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationContr
 type deploymentClient interface {
 	getDeployment(namespace, name string) (*kapi.ReplicationController, error)
 	updateDeployment(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	// listDeploymentsForConfig should return deployments associated with the
+	// provided config.
+	listDeploymentsForConfig(namespace, configName string) (*kapi.ReplicationControllerList, error)
+	deleteDeployment(namespace, name string) error
+}
+
+// deploymentConfigClient abstracts access to deployment configs.
+type deploymentConfigClient interface {
+	getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error)
+	updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
 }
 
 // podClient abstracts access to pods.
@@ -271,8 +449,10 @@ type podClient interface {
 
 // deploymentClientImpl is a pluggable deploymentClient.
 type deploymentClientImpl struct {
-	getDeploymentFunc    func(namespace, name string) (*kapi.ReplicationController, error)
-	updateDeploymentFunc func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	getDeploymentFunc            func(namespace, name string) (*kapi.ReplicationController, error)
+	updateDeploymentFunc         func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	listDeploymentsForConfigFunc func(namespace, configName string) (*kapi.ReplicationControllerList, error)
+	deleteDeploymentFunc         func(namespace, name string) error
 }
 
 func (i *deploymentClientImpl) getDeployment(namespace, name string) (*kapi.ReplicationController, error) {
@@ -283,6 +463,28 @@ func (i *deploymentClientImpl) updateDeployment(namespace string, deployment *ka
 	return i.updateDeploymentFunc(namespace, deployment)
 }
 
+func (i *deploymentClientImpl) listDeploymentsForConfig(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+	return i.listDeploymentsForConfigFunc(namespace, configName)
+}
+
+func (i *deploymentClientImpl) deleteDeployment(namespace, name string) error {
+	return i.deleteDeploymentFunc(namespace, name)
+}
+
+// deploymentConfigClientImpl is a pluggable deploymentConfigClient.
+type deploymentConfigClientImpl struct {
+	getDeploymentConfigFunc    func(namespace, name string) (*deployapi.DeploymentConfig, error)
+	updateDeploymentConfigFunc func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+func (i *deploymentConfigClientImpl) getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error) {
+	return i.getDeploymentConfigFunc(namespace, name)
+}
+
+func (i *deploymentConfigClientImpl) updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	return i.updateDeploymentConfigFunc(namespace, config)
+}
+
 // podClientImpl is a pluggable podClient.
 type podClientImpl struct {
 	getPodFunc             func(namespace, name string) (*kapi.Pod, error)
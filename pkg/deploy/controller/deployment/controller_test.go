@@ -10,10 +10,12 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/client/record"
+	kutil "k8s.io/kubernetes/pkg/util"
 
 	api "github.com/openshift/origin/pkg/api/latest"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployrollback "github.com/openshift/origin/pkg/deploy/registry/rollback"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
@@ -651,6 +653,253 @@ func TestHandle_deployerPodDisappeared(t *testing.T) {
 	}
 }
 
+// rollbackDeployments returns a complete deployment of version 1 which has
+// been scaled down and a failed deployment of version 2 of a config with
+// AutoRollback set.
+func rollbackDeployments() (*kapi.ReplicationController, *kapi.ReplicationController) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.AutoRollback = true
+	last, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	last.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+	last.Spec.Replicas = 0
+
+	config.LatestVersion = 2
+	config.Template.ControllerTemplate.Template.Spec.Containers[0].Image = "registry:8080/repo1:broken"
+	failed, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	failed.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusFailed)
+	failed.Annotations[deployapi.DesiredReplicasAnnotation] = "2"
+	failed.Spec.Replicas = 0
+	return last, failed
+}
+
+func rollbackController(t *testing.T, config *deployapi.DeploymentConfig, deployments []kapi.ReplicationController, updatedDeployment **kapi.ReplicationController, updatedConfig **deployapi.DeploymentConfig) *DeploymentController {
+	return &DeploymentController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, api.Codec)
+		},
+		deploymentClient: &deploymentClientImpl{
+			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				*updatedDeployment = deployment
+				return deployment, nil
+			},
+			listDeploymentsForConfigFunc: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: deployments}, nil
+			},
+		},
+		deploymentConfigClient: &deploymentConfigClientImpl{
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return config, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				*updatedConfig = config
+				return config, nil
+			},
+		},
+		podClient: &podClientImpl{
+			createPodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+				t.Fatalf("unexpected call to create pod")
+				return nil, nil
+			},
+		},
+		makeContainer: func(strategy *deployapi.DeploymentStrategy) (*kapi.Container, error) {
+			t.Fatalf("unexpected call to make container")
+			return nil, nil
+		},
+		generateRollback: (&deployrollback.RollbackGenerator{}).GenerateRollback,
+		recorder:         &record.FakeRecorder{},
+	}
+}
+
+// TestHandle_autoRollback ensures that a failed deployment of a config with
+// AutoRollback set scales the last successful deployment back up and rolls
+// the config back to it.
+func TestHandle_autoRollback(t *testing.T) {
+	last, failed := rollbackDeployments()
+	config, _ := deployutil.DecodeDeploymentConfig(failed, api.Codec)
+
+	var updatedDeployment *kapi.ReplicationController
+	var updatedConfig *deployapi.DeploymentConfig
+	controller := rollbackController(t, config, []kapi.ReplicationController{*last, *failed}, &updatedDeployment, &updatedConfig)
+
+	if err := controller.Handle(failed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updatedDeployment == nil {
+		t.Fatalf("expected %s to be scaled back up", last.Name)
+	}
+	if e, a := last.Name, updatedDeployment.Name; e != a {
+		t.Fatalf("expected %s to be updated, got %s", e, a)
+	}
+	if e, a := 2, updatedDeployment.Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled to %d, got %d", last.Name, e, a)
+	}
+
+	if updatedConfig == nil {
+		t.Fatalf("expected the config to be rolled back")
+	}
+	if e, a := 3, updatedConfig.LatestVersion; e != a {
+		t.Errorf("expected latest version %d, got %d", e, a)
+	}
+	if e, a := "registry:8080/repo1:ref1", updatedConfig.Template.ControllerTemplate.Template.Spec.Containers[0].Image; e != a {
+		t.Errorf("expected the template to be rolled back to image %s, got %s", e, a)
+	}
+	if !isRollback(updatedConfig) {
+		t.Errorf("expected a rollback cause, got %#v", updatedConfig.Details)
+	}
+	if e, a := last.Name, updatedConfig.Annotations[deployapi.DeploymentConfigRollbackTargetAnnotation]; e != a {
+		t.Errorf("expected rollback target %s, got %s", e, a)
+	}
+}
+
+// TestHandle_autoRollbackNoop ensures that failed deployments which shouldn't
+// be rolled back are left alone.
+func TestHandle_autoRollbackNoop(t *testing.T) {
+	tests := map[string]func(config *deployapi.DeploymentConfig, failed *kapi.ReplicationController){
+		"cancelled": func(config *deployapi.DeploymentConfig, failed *kapi.ReplicationController) {
+			failed.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+		},
+		"disabled": func(config *deployapi.DeploymentConfig, failed *kapi.ReplicationController) {
+			config.AutoRollback = false
+		},
+		"superseded": func(config *deployapi.DeploymentConfig, failed *kapi.ReplicationController) {
+			config.LatestVersion++
+		},
+		"rollback": func(config *deployapi.DeploymentConfig, failed *kapi.ReplicationController) {
+			failedConfig, _ := deployutil.DecodeDeploymentConfig(failed, api.Codec)
+			failedConfig.Details = &deployapi.DeploymentDetails{
+				Causes: []*deployapi.DeploymentCause{{Type: deployapi.DeploymentTriggerRollback}},
+			}
+			encoded, _ := deployutil.EncodeDeploymentConfig(failedConfig, api.Codec)
+			failed.Annotations[deployapi.DeploymentEncodedConfigAnnotation] = encoded
+		},
+	}
+
+	for name, mutate := range tests {
+		last, failed := rollbackDeployments()
+		config, _ := deployutil.DecodeDeploymentConfig(failed, api.Codec)
+		mutate(config, failed)
+
+		var updatedDeployment *kapi.ReplicationController
+		var updatedConfig *deployapi.DeploymentConfig
+		controller := rollbackController(t, config, []kapi.ReplicationController{*last, *failed}, &updatedDeployment, &updatedConfig)
+
+		if err := controller.Handle(failed); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if updatedDeployment != nil {
+			t.Errorf("%s: unexpected deployment update: %#v", name, updatedDeployment)
+		}
+		if updatedConfig != nil {
+			t.Errorf("%s: unexpected config update: %#v", name, updatedConfig)
+		}
+	}
+}
+
+// historyDeployments returns a complete deployment for each of the statuses
+// of a config with a RevisionHistoryLimit of 1, followed by the latest
+// complete deployment of the config. All but the latest deployment are scaled
+// down.
+func historyDeployments(statuses ...deployapi.DeploymentStatus) (*deployapi.DeploymentConfig, []kapi.ReplicationController) {
+	limit := 1
+	config := deploytest.OkDeploymentConfig(0)
+	config.RevisionHistoryLimit = &limit
+	deployments := []kapi.ReplicationController{}
+	for i, status := range append(statuses, deployapi.DeploymentStatusComplete) {
+		config.LatestVersion = i + 1
+		deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+		deployment.CreationTimestamp = kutil.Unix(int64(i), 0)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Spec.Replicas = 0
+		deployments = append(deployments, *deployment)
+	}
+	deployments[len(deployments)-1].Spec.Replicas = 1
+	return config, deployments
+}
+
+func historyController(t *testing.T, config *deployapi.DeploymentConfig, deployments []kapi.ReplicationController, deleted *[]string) *DeploymentController {
+	return &DeploymentController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, api.Codec)
+		},
+		deploymentClient: &deploymentClientImpl{
+			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				t.Fatalf("unexpected deployment update")
+				return nil, nil
+			},
+			listDeploymentsForConfigFunc: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: deployments}, nil
+			},
+			deleteDeploymentFunc: func(namespace, name string) error {
+				*deleted = append(*deleted, name)
+				return nil
+			},
+		},
+		deploymentConfigClient: &deploymentConfigClientImpl{
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return config, nil
+			},
+		},
+		podClient: &podClientImpl{
+			getDeployerPodsForFunc: func(namespace, name string) ([]kapi.Pod, error) {
+				return []kapi.Pod{}, nil
+			},
+		},
+		recorder: &record.FakeRecorder{},
+	}
+}
+
+// TestHandle_cleanupOldDeployments ensures that old deployments exceeding the
+// revision history limit are deleted after a successful deployment, except
+// for the target of the most recent rollback.
+func TestHandle_cleanupOldDeployments(t *testing.T) {
+	complete, failed := deployapi.DeploymentStatusComplete, deployapi.DeploymentStatusFailed
+	config, deployments := historyDeployments(complete, complete, failed, failed, complete)
+	config.Annotations = map[string]string{deployapi.DeploymentConfigRollbackTargetAnnotation: deployments[0].Name}
+
+	deleted := []string{}
+	controller := historyController(t, config, deployments, &deleted)
+	latest := deployments[len(deployments)-1]
+	if err := controller.Handle(&latest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{deployments[1].Name, deployments[2].Name}
+	sort.Strings(deleted)
+	if !reflect.DeepEqual(expected, deleted) {
+		t.Errorf("expected deleted deployments %v, got %v", expected, deleted)
+	}
+}
+
+// TestHandle_cleanupOldDeploymentsNoop ensures that no deployments are
+// deleted without a revision history limit or for superseded deployments.
+func TestHandle_cleanupOldDeploymentsNoop(t *testing.T) {
+	tests := map[string]func(config *deployapi.DeploymentConfig){
+		"unlimited": func(config *deployapi.DeploymentConfig) {
+			config.RevisionHistoryLimit = nil
+		},
+		"superseded": func(config *deployapi.DeploymentConfig) {
+			config.LatestVersion++
+		},
+	}
+
+	for name, mutate := range tests {
+		complete := deployapi.DeploymentStatusComplete
+		config, deployments := historyDeployments(complete, complete, complete)
+		mutate(config)
+
+		deleted := []string{}
+		controller := historyController(t, config, deployments, &deleted)
+		latest := deployments[len(deployments)-1]
+		if err := controller.Handle(&latest); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if len(deleted) > 0 {
+			t.Errorf("%s: unexpected deletions: %v", name, deleted)
+		}
+	}
+}
+
 func okContainer() *kapi.Container {
 	return &kapi.Container{
 		Image:   "test/image",
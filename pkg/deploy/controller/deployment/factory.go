@@ -14,14 +14,18 @@ import (
 	kutil "k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/watch"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployrollback "github.com/openshift/origin/pkg/deploy/registry/rollback"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
 // DeploymentControllerFactory can create a DeploymentController that creates
 // deployer pods in a configurable way.
 type DeploymentControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
 	// Codec is used for encoding/decoding.
@@ -61,6 +65,20 @@ func (factory *DeploymentControllerFactory) Create() controller.RunnableControll
 			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
 				return factory.KubeClient.ReplicationControllers(namespace).Update(deployment)
 			},
+			listDeploymentsForConfigFunc: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return factory.KubeClient.ReplicationControllers(namespace).List(deployutil.ConfigSelector(configName))
+			},
+			deleteDeploymentFunc: func(namespace, name string) error {
+				return factory.KubeClient.ReplicationControllers(namespace).Delete(name)
+			},
+		},
+		deploymentConfigClient: &deploymentConfigClientImpl{
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Get(name)
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Update(config)
+			},
 		},
 		podClient: &podClientImpl{
 			getPodFunc: func(namespace, name string) (*kapi.Pod, error) {
@@ -95,7 +113,8 @@ func (factory *DeploymentControllerFactory) Create() controller.RunnableControll
 		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
 			return deployutil.DecodeDeploymentConfig(deployment, factory.Codec)
 		},
-		recorder: eventBroadcaster.NewRecorder(kapi.EventSource{Component: "deployer"}),
+		generateRollback: (&deployrollback.RollbackGenerator{}).GenerateRollback,
+		recorder:         eventBroadcaster.NewRecorder(kapi.EventSource{Component: "deployer"}),
 	}
 
 	return &controller.RetryController{
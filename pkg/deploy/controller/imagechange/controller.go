@@ -35,6 +35,11 @@ func (c *ImageChangeController) Handle(imageRepo *imageapi.ImageStream) error {
 	// Find any configs which should be updated based on the new image state
 	configsToUpdate := map[string]*deployapi.DeploymentConfig{}
 	for _, config := range configs {
+		if config.Paused {
+			glog.V(4).Infof("Ignoring image changes for DeploymentConfig %s; paused", deployutil.LabelForDeploymentConfig(config))
+			continue
+		}
+
 		glog.V(4).Infof("Detecting changed images for DeploymentConfig %s", deployutil.LabelForDeploymentConfig(config))
 
 		for _, trigger := range config.Triggers {
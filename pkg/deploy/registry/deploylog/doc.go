@@ -0,0 +1,3 @@
+// Package deploylog contains the REST support for streaming the log of the
+// deployer pod of a DeploymentConfig's deployment.
+package deploylog
@@ -0,0 +1,178 @@
+package deploylog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	kclient "k8s.io/kubernetes/pkg/client"
+	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
+	"k8s.io/kubernetes/pkg/registry/pod"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/deploy/api/validation"
+	"github.com/openshift/origin/pkg/deploy/registry/deployconfig"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// REST is an implementation of RESTStorage for the api server.
+type REST struct {
+	ConfigRegistry   deployconfig.Registry
+	DeploymentGetter deploymentGetter
+	PodGetter        pod.ResourceGetter
+	ConnectionInfo   kclient.ConnectionInfoGetter
+	Timeout          time.Duration
+	Interval         time.Duration
+}
+
+// deploymentGetter knows how to get a deployment.
+type deploymentGetter interface {
+	Get(ctx kapi.Context, name string) (*kapi.ReplicationController, error)
+}
+
+type podGetter struct {
+	podsNamespacer kclient.PodsNamespacer
+}
+
+func (g *podGetter) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	ns, ok := kapi.NamespaceFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("namespace parameter required.")
+	}
+	return g.podsNamespacer.Pods(ns).Get(name)
+}
+
+type rcGetter struct {
+	rcNamespacer kclient.ReplicationControllersNamespacer
+}
+
+func (g *rcGetter) Get(ctx kapi.Context, name string) (*kapi.ReplicationController, error) {
+	ns, ok := kapi.NamespaceFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("namespace parameter required.")
+	}
+	return g.rcNamespacer.ReplicationControllers(ns).Get(name)
+}
+
+const (
+	defaultTimeout  time.Duration = 10 * time.Second
+	defaultInterval time.Duration = 1 * time.Second
+)
+
+// NewREST creates a new REST for DeploymentLog
+// Takes deployment config registry and kube client to get necessary attributes
+// to assemble URL to which the request shall be redirected in order to get the
+// log of the deployer pod of a deployment.
+func NewREST(configRegistry deployconfig.Registry, kc kclient.Interface, connectionInfo kclient.ConnectionInfoGetter) *REST {
+	return &REST{
+		ConfigRegistry:   configRegistry,
+		DeploymentGetter: &rcGetter{kc},
+		PodGetter:        &podGetter{kc},
+		ConnectionInfo:   connectionInfo,
+		Timeout:          defaultTimeout,
+		Interval:         defaultInterval,
+	}
+}
+
+var _ = rest.GetterWithOptions(&REST{})
+
+// Get returns a streamer resource with the contents of the deployer pod log
+// of the latest or the requested deployment of the named config.
+func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.Object, error) {
+	deployLogOpts, ok := opts.(*api.DeploymentLogOptions)
+	if !ok {
+		return nil, errors.NewBadRequest("did not get an expected options.")
+	}
+	if errs := validation.ValidateDeploymentLogOptions(deployLogOpts); len(errs) > 0 {
+		return nil, errors.NewInvalid("deploymentLogOptions", "", errs)
+	}
+	config, err := r.ConfigRegistry.GetDeploymentConfig(ctx, name)
+	if err != nil {
+		return nil, errors.NewNotFound("deploymentConfig", name)
+	}
+
+	version := config.LatestVersion
+	if deployLogOpts.Version > 0 {
+		version = deployLogOpts.Version
+	}
+	if version == 0 || version > config.LatestVersion {
+		return nil, errors.NewBadRequest(fmt.Sprintf("deployment config %s/%s has no deployment with version %d", config.Namespace, config.Name, version))
+	}
+
+	deploymentName := deployutil.DeploymentNameForConfigVersion(config.Name, version)
+	deployment, err := r.DeploymentGetter.Get(ctx, deploymentName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NewNotFound("deployment", deploymentName)
+		}
+		return nil, err
+	}
+
+	switch deployutil.DeploymentStatusFor(deployment) {
+	// The deployer pod has not launched, wait til it runs
+	case api.DeploymentStatusNew, api.DeploymentStatusPending:
+		if deployLogOpts.NoWait {
+			glog.V(4).Infof("Deployment %s is in %s state. No logs to retrieve yet.", deployutil.LabelForDeployment(deployment), deployutil.DeploymentStatusFor(deployment))
+			// return empty content if not waiting for the deployment
+			return &genericrest.LocationStreamer{}, nil
+		}
+		glog.V(4).Infof("Deployment %s is in %s state, waiting for it to start", deployutil.LabelForDeployment(deployment), deployutil.DeploymentStatusFor(deployment))
+		if err := r.waitForDeployment(ctx, deployment); err != nil {
+			return nil, err
+		}
+	}
+
+	deployerPodName := deployutil.DeployerPodNameForDeployment(deployment.Name)
+	logOpts := &kapi.PodLogOptions{
+		Follow: deployLogOpts.Follow,
+	}
+	location, transport, err := pod.LogLocation(r.PodGetter, r.ConnectionInfo, ctx, deployerPodName, logOpts)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NewBadRequest(fmt.Sprintf("the deployer pod of deployment %s no longer exists", deployutil.LabelForDeployment(deployment)))
+		}
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	return &genericrest.LocationStreamer{
+		Location:    location,
+		Transport:   transport,
+		ContentType: "text/plain",
+		Flush:       deployLogOpts.Follow,
+	}, nil
+}
+
+// waitForDeployment waits until the deployer pod of deployment runs or the
+// deployment finished.
+func (r *REST) waitForDeployment(ctx kapi.Context, deployment *kapi.ReplicationController) error {
+	err := wait.Poll(r.Interval, r.Timeout, func() (bool, error) {
+		current, err := r.DeploymentGetter.Get(ctx, deployment.Name)
+		if err != nil {
+			return false, err
+		}
+		switch deployutil.DeploymentStatusFor(current) {
+		case api.DeploymentStatusRunning, api.DeploymentStatusComplete, api.DeploymentStatusFailed:
+			return true, nil
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.NewTimeoutError(fmt.Sprintf("timed out waiting for deployment %s to start", deployutil.LabelForDeployment(deployment)), 1)
+	}
+	return err
+}
+
+// NewGetOptions returns a new options object for deployment logs
+func (r *REST) NewGetOptions() (runtime.Object, bool, string) {
+	return &api.DeploymentLogOptions{}, false, ""
+}
+
+// New creates an empty DeploymentLog resource
+func (r *REST) New() runtime.Object {
+	return &api.DeploymentLog{}
+}
@@ -0,0 +1,199 @@
+package deploylog
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	kclient "k8s.io/kubernetes/pkg/client"
+	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	"github.com/openshift/origin/pkg/deploy/registry/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+type testPodGetter struct {
+	pods map[string]*kapi.Pod
+}
+
+func (p *testPodGetter) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	pod, ok := p.pods[name]
+	if !ok {
+		return nil, errors.NewNotFound("pod", name)
+	}
+	return pod, nil
+}
+
+type testDeploymentGetter struct {
+	deployments map[string][]*kapi.ReplicationController
+}
+
+// Get returns the next version of the named deployment on each call, staying
+// at the last one.
+func (g *testDeploymentGetter) Get(ctx kapi.Context, name string) (*kapi.ReplicationController, error) {
+	versions, ok := g.deployments[name]
+	if !ok {
+		return nil, errors.NewNotFound("replicationController", name)
+	}
+	if len(versions) > 1 {
+		g.deployments[name] = versions[1:]
+	}
+	return versions[0], nil
+}
+
+func mockDeployment(version int, status api.DeploymentStatus) *kapi.ReplicationController {
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codec)
+	deployment.Namespace = kapi.NamespaceDefault
+	deployment.Annotations[api.DeploymentStatusAnnotation] = string(status)
+	return deployment
+}
+
+func mockDeployerPod(deployment *kapi.ReplicationController) *kapi.Pod {
+	return &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      deployutil.DeployerPodNameForDeployment(deployment.Name),
+			Namespace: kapi.NamespaceDefault,
+		},
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{
+					Name: "deployment",
+				},
+			},
+			NodeName: "foo-host",
+		},
+	}
+}
+
+func mockREST(latestVersion int, deployments ...[]*kapi.ReplicationController) *REST {
+	config := deploytest.OkDeploymentConfig(latestVersion)
+	config.Namespace = kapi.NamespaceDefault
+	deploymentGetter := &testDeploymentGetter{deployments: map[string][]*kapi.ReplicationController{}}
+	podGetter := &testPodGetter{pods: map[string]*kapi.Pod{}}
+	for _, versions := range deployments {
+		deploymentGetter.deployments[versions[0].Name] = versions
+		if status := deployutil.DeploymentStatusFor(versions[len(versions)-1]); status != api.DeploymentStatusNew {
+			pod := mockDeployerPod(versions[0])
+			podGetter.pods[pod.Name] = pod
+		}
+	}
+	return &REST{
+		ConfigRegistry:   &test.DeploymentConfigRegistry{DeploymentConfig: config},
+		DeploymentGetter: deploymentGetter,
+		PodGetter:        podGetter,
+		ConnectionInfo:   &kclient.HTTPKubeletClient{Config: &kclient.KubeletConfig{EnableHttps: true, Port: 12345}, Client: &http.Client{}},
+		Timeout:          defaultTimeout,
+		Interval:         10 * time.Millisecond,
+	}
+}
+
+func getLocation(storage *REST, opts *api.DeploymentLogOptions) (string, error) {
+	getter := rest.GetterWithOptions(storage)
+	obj, err := getter.Get(kapi.NewDefaultContext(), "config", opts)
+	if err != nil {
+		return "", err
+	}
+	streamer, ok := obj.(*genericrest.LocationStreamer)
+	if !ok {
+		return "", fmt.Errorf("Result of get not LocationStreamer")
+	}
+	if streamer.Location != nil {
+		return streamer.Location.String(), nil
+	}
+	return "", nil
+}
+
+// TestRegistryResourceLocation tests if proper resource location URL is
+// returned for different deployment states.
+func TestRegistryResourceLocation(t *testing.T) {
+	expectedLocation := fmt.Sprintf("https://foo-host:12345/containerLogs/%s/config-1-deploy/deployment", kapi.NamespaceDefault)
+	expectedLocations := map[api.DeploymentStatus]string{
+		api.DeploymentStatusNew:      "",
+		api.DeploymentStatusPending:  "",
+		api.DeploymentStatusRunning:  expectedLocation,
+		api.DeploymentStatusComplete: expectedLocation,
+		api.DeploymentStatusFailed:   expectedLocation,
+	}
+
+	for status, expected := range expectedLocations {
+		storage := mockREST(1, []*kapi.ReplicationController{mockDeployment(1, status)})
+		location, err := getLocation(storage, &api.DeploymentLogOptions{NoWait: true})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", status, err)
+		}
+		if location != expected {
+			t.Errorf("%s: expected location %s, got %s", status, expected, location)
+		}
+	}
+}
+
+func TestRegistryResourceLocationVersion(t *testing.T) {
+	storage := mockREST(2,
+		[]*kapi.ReplicationController{mockDeployment(1, api.DeploymentStatusComplete)},
+		[]*kapi.ReplicationController{mockDeployment(2, api.DeploymentStatusRunning)},
+	)
+
+	location, err := getLocation(storage, &api.DeploymentLogOptions{Version: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(location, "/config-1-deploy/") {
+		t.Errorf("expected the log of version 1, got %s", location)
+	}
+
+	location, err = getLocation(storage, &api.DeploymentLogOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(location, "/config-2-deploy/") {
+		t.Errorf("expected the log of the latest version, got %s", location)
+	}
+
+	if _, err := getLocation(storage, &api.DeploymentLogOptions{Version: 3}); err == nil || !errors.IsBadRequest(err) {
+		t.Errorf("expected a bad request for a missing version, got %v", err)
+	}
+}
+
+func TestRegistryResourceLocationPodDeleted(t *testing.T) {
+	storage := mockREST(1, []*kapi.ReplicationController{mockDeployment(1, api.DeploymentStatusComplete)})
+	storage.PodGetter = &testPodGetter{pods: map[string]*kapi.Pod{}}
+
+	_, err := getLocation(storage, &api.DeploymentLogOptions{})
+	if err == nil || !strings.Contains(err.Error(), "no longer exists") {
+		t.Errorf("expected an error for the deleted deployer pod, got %v", err)
+	}
+}
+
+func TestWaitForDeployment(t *testing.T) {
+	storage := mockREST(1, []*kapi.ReplicationController{
+		mockDeployment(1, api.DeploymentStatusNew),
+		mockDeployment(1, api.DeploymentStatusPending),
+		mockDeployment(1, api.DeploymentStatusRunning),
+	})
+
+	location, err := getLocation(storage, &api.DeploymentLogOptions{Follow: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(location, "follow=true") {
+		t.Errorf("expected the log to be followed, got %s", location)
+	}
+}
+
+func TestWaitForDeploymentTimeout(t *testing.T) {
+	storage := mockREST(1, []*kapi.ReplicationController{mockDeployment(1, api.DeploymentStatusPending)})
+	storage.Timeout = 100 * time.Millisecond
+
+	_, err := getLocation(storage, &api.DeploymentLogOptions{})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Unexpected error result from waitForDeployment: %v", err)
+	}
+}
@@ -61,6 +61,13 @@ func (g *RollbackGenerator) GenerateRollback(from, to *deployapi.DeploymentConfi
 		}
 	}
 
+	// Record the target of the rollback so it's retained when old deployments
+	// are cleaned up.
+	if rollback.Annotations == nil {
+		rollback.Annotations = map[string]string{}
+	}
+	rollback.Annotations[deployapi.DeploymentConfigRollbackTargetAnnotation] = spec.From.Name
+
 	// TODO: add a new cause?
 	rollback.LatestVersion++
 
@@ -0,0 +1,214 @@
+package bluegreen
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// BlueGreenDeploymentStrategy scales up the new deployment next to the old
+// one, waits for its pods to become ready and for an optional verification
+// hook to succeed, and then switches a service from the old deployment to the
+// new one.
+//
+// The old deployment is left scaled up after the switch. Nothing switches the
+// service back to it; it is scaled down by the deployer once a newer
+// deployment replaces it.
+//
+// The service stays pinned to the deployment label of the active deployment.
+// The deployer removes that label again when a later deployment of the config
+// uses another strategy.
+type BlueGreenDeploymentStrategy struct {
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// getService knows how to get a service.
+	getService func(namespace, name string) (*kapi.Service, error)
+	// updateService knows how to update a service.
+	updateService func(namespace string, service *kapi.Service) (*kapi.Service, error)
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// codec is used to decode DeploymentConfigs contained in deployments.
+	codec runtime.Codec
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the pods of the
+	// new deployment are ready.
+	getUpdateAcceptor func(timeout time.Duration) kubectl.UpdateAcceptor
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// NewBlueGreenDeploymentStrategy makes a BlueGreenDeploymentStrategy backed by
+// a real HookExecutor and client.
+func NewBlueGreenDeploymentStrategy(client kclient.Interface, tagClient osclient.ImageStreamsNamespacer, codec runtime.Codec) *BlueGreenDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor("ReplicationController", kubectl.NewScalerClient(client))
+	return &BlueGreenDeploymentStrategy{
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			return client.Services(namespace).Get(name)
+		},
+		updateService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+			return client.Services(namespace).Update(service)
+		},
+		scaler: scaler,
+		codec:  codec,
+		hookExecutor: &stratsupport.HookExecutor{
+			PodClient: &stratsupport.HookExecutorPodClientImpl{
+				CreatePodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+					return client.Pods(namespace).Create(pod)
+				},
+				PodWatchFunc: func(namespace, name, resourceVersion string, stopChannel chan struct{}) func() *kapi.Pod {
+					return stratsupport.NewPodWatch(client, namespace, name, resourceVersion, stopChannel)
+				},
+			},
+			ImageStreamClient: tagClient,
+		},
+		getUpdateAcceptor: func(timeout time.Duration) kubectl.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		retryTimeout: 120 * time.Second,
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy scales up to next to from and switches the service of the strategy
+// to to once it is ready. If to does not become ready or its verification
+// fails, to is scaled back down and the service keeps selecting from.
+func (s *BlueGreenDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.codec)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Template.Strategy.BlueGreenParams
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+
+	// Make sure the service only sends traffic to the old deployment while the
+	// new one comes up.
+	if from != nil {
+		if err := s.switchService(params.ServiceName, from); err != nil {
+			return err
+		}
+	}
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, "prehook"); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	// Scale up the to deployment next to the from deployment and wait for its
+	// pods to become ready.
+	if desiredReplicas > 0 {
+		glog.Infof("Scaling %s to %d", deployutil.LabelForDeployment(to), desiredReplicas)
+		if err := s.scaler.Scale(to.Namespace, to.Name, uint(desiredReplicas), &kubectl.ScalePrecondition{-1, ""}, retryParams, waitParams); err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err)
+		}
+		updatedTo, err := s.getReplicationController(to.Namespace, to.Name)
+		if err != nil {
+			return fmt.Errorf("couldn't look up deployment %s: %v", deployutil.LabelForDeployment(to), err)
+		}
+		to = updatedTo
+
+		acceptor := s.getUpdateAcceptor(time.Duration(*params.TimeoutSeconds) * time.Second)
+		if err := acceptor.Accept(to); err != nil {
+			return s.abort(to, fmt.Errorf("%s did not become ready: %v", deployutil.LabelForDeployment(to), err))
+		}
+	}
+
+	// Execute any verification hook before the new deployment receives traffic.
+	if params.Verify != nil {
+		if err := s.hookExecutor.Execute(params.Verify, to, "verifyhook"); err != nil {
+			return s.abort(to, fmt.Errorf("Verify hook failed: %s", err))
+		}
+		glog.Infof("Verify hook finished")
+	}
+
+	// Cut the service over to the new deployment.
+	if err := s.switchService(params.ServiceName, to); err != nil {
+		return s.abort(to, err)
+	}
+
+	// Execute any post-hook. Errors are logged and ignored.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, "posthook"); err != nil {
+			util.HandleError(fmt.Errorf("post hook failed: %s", err))
+		} else {
+			glog.Infof("Post hook finished")
+		}
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// switchService makes the named service select only the pods of deployment.
+func (s *BlueGreenDeploymentStrategy) switchService(name string, deployment *kapi.ReplicationController) error {
+	service, err := s.getService(deployment.Namespace, name)
+	if err != nil {
+		return fmt.Errorf("couldn't get service %s/%s: %v", deployment.Namespace, name, err)
+	}
+	if service.Spec.Selector[deployapi.DeploymentLabel] == deployment.Name {
+		return nil
+	}
+	selector := map[string]string{}
+	for k, v := range service.Spec.Selector {
+		selector[k] = v
+	}
+	selector[deployapi.DeploymentLabel] = deployment.Name
+	service.Spec.Selector = selector
+	if _, err := s.updateService(service.Namespace, service); err != nil {
+		return fmt.Errorf("couldn't switch service %s/%s to %s: %v", service.Namespace, service.Name, deployutil.LabelForDeployment(deployment), err)
+	}
+	glog.Infof("Switched service %s to %s", service.Name, deployutil.LabelForDeployment(deployment))
+	return nil
+}
+
+// abort scales the new deployment back down after it failed, leaving the old
+// deployment serving, and returns the reason of the failure.
+func (s *BlueGreenDeploymentStrategy) abort(to *kapi.ReplicationController, reason error) error {
+	retryWaitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	if err := s.scaler.Scale(to.Namespace, to.Name, 0, &kubectl.ScalePrecondition{-1, ""}, retryWaitParams, retryWaitParams); err != nil {
+		util.HandleError(fmt.Errorf("couldn't scale %s back to 0: %v", deployutil.LabelForDeployment(to), err))
+	}
+	return reason
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
@@ -0,0 +1,180 @@
+package bluegreen
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubectl"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+type fakeAcceptor struct {
+	err error
+}
+
+func (a *fakeAcceptor) Accept(*kapi.ReplicationController) error {
+	return a.err
+}
+
+// testStrategy returns a strategy serving service and a pointer which is
+// updated with the last service update.
+func testStrategy(scaler kubectl.Scaler, service *kapi.Service, acceptErr error, executeFunc func(*deployapi.LifecycleHook, *kapi.ReplicationController, string) error) (*BlueGreenDeploymentStrategy, **kapi.Service) {
+	var updated *kapi.Service
+	strategy := &BlueGreenDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return &kapi.ReplicationController{ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name}}, nil
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			if updated != nil {
+				copied := *updated
+				return &copied, nil
+			}
+			copied := *service
+			return &copied, nil
+		},
+		updateService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+			updated = service
+			return service, nil
+		},
+		getUpdateAcceptor: func(timeout time.Duration) kubectl.UpdateAcceptor {
+			return &fakeAcceptor{err: acceptErr}
+		},
+		hookExecutor: &hookExecutorImpl{executeFunc: executeFunc},
+		scaler:       scaler,
+	}
+	return strategy, &updated
+}
+
+func blueGreenDeployment(version int, verify *deployapi.LifecycleHook) *kapi.ReplicationController {
+	config := deploytest.OkDeploymentConfig(version)
+	config.Template.Strategy = deploytest.OkBlueGreenStrategy()
+	config.Template.Strategy.BlueGreenParams.Verify = verify
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	return deployment
+}
+
+func okService() *kapi.Service {
+	return &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "service", Namespace: kapi.NamespaceDefault},
+		Spec: kapi.ServiceSpec{
+			Selector: map[string]string{"a": "b"},
+		},
+	}
+}
+
+func TestBlueGreen_initialDeployment(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, updated := testStrategy(scaler, okService(), nil, nil)
+
+	to := blueGreenDeployment(1, nil)
+	if err := strategy.Deploy(nil, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	if e, a := 1, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+	if e, a := uint(2), scaler.Events[0].Size; e != a {
+		t.Errorf("expected scale up to %d, got %d", e, a)
+	}
+	if *updated == nil {
+		t.Fatalf("expected the service to be updated")
+	}
+	if e, a := to.Name, (*updated).Spec.Selector[deployapi.DeploymentLabel]; e != a {
+		t.Errorf("expected service to select %s, got %s", e, a)
+	}
+	if e, a := "b", (*updated).Spec.Selector["a"]; e != a {
+		t.Errorf("expected the existing selector to be preserved, got %s", a)
+	}
+}
+
+func TestBlueGreen_cutover(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	from := blueGreenDeployment(1, nil)
+	service := okService()
+	service.Spec.Selector[deployapi.DeploymentLabel] = from.Name
+
+	verified := false
+	var updated **kapi.Service
+	strategy, updated := testStrategy(scaler, service, nil, func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+		if *updated != nil && (*updated).Spec.Selector[deployapi.DeploymentLabel] != from.Name {
+			t.Errorf("expected the service to be switched after verification")
+		}
+		verified = true
+		return nil
+	})
+
+	to := blueGreenDeployment(2, &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort})
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	if !verified {
+		t.Fatalf("expected verify hook execution")
+	}
+	for _, event := range scaler.Events {
+		if event.Name == from.Name {
+			t.Errorf("expected %s to be left alone, got scale event %#v", from.Name, event)
+		}
+	}
+	if e, a := to.Name, (*updated).Spec.Selector[deployapi.DeploymentLabel]; e != a {
+		t.Errorf("expected service to select %s, got %s", e, a)
+	}
+}
+
+func TestBlueGreen_verifyHookFail(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	from := blueGreenDeployment(1, nil)
+	service := okService()
+	service.Spec.Selector[deployapi.DeploymentLabel] = from.Name
+
+	strategy, updated := testStrategy(scaler, service, nil, func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+		return fmt.Errorf("hook execution failure")
+	})
+
+	to := blueGreenDeployment(2, &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort})
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	if *updated != nil {
+		t.Errorf("expected the service to be left alone, got %#v", (*updated).Spec.Selector)
+	}
+	if e, a := 2, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+	if e, a := uint(0), scaler.Events[1].Size; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", to.Name, e, a)
+	}
+}
+
+func TestBlueGreen_notReady(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	from := blueGreenDeployment(1, nil)
+	service := okService()
+	service.Spec.Selector[deployapi.DeploymentLabel] = from.Name
+
+	strategy, updated := testStrategy(scaler, service, fmt.Errorf("timed out"), nil)
+
+	to := blueGreenDeployment(2, nil)
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	if *updated != nil {
+		t.Errorf("expected the service to be left alone, got %#v", (*updated).Spec.Selector)
+	}
+	if e, a := uint(0), scaler.Events[len(scaler.Events)-1].Size; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", to.Name, e, a)
+	}
+}
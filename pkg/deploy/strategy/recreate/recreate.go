@@ -12,6 +12,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
@@ -41,7 +42,7 @@ type RecreateDeploymentStrategy struct {
 
 // NewRecreateDeploymentStrategy makes a RecreateDeploymentStrategy backed by
 // a real HookExecutor and client.
-func NewRecreateDeploymentStrategy(client kclient.Interface, codec runtime.Codec) *RecreateDeploymentStrategy {
+func NewRecreateDeploymentStrategy(client kclient.Interface, tagClient osclient.ImageStreamsNamespacer, codec runtime.Codec) *RecreateDeploymentStrategy {
 	scaler, _ := kubectl.ScalerFor("ReplicationController", kubectl.NewScalerClient(client))
 	return &RecreateDeploymentStrategy{
 		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
@@ -58,6 +59,7 @@ func NewRecreateDeploymentStrategy(client kclient.Interface, codec runtime.Codec
 					return stratsupport.NewPodWatch(client, namespace, name, resourceVersion, stopChannel)
 				},
 			},
+			ImageStreamClient: tagClient,
 		},
 		retryTimeout: 120 * time.Second,
 		retryPeriod:  1 * time.Second,
@@ -104,6 +106,14 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 		}
 	}
 
+	// Execute any mid-hook.
+	if params != nil && params.Mid != nil {
+		if err := s.hookExecutor.Execute(params.Mid, to, "midhook"); err != nil {
+			return fmt.Errorf("Mid hook failed: %s", err)
+		}
+		glog.Infof("Mid hook finished")
+	}
+
 	// Scale up the to deployment.
 	if desiredReplicas > 0 {
 		// If an UpdateAcceptor is provided, scale up to 1 and validate the replica,
@@ -104,6 +104,88 @@ func TestRecreate_deploymentPreHookFail(t *testing.T) {
 	}
 }
 
+func TestRecreate_deploymentMidHookSuccess(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Template.Strategy.RecreateParams = recreateParams("", "")
+	config.Template.Strategy.RecreateParams.Mid = &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		ExecNewPod:    &deployapi.ExecNewPodHook{},
+	}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	scaler := &scalertest.FakeScaler{}
+
+	var scaleEventsAtHook []scalertest.ScaleEvent
+	strategy := &RecreateDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				scaleEventsAtHook = append([]scalertest.ScaleEvent{}, scaler.Events...)
+				return nil
+			},
+		},
+		scaler: scaler,
+	}
+
+	from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(0), kapi.Codec)
+	err := strategy.Deploy(from, deployment, 2)
+	if err != nil {
+		t.Fatalf("unexpected deploy error: %#v", err)
+	}
+	if scaleEventsAtHook == nil {
+		t.Fatalf("expected hook execution")
+	}
+	// The hook runs once the old deployment is scaled down and before the new
+	// one is scaled up.
+	if e, a := 1, len(scaleEventsAtHook); e != a {
+		t.Fatalf("expected %d scale events before the hook, got %d: %v", e, a, scaleEventsAtHook)
+	}
+	if e, a := (scalertest.ScaleEvent{Name: from.Name, Size: 0}), scaleEventsAtHook[0]; e != a {
+		t.Errorf("expected scale event %v before the hook, got %v", e, a)
+	}
+	if e, a := 2, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale events, got %d: %v", e, a, scaler.Events)
+	}
+}
+
+func TestRecreate_deploymentMidHookFail(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Template.Strategy.RecreateParams = recreateParams("", "")
+	config.Template.Strategy.RecreateParams.Mid = &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		ExecNewPod:    &deployapi.ExecNewPodHook{},
+	}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	scaler := &scalertest.FakeScaler{}
+
+	strategy := &RecreateDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				return fmt.Errorf("hook execution failure")
+			},
+		},
+		scaler: scaler,
+	}
+
+	err := strategy.Deploy(nil, deployment, 2)
+	if err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	if len(scaler.Events) > 0 {
+		t.Fatalf("unexpected scaling events: %v", scaler.Events)
+	}
+}
+
 func TestRecreate_deploymentPostHookSuccess(t *testing.T) {
 	config := deploytest.OkDeploymentConfig(1)
 	config.Template.Strategy.RecreateParams = recreateParams("", deployapi.LifecycleHookFailurePolicyAbort)
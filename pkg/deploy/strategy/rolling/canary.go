@@ -0,0 +1,368 @@
+package rolling
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// CanaryDeploymentStrategy is a Strategy which shifts replicas from the old
+// deployment to the new one in the steps of the CanaryDeploymentStrategyParams.
+// After each step, it waits for the pods of the new deployment to become
+// ready, and then pauses or waits for the step to be approved. The remainder
+// of the deployment is rolled out with the upstream Kubernetes
+// RollingUpdater, just like the RollingDeploymentStrategy does.
+//
+// When the pods of the new deployment crash loop or don't become ready during
+// a step, the old deployment is scaled back up and the new one scaled down.
+// The same happens when a step still waits for its approval close to the
+// ActiveDeadlineSeconds of the deployer pod.
+// Crash loops are also detected while the remainder is rolled out.
+type CanaryDeploymentStrategy struct {
+	// initialStrategy is used when there are no prior deployments.
+	initialStrategy acceptingDeploymentStrategy
+	// client is used to deal with ReplicationControllers.
+	client kubectl.RollingUpdaterClient
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// listPods knows how to list the pods of a deployment.
+	listPods func(namespace string, selector labels.Selector) (*kapi.PodList, error)
+	// rollingUpdate knows how to perform a rolling update.
+	rollingUpdate func(config *kubectl.RollingUpdaterConfig) error
+	// codec is used to access the encoded config on a deployment.
+	codec runtime.Codec
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the replicas of
+	// each step of the deployment.
+	getUpdateAcceptor func(timeout time.Duration) kubectl.UpdateAcceptor
+}
+
+// canaryUpdateRetries is the number of times an update of the annotations of
+// a deployment is attempted when it conflicts with other updates.
+const canaryUpdateRetries = 5
+
+// NewCanaryDeploymentStrategy makes a new CanaryDeploymentStrategy.
+func NewCanaryDeploymentStrategy(namespace string, client kclient.Interface, tagClient osclient.ImageStreamsNamespacer, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *CanaryDeploymentStrategy {
+	rolling := NewRollingDeploymentStrategy(namespace, client, tagClient, codec, initialStrategy)
+	return &CanaryDeploymentStrategy{
+		initialStrategy:   rolling.initialStrategy,
+		client:            rolling.client,
+		scaler:            rolling.scaler,
+		listPods:          rolling.listPods,
+		rollingUpdate:     rolling.rollingUpdate,
+		codec:             codec,
+		hookExecutor:      rolling.hookExecutor,
+		getUpdateAcceptor: rolling.getUpdateAcceptor,
+	}
+}
+
+func (s *CanaryDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.codec)
+	if err != nil {
+		return fmt.Errorf("couldn't decode DeploymentConfig from deployment %s: %v", deployutil.LabelForDeployment(to), err)
+	}
+
+	params := config.Template.Strategy.CanaryParams
+	interval := time.Duration(*params.IntervalSeconds) * time.Second
+	timeout := time.Duration(*params.TimeoutSeconds) * time.Second
+	updateAcceptor := s.getUpdateAcceptor(timeout)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, "prehook"); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	// Without a prior deployment there's no traffic to shift, so delegate to
+	// another strategy.
+	if from == nil {
+		if err := s.initialStrategy.DeployWithAcceptor(from, to, desiredReplicas, updateAcceptor); err != nil {
+			return err
+		}
+	} else {
+		to, err = setSourceIdAnnotation(s.client, from, to)
+		if err != nil {
+			return err
+		}
+
+		// The deployer pod is killed once it runs longer than its
+		// ActiveDeadlineSeconds, so the steps have to finish early enough to
+		// leave time for an abort.
+		deadline := to.CreationTimestamp.Add(time.Duration(deployapi.MaxDeploymentDurationSeconds)*time.Second - 2*timeout)
+		originalReplicas := from.Spec.Replicas
+		for i := range params.Steps {
+			if err := s.deployStep(from, to, desiredReplicas, i+1, &params.Steps[i], params, updateAcceptor, deadline); err != nil {
+				s.abort(from, to, originalReplicas, interval, timeout)
+				return err
+			}
+		}
+
+		updatedTo, err := s.client.GetReplicationController(to.Namespace, to.Name)
+		if err != nil {
+			return fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(to), err)
+		}
+		to = updatedTo
+		updatedFrom, err := s.client.GetReplicationController(from.Namespace, from.Name)
+		if err != nil {
+			return fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(from), err)
+		}
+		from = updatedFrom
+
+		// The rolling updater continues from the replicas the canary steps left
+		// both deployments at. See the Rolling strategy for the replicas hack.
+		if to.Spec.Replicas == 0 {
+			to.Spec.Replicas = 1
+		}
+		glog.Infof("Finishing canary deployment from %s to %s (desired replicas: %d)", deployutil.LabelForDeployment(from), deployutil.LabelForDeployment(to), desiredReplicas)
+		rollingConfig := &kubectl.RollingUpdaterConfig{
+			Out:           &rollingUpdaterWriter{},
+			OldRc:         from,
+			NewRc:         to,
+			UpdatePeriod:  interval,
+			Interval:      interval,
+			Timeout:       timeout,
+			CleanupPolicy: kubectl.PreserveRollingUpdateCleanupPolicy,
+			UpdateAcceptor: &restartCheckingAcceptor{
+				acceptor:    updateAcceptor,
+				strategy:    s,
+				maxRestarts: *params.MaxContainerRestarts,
+			},
+		}
+		if err := s.rollingUpdate(rollingConfig); err != nil {
+			if _, crashLooping := err.(crashLoopError); crashLooping {
+				s.abort(from, to, originalReplicas, interval, timeout)
+			}
+			return err
+		}
+	}
+
+	// Execute any post-hook. Errors are logged and ignored.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, "posthook"); err != nil {
+			util.HandleError(fmt.Errorf("Post hook failed: %s", err))
+		} else {
+			glog.Info("Post hook finished")
+		}
+	}
+
+	return nil
+}
+
+// deployStep scales to up and from down to the replicas of the step with the
+// given number, and waits for the pods of to to become ready and the step to
+// pause or be approved. Waiting past deadline fails the step.
+func (s *CanaryDeploymentStrategy) deployStep(from, to *kapi.ReplicationController, desiredReplicas, number int, step *deployapi.CanaryStep, params *deployapi.CanaryDeploymentStrategyParams, updateAcceptor kubectl.UpdateAcceptor, deadline time.Time) error {
+	interval := time.Duration(*params.IntervalSeconds) * time.Second
+	timeout := time.Duration(*params.TimeoutSeconds) * time.Second
+	replicas := canaryStepReplicas(step, desiredReplicas)
+	glog.Infof("Starting canary step %d of %d: scaling %s to %d", number, len(params.Steps), deployutil.LabelForDeployment(to), replicas)
+
+	updatedTo, err := s.updateAnnotations(to, func(annotations map[string]string) {
+		annotations[deployapi.DeploymentCanaryStepAnnotation] = strconv.Itoa(number)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Steps never scale the new deployment down.
+	if replicas > updatedTo.Spec.Replicas {
+		if err := s.scale(to, replicas, interval, timeout); err != nil {
+			return err
+		}
+		updatedTo, err = s.client.GetReplicationController(to.Namespace, to.Name)
+		if err != nil {
+			return fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(to), err)
+		}
+	} else {
+		replicas = updatedTo.Spec.Replicas
+	}
+	if err := updateAcceptor.Accept(updatedTo); err != nil {
+		return fmt.Errorf("canary step %d rejected for %s: %v", number, deployutil.LabelForDeployment(to), err)
+	}
+	updatedFrom, err := s.client.GetReplicationController(from.Namespace, from.Name)
+	if err != nil {
+		return fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(from), err)
+	}
+	if fromReplicas := desiredReplicas - replicas; fromReplicas < updatedFrom.Spec.Replicas {
+		if err := s.scale(from, fromReplicas, interval, timeout); err != nil {
+			return err
+		}
+	}
+	if err := s.checkRestarts(to, *params.MaxContainerRestarts); err != nil {
+		return err
+	}
+
+	// Pause and wait for an approval, making sure the pods keep running.
+	pause := time.Duration(0)
+	if step.PauseSeconds != nil {
+		pause = time.Duration(*step.PauseSeconds) * time.Second
+	}
+	pauseEnd := time.Now().Add(pause)
+	waitingForApproval := false
+	for {
+		approved := true
+		if step.ManualApproval {
+			current, err := s.client.GetReplicationController(to.Namespace, to.Name)
+			if err != nil {
+				return fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(to), err)
+			}
+			approvedStep, _ := strconv.Atoi(current.Annotations[deployapi.DeploymentCanaryApprovedStepAnnotation])
+			approved = approvedStep >= number
+			if !approved && !waitingForApproval {
+				glog.Infof("Waiting for canary step %d of %s to be approved", number, deployutil.LabelForDeployment(to))
+				if _, err := s.updateAnnotations(to, func(annotations map[string]string) {
+					annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentWaitingForApproval
+				}); err != nil {
+					return err
+				}
+				waitingForApproval = true
+			}
+		}
+		if approved && !time.Now().Before(pauseEnd) {
+			break
+		}
+		if !time.Now().Before(deadline) {
+			if approved {
+				return fmt.Errorf("canary step %d of %s didn't finish its pause before the deployment deadline", number, deployutil.LabelForDeployment(to))
+			}
+			// The deployment no longer waits for the approval.
+			if _, err := s.updateAnnotations(to, func(annotations map[string]string) {
+				delete(annotations, deployapi.DeploymentStatusReasonAnnotation)
+			}); err != nil {
+				util.HandleError(err)
+			}
+			return fmt.Errorf("canary step %d of %s wasn't approved before the deployment deadline", number, deployutil.LabelForDeployment(to))
+		}
+		time.Sleep(interval)
+		if err := s.checkRestarts(to, *params.MaxContainerRestarts); err != nil {
+			return err
+		}
+	}
+	if waitingForApproval {
+		if _, err := s.updateAnnotations(to, func(annotations map[string]string) {
+			delete(annotations, deployapi.DeploymentStatusReasonAnnotation)
+		}); err != nil {
+			return err
+		}
+	}
+	glog.Infof("Canary step %d of %d finished", number, len(params.Steps))
+	return nil
+}
+
+// checkRestarts returns an error if any container of the pods of deployment
+// restarted at least maxRestarts times.
+func (s *CanaryDeploymentStrategy) checkRestarts(deployment *kapi.ReplicationController, maxRestarts int) error {
+	pods, err := s.listPods(deployment.Namespace, labels.SelectorFromSet(deployment.Spec.Selector))
+	if err != nil {
+		return fmt.Errorf("couldn't list pods of deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.RestartCount >= maxRestarts {
+				return crashLoopError(fmt.Sprintf("container %s of pod %s is crash looping (%d restarts)", status.Name, pod.Name, status.RestartCount))
+			}
+		}
+	}
+	return nil
+}
+
+// crashLoopError is returned when the pods of a deployment crash loop.
+type crashLoopError string
+
+func (e crashLoopError) Error() string {
+	return string(e)
+}
+
+// restartCheckingAcceptor is an UpdateAcceptor which, once acceptor accepts a
+// deployment, also makes sure none of the pods of the deployment crash loop.
+type restartCheckingAcceptor struct {
+	acceptor    kubectl.UpdateAcceptor
+	strategy    *CanaryDeploymentStrategy
+	maxRestarts int
+}
+
+func (a *restartCheckingAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	if err := a.acceptor.Accept(deployment); err != nil {
+		return err
+	}
+	return a.strategy.checkRestarts(deployment, a.maxRestarts)
+}
+
+// abort scales from back up to replicas and to down to 0. Errors are logged
+// and ignored.
+func (s *CanaryDeploymentStrategy) abort(from, to *kapi.ReplicationController, replicas int, interval, timeout time.Duration) {
+	glog.Infof("Aborting canary deployment of %s", deployutil.LabelForDeployment(to))
+	if err := s.scale(from, replicas, interval, timeout); err != nil {
+		util.HandleError(err)
+	}
+	if err := s.scale(to, 0, interval, timeout); err != nil {
+		util.HandleError(err)
+	}
+}
+
+// scale scales deployment to replicas and waits for the replicas to exist.
+func (s *CanaryDeploymentStrategy) scale(deployment *kapi.ReplicationController, replicas int, interval, timeout time.Duration) error {
+	return scale(s.scaler, deployment, replicas, interval, timeout)
+}
+
+// updateAnnotations applies update to the annotations of the latest version of
+// deployment, persists it and returns the updated deployment. Conflicting
+// updates are retried up to canaryUpdateRetries times.
+func (s *CanaryDeploymentStrategy) updateAnnotations(deployment *kapi.ReplicationController, update func(annotations map[string]string)) (*kapi.ReplicationController, error) {
+	for i := 1; ; i++ {
+		current, err := s.client.GetReplicationController(deployment.Namespace, deployment.Name)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(deployment), err)
+		}
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		update(current.Annotations)
+		updated, err := s.client.UpdateReplicationController(current.Namespace, current)
+		if err != nil {
+			if kerrors.IsConflict(err) && i < canaryUpdateRetries {
+				glog.V(4).Infof("Retrying update of deployment %s after conflict: %v", deployutil.LabelForDeployment(deployment), err)
+				continue
+			}
+			return nil, fmt.Errorf("couldn't update deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+		}
+		return updated, nil
+	}
+}
+
+// canaryStepReplicas returns the replicas of the new deployment in step, which
+// is at least 1 and at most desiredReplicas.
+func canaryStepReplicas(step *deployapi.CanaryStep, desiredReplicas int) int {
+	replicas := desiredReplicas
+	switch {
+	case step.Replicas != nil:
+		replicas = *step.Replicas
+	case step.Percent != nil:
+		replicas = int(math.Ceil(float64(desiredReplicas) * float64(*step.Percent) / 100))
+	}
+	if replicas < 1 {
+		replicas = 1
+	}
+	if replicas > desiredReplicas {
+		replicas = desiredReplicas
+	}
+	return replicas
+}
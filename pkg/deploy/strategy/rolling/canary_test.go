@@ -0,0 +1,320 @@
+package rolling
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/labels"
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// canaryScaler records scale events and applies them to deployments.
+type canaryScaler struct {
+	scalertest.FakeScaler
+	deployments map[string]*kapi.ReplicationController
+}
+
+func (s *canaryScaler) Scale(namespace, name string, newSize uint, preconditions *kubectl.ScalePrecondition, retry, wait *kubectl.RetryParams) error {
+	s.deployments[name].Spec.Replicas = int(newSize)
+	return s.FakeScaler.Scale(namespace, name, newSize, preconditions, retry, wait)
+}
+
+func canaryDeployments(strategy deployapi.DeploymentStrategy) (*kapi.ReplicationController, *kapi.ReplicationController) {
+	fromConfig := deploytest.OkDeploymentConfig(1)
+	fromConfig.Template.Strategy = strategy
+	from, _ := deployutil.MakeDeployment(fromConfig, kapi.Codec)
+	from.Spec.Replicas = 4
+	config := deploytest.OkDeploymentConfig(2)
+	config.Template.Strategy = strategy
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	to.Spec.Replicas = 0
+	to.CreationTimestamp = kutil.Now()
+	return from, to
+}
+
+func newCanaryStrategy(t *testing.T, deployments map[string]*kapi.ReplicationController, scaler kubectl.Scaler, pods *kapi.PodList, rollingUpdate func(config *kubectl.RollingUpdaterConfig) error) *CanaryDeploymentStrategy {
+	copyOf := func(rc *kapi.ReplicationController) *kapi.ReplicationController {
+		copied := *rc
+		copied.Annotations = map[string]string{}
+		for k, v := range rc.Annotations {
+			copied.Annotations[k] = v
+		}
+		return &copied
+	}
+	return &CanaryDeploymentStrategy{
+		codec: api.Codec,
+		client: &rollingUpdaterClient{
+			GetReplicationControllerFn: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return copyOf(deployments[name]), nil
+			},
+			UpdateReplicationControllerFn: func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				deployments[rc.Name] = copyOf(rc)
+				return rc, nil
+			},
+		},
+		scaler: scaler,
+		listPods: func(namespace string, selector labels.Selector) (*kapi.PodList, error) {
+			return pods, nil
+		},
+		initialStrategy: &testStrategy{
+			deployFn: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor kubectl.UpdateAcceptor) error {
+				t.Fatalf("unexpected call to initial strategy")
+				return nil
+			},
+		},
+		rollingUpdate:     rollingUpdate,
+		getUpdateAcceptor: getUpdateAcceptor,
+	}
+}
+
+func TestCanary_deploySteps(t *testing.T) {
+	from, to := canaryDeployments(deploytest.OkCanaryStrategy())
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	var rollingConfig *kubectl.RollingUpdaterConfig
+	strategy := newCanaryStrategy(t, deployments, scaler, &kapi.PodList{}, func(config *kubectl.RollingUpdaterConfig) error {
+		rollingConfig = config
+		return nil
+	})
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		{Name: from.Name, Size: 3},
+		{Name: to.Name, Size: 2},
+		{Name: from.Name, Size: 2},
+	}
+	if e, a := len(expected), len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale events, got %d: %#v", e, a, scaler.Events)
+	}
+	for i := range expected {
+		if e, a := expected[i], scaler.Events[i]; e != a {
+			t.Errorf("expected scale event %#v, got %#v", e, a)
+		}
+	}
+
+	if rollingConfig == nil {
+		t.Fatalf("expected rolling update to be invoked")
+	}
+	if e, a := 2, rollingConfig.NewRc.Spec.Replicas; e != a {
+		t.Errorf("expected the rolling update to continue from %d replicas, got %d", e, a)
+	}
+	if e, a := "2", deployments[to.Name].Annotations[deployapi.DeploymentCanaryStepAnnotation]; e != a {
+		t.Errorf("expected canary step %s, got %s", e, a)
+	}
+}
+
+func TestCanary_deployManualApproval(t *testing.T) {
+	strategyConfig := deploytest.OkCanaryStrategy()
+	strategyConfig.CanaryParams.Steps[0].ManualApproval = true
+	from, to := canaryDeployments(strategyConfig)
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	waited := false
+	strategy := newCanaryStrategy(t, deployments, scaler, &kapi.PodList{}, func(config *kubectl.RollingUpdaterConfig) error {
+		return nil
+	})
+	client := strategy.client.(*rollingUpdaterClient)
+	update := client.UpdateReplicationControllerFn
+	client.UpdateReplicationControllerFn = func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+		// Approve the step once the deployment waits for it.
+		if rc.Annotations[deployapi.DeploymentStatusReasonAnnotation] == deployapi.DeploymentWaitingForApproval {
+			waited = true
+			rc.Annotations[deployapi.DeploymentCanaryApprovedStepAnnotation] = "1"
+		}
+		return update(namespace, rc)
+	}
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !waited {
+		t.Fatalf("expected the deployment to wait for approval")
+	}
+	if _, ok := deployments[to.Name].Annotations[deployapi.DeploymentStatusReasonAnnotation]; ok {
+		t.Errorf("expected the approval reason to be cleared")
+	}
+}
+
+func TestCanary_abortUnapprovedStepAtDeadline(t *testing.T) {
+	strategyConfig := deploytest.OkCanaryStrategy()
+	strategyConfig.CanaryParams.Steps[0].ManualApproval = true
+	from, to := canaryDeployments(strategyConfig)
+	// The deployer pod is about to reach its ActiveDeadlineSeconds.
+	to.CreationTimestamp = kutil.NewTime(time.Now().Add(-time.Duration(deployapi.MaxDeploymentDurationSeconds) * time.Second))
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	strategy := newCanaryStrategy(t, deployments, scaler, &kapi.PodList{}, func(config *kubectl.RollingUpdaterConfig) error {
+		t.Fatalf("unexpected call to rollingUpdate")
+		return nil
+	})
+
+	if err := strategy.Deploy(from, to, 4); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := 4, deployments[from.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", from.Name, e, a)
+	}
+	if e, a := 0, deployments[to.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", to.Name, e, a)
+	}
+	if _, ok := deployments[to.Name].Annotations[deployapi.DeploymentStatusReasonAnnotation]; ok {
+		t.Errorf("expected the approval reason to be cleared")
+	}
+}
+
+func TestCanary_deployLookupError(t *testing.T) {
+	from, to := canaryDeployments(deploytest.OkCanaryStrategy())
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	strategy := newCanaryStrategy(t, deployments, scaler, &kapi.PodList{}, func(config *kubectl.RollingUpdaterConfig) error {
+		t.Fatalf("unexpected call to rollingUpdate")
+		return nil
+	})
+	client := strategy.client.(*rollingUpdaterClient)
+	get := client.GetReplicationControllerFn
+	client.GetReplicationControllerFn = func(namespace, name string) (*kapi.ReplicationController, error) {
+		// The lookups fail once the steps are finished.
+		if len(scaler.Events) == 4 {
+			return nil, fmt.Errorf("lookup failed")
+		}
+		return get(namespace, name)
+	}
+
+	if err := strategy.Deploy(from, to, 4); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestCanary_deployUpdateConflict(t *testing.T) {
+	from, to := canaryDeployments(deploytest.OkCanaryStrategy())
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	strategy := newCanaryStrategy(t, deployments, scaler, &kapi.PodList{}, func(config *kubectl.RollingUpdaterConfig) error {
+		return nil
+	})
+	client := strategy.client.(*rollingUpdaterClient)
+	update := client.UpdateReplicationControllerFn
+	conflicts := 0
+	client.UpdateReplicationControllerFn = func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+		// Every first update of a step conflicts with another update.
+		if conflicts < 2 && rc.Annotations[deployapi.DeploymentCanaryStepAnnotation] == fmt.Sprintf("%d", conflicts+1) {
+			conflicts++
+			return nil, kerrors.NewConflict("ReplicationController", rc.Name, fmt.Errorf("conflict"))
+		}
+		return update(namespace, rc)
+	}
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 2, conflicts; e != a {
+		t.Fatalf("expected %d conflicts, got %d", e, a)
+	}
+	if e, a := "2", deployments[to.Name].Annotations[deployapi.DeploymentCanaryStepAnnotation]; e != a {
+		t.Errorf("expected canary step %s, got %s", e, a)
+	}
+}
+
+func TestCanary_abortCrashLoop(t *testing.T) {
+	from, to := canaryDeployments(deploytest.OkCanaryStrategy())
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	pods := &kapi.PodList{
+		Items: []kapi.Pod{
+			{
+				ObjectMeta: kapi.ObjectMeta{Name: "pod"},
+				Status: kapi.PodStatus{
+					ContainerStatuses: []kapi.ContainerStatus{
+						{Name: "container1", RestartCount: 3},
+					},
+				},
+			},
+		},
+	}
+	strategy := newCanaryStrategy(t, deployments, scaler, pods, func(config *kubectl.RollingUpdaterConfig) error {
+		t.Fatalf("unexpected call to rollingUpdate")
+		return nil
+	})
+
+	if err := strategy.Deploy(from, to, 4); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := 4, deployments[from.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", from.Name, e, a)
+	}
+	if e, a := 0, deployments[to.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", to.Name, e, a)
+	}
+}
+
+func TestCanary_stepReplicas(t *testing.T) {
+	intp := func(i int) *int { return &i }
+	tests := []struct {
+		step     deployapi.CanaryStep
+		desired  int
+		expected int
+	}{
+		{deployapi.CanaryStep{Replicas: intp(2)}, 4, 2},
+		{deployapi.CanaryStep{Replicas: intp(10)}, 4, 4},
+		{deployapi.CanaryStep{Percent: intp(10)}, 4, 1},
+		{deployapi.CanaryStep{Percent: intp(50)}, 5, 3},
+		{deployapi.CanaryStep{Percent: intp(100)}, 5, 5},
+	}
+	for i, test := range tests {
+		if e, a := test.expected, canaryStepReplicas(&test.step, test.desired); e != a {
+			t.Errorf("%d: expected %d replicas, got %d", i, e, a)
+		}
+	}
+}
+
+func TestCanary_abortCrashLoopDuringRollingUpdate(t *testing.T) {
+	from, to := canaryDeployments(deploytest.OkCanaryStrategy())
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+
+	// The pods only start crash looping once the steps are finished.
+	pods := &kapi.PodList{}
+	strategy := newCanaryStrategy(t, deployments, scaler, pods, func(config *kubectl.RollingUpdaterConfig) error {
+		pods.Items = []kapi.Pod{
+			{
+				ObjectMeta: kapi.ObjectMeta{Name: "pod"},
+				Status: kapi.PodStatus{
+					ContainerStatuses: []kapi.ContainerStatus{
+						{Name: "container1", RestartCount: 3},
+					},
+				},
+			},
+		}
+		return config.UpdateAcceptor.Accept(config.NewRc)
+	})
+
+	if err := strategy.Deploy(from, to, 4); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := 4, deployments[from.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", from.Name, e, a)
+	}
+	if e, a := 0, deployments[to.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", to.Name, e, a)
+	}
+}
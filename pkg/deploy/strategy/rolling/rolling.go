@@ -2,18 +2,23 @@ package rolling
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kclient "k8s.io/kubernetes/pkg/client"
+	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/wait"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
@@ -51,6 +56,11 @@ type RollingDeploymentStrategy struct {
 	// getUpdateAcceptor returns an UpdateAcceptor to verify the first replica
 	// of the deployment.
 	getUpdateAcceptor func(timeout time.Duration) kubectl.UpdateAcceptor
+	// scaler is used to scale replication controllers when updating with
+	// readiness gating.
+	scaler kubectl.Scaler
+	// listPods knows how to list the pods of a deployment.
+	listPods func(namespace string, selector labels.Selector) (*kapi.PodList, error)
 }
 
 // acceptingDeploymentStrategy is a DeploymentStrategy which accepts an
@@ -67,7 +77,7 @@ type acceptingDeploymentStrategy interface {
 const AcceptorInterval = 1 * time.Second
 
 // NewRollingDeploymentStrategy makes a new RollingDeploymentStrategy.
-func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
+func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, tagClient osclient.ImageStreamsNamespacer, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
 	updaterClient := &rollingUpdaterClient{
 		ControllerHasDesiredReplicasFn: func(rc *kapi.ReplicationController) wait.ConditionFunc {
 			return kclient.ControllerHasDesiredReplicas(client, rc)
@@ -92,10 +102,15 @@ func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, co
 			return fmt.Errorf("unexpected attempt to delete Deployment %s/%s", namespace, name)
 		},
 	}
+	scaler, _ := kubectl.ScalerFor("ReplicationController", updaterClient)
 	return &RollingDeploymentStrategy{
 		codec:           codec,
 		initialStrategy: initialStrategy,
 		client:          updaterClient,
+		scaler:          scaler,
+		listPods: func(namespace string, selector labels.Selector) (*kapi.PodList, error) {
+			return client.Pods(namespace).List(selector, fields.Everything())
+		},
 		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
 			updater := kubectl.NewRollingUpdater(namespace, updaterClient)
 			return updater.Update(config)
@@ -109,6 +124,7 @@ func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, co
 					return stratsupport.NewPodWatch(client, namespace, name, resourceVersion, stopChannel)
 				},
 			},
+			ImageStreamClient: tagClient,
 		},
 		getUpdateAcceptor: func(timeout time.Duration) kubectl.UpdateAcceptor {
 			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
@@ -170,22 +186,19 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		glog.Infof("Pre hook finished")
 	}
 
-	// HACK: Assign the source ID annotation that the rolling updater expects,
-	// unless it already exists on the deployment.
-	//
-	// Related upstream issue:
-	// https://github.com/GoogleCloudPlatform/kubernetes/pull/7183
-	to, err = s.client.GetReplicationController(to.Namespace, to.Name)
+	to, err = setSourceIdAnnotation(s.client, from, to)
 	if err != nil {
-		return fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(to), err)
+		return err
 	}
-	if _, hasSourceId := to.Annotations[sourceIdAnnotation]; !hasSourceId {
-		to.Annotations[sourceIdAnnotation] = fmt.Sprintf("%s:%s", from.Name, from.ObjectMeta.UID)
-		if updated, err := s.client.UpdateReplicationController(to.Namespace, to); err != nil {
-			return fmt.Errorf("couldn't assign source annotation to deployment %s: %v", deployutil.LabelForDeployment(to), err)
-		} else {
-			to = updated
+
+	// Replace the pods with readiness gating when the limits of the update are
+	// configured, instead of relying on the RollingUpdater.
+	if params.MaxUnavailable != nil || params.MaxSurge != nil || params.MinReadySeconds > 0 {
+		if err := s.updateWithReadiness(from, to, desiredReplicas, params); err != nil {
+			return err
 		}
+		s.executePost(params, to)
+		return nil
 	}
 
 	// HACK: There's a validation in the rolling updater which assumes that when
@@ -226,7 +239,12 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		return err
 	}
 
-	// Execute any post-hook. Errors are logged and ignored.
+	s.executePost(params, to)
+	return nil
+}
+
+// executePost executes any post-hook. Errors are logged and ignored.
+func (s *RollingDeploymentStrategy) executePost(params *deployapi.RollingDeploymentStrategyParams, to *kapi.ReplicationController) {
 	if params.Post != nil {
 		err := s.hookExecutor.Execute(params.Post, to, "posthook")
 		if err != nil {
@@ -235,10 +253,210 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 			glog.Info("Post hook finished")
 		}
 	}
+}
+
+// updateWithReadiness replaces the pods of from with the pods of to, never
+// running more than desiredReplicas+maxSurge pods and never having fewer than
+// desiredReplicas-maxUnavailable available pods. A pod of to is available
+// once it has been ready for at least MinReadySeconds; pods of from are
+// assumed to be available.
+//
+// If no progress is made within TimeoutSeconds, from is scaled back to its
+// original replicas, to is scaled down to 0 and an error is returned.
+func (s *RollingDeploymentStrategy) updateWithReadiness(from, to *kapi.ReplicationController, desiredReplicas int, params *deployapi.RollingDeploymentStrategyParams) error {
+	interval := time.Duration(*params.IntervalSeconds) * time.Second
+	timeout := time.Duration(*params.TimeoutSeconds) * time.Second
+	minReady := time.Duration(params.MinReadySeconds) * time.Second
+	maxUnavailable, maxSurge := rollingLimits(params, desiredReplicas)
+	minAvailable := desiredReplicas - maxUnavailable
+
+	glog.Infof("Starting rolling update from %s to %s (desired replicas: %d, maxUnavailable=%d, maxSurge=%d, minReadySeconds=%ds, intervalSeconds=%ds, timeoutSeconds=%ds)",
+		deployutil.LabelForDeployment(from),
+		deployutil.LabelForDeployment(to),
+		desiredReplicas,
+		maxUnavailable,
+		maxSurge,
+		params.MinReadySeconds,
+		*params.IntervalSeconds,
+		*params.TimeoutSeconds,
+	)
+
+	originalReplicas := from.Spec.Replicas
+	fromReplicas, toReplicas := from.Spec.Replicas, to.Spec.Replicas
+	readySince := map[string]time.Time{}
+	lastAvailable := -1
+	deadline := time.Now().Add(timeout)
+	for {
+		available, err := s.availablePods(to, readySince, minReady)
+		if err != nil {
+			return s.abort(from, to, originalReplicas, interval, timeout, err)
+		}
+		if available >= desiredReplicas && fromReplicas == 0 {
+			break
+		}
+
+		progressed := available > lastAvailable
+		lastAvailable = available
+
+		// Scale up to as far as the surge allows.
+		if replicas := minInt(desiredReplicas, desiredReplicas+maxSurge-fromReplicas); replicas > toReplicas {
+			if err := scale(s.scaler, to, replicas, interval, timeout); err != nil {
+				return s.abort(from, to, originalReplicas, interval, timeout, err)
+			}
+			toReplicas = replicas
+			progressed = true
+		}
+
+		// Scale down from as far as the available pods of to allow.
+		if replicas := maxInt(0, minAvailable-available); replicas < fromReplicas {
+			if err := scale(s.scaler, from, replicas, interval, timeout); err != nil {
+				return s.abort(from, to, originalReplicas, interval, timeout, err)
+			}
+			fromReplicas = replicas
+			progressed = true
+		}
+
+		now := time.Now()
+		if progressed {
+			deadline = now.Add(timeout)
+		} else if !now.Before(deadline) {
+			return s.abort(from, to, originalReplicas, interval, timeout, fmt.Errorf("pods of %s didn't become available within %ds (%d of %d available)", deployutil.LabelForDeployment(to), *params.TimeoutSeconds, available, desiredReplicas))
+		}
+		if !progressed {
+			time.Sleep(interval)
+		}
+	}
+	glog.Infof("All %d pods of %s are available", desiredReplicas, deployutil.LabelForDeployment(to))
+	return nil
+}
+
+// availablePods returns how many pods of deployment have been ready for at
+// least minReady. readySince records when each pod was first observed ready
+// and is updated by each call.
+func (s *RollingDeploymentStrategy) availablePods(deployment *kapi.ReplicationController, readySince map[string]time.Time, minReady time.Duration) (int, error) {
+	pods, err := s.listPods(deployment.Namespace, labels.SelectorFromSet(deployment.Spec.Selector))
+	if err != nil {
+		return 0, fmt.Errorf("couldn't list pods of deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	now := time.Now()
+	available := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || !kapi.IsPodReady(pod) {
+			delete(readySince, pod.Name)
+			continue
+		}
+		since, ok := readySince[pod.Name]
+		if !ok {
+			since = now
+			readySince[pod.Name] = now
+		}
+		if now.Sub(since) >= minReady {
+			available++
+		}
+	}
+	return available, nil
+}
+
+// abort scales from back up to replicas and to down to 0, and returns reason.
+// Errors while scaling are logged and ignored.
+func (s *RollingDeploymentStrategy) abort(from, to *kapi.ReplicationController, replicas int, interval, timeout time.Duration, reason error) error {
+	glog.Infof("Aborting rolling update of %s: %v", deployutil.LabelForDeployment(to), reason)
+	if err := scale(s.scaler, from, replicas, interval, timeout); err != nil {
+		util.HandleError(err)
+	}
+	if err := scale(s.scaler, to, 0, interval, timeout); err != nil {
+		util.HandleError(err)
+	}
+	return reason
+}
+
+// rollingLimits resolves the maxUnavailable and maxSurge of params for
+// desiredReplicas. Percentages round down for maxUnavailable and up for
+// maxSurge. If both limits resolve to 0, maxUnavailable is 1 so the update
+// can make progress.
+func rollingLimits(params *deployapi.RollingDeploymentStrategyParams, desiredReplicas int) (int, int) {
+	maxUnavailable, maxSurge := 0, 1
+	if params.MaxUnavailable != nil {
+		maxUnavailable = intOrPercent(params.MaxUnavailable, desiredReplicas, false)
+	}
+	if params.MaxSurge != nil {
+		maxSurge = intOrPercent(params.MaxSurge, desiredReplicas, true)
+	}
+	if maxUnavailable > desiredReplicas {
+		maxUnavailable = desiredReplicas
+	}
+	if maxUnavailable == 0 && maxSurge == 0 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable, maxSurge
+}
+
+// intOrPercent returns the absolute value of value, which is either a number
+// or a percentage of total. Percentages are rounded up if roundUp is true and
+// down otherwise.
+func intOrPercent(value *util.IntOrString, total int, roundUp bool) int {
+	if value.Kind == util.IntstrInt {
+		return value.IntVal
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+	if err != nil {
+		return 0
+	}
+	if roundUp {
+		return int(math.Ceil(float64(total) * float64(percent) / 100))
+	}
+	return int(math.Floor(float64(total) * float64(percent) / 100))
+}
 
+// scale scales deployment to replicas and waits for the replicas to exist.
+func scale(scaler kubectl.Scaler, deployment *kapi.ReplicationController, replicas int, interval, timeout time.Duration) error {
+	retry := kubectl.NewRetryParams(interval, timeout)
+	wait := kubectl.NewRetryParams(interval, timeout)
+	if err := scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{-1, ""}, retry, wait); err != nil {
+		return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(deployment), replicas, err)
+	}
 	return nil
 }
 
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// setSourceIdAnnotation returns the latest version of to with the source ID
+// annotation for from.
+//
+// HACK: Assign the source ID annotation that the rolling updater expects,
+// unless it already exists on the deployment.
+//
+// Related upstream issue:
+// https://github.com/GoogleCloudPlatform/kubernetes/pull/7183
+func setSourceIdAnnotation(client kubectl.RollingUpdaterClient, from, to *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+	to, err := client.GetReplicationController(to.Namespace, to.Name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(to), err)
+	}
+	if _, hasSourceId := to.Annotations[sourceIdAnnotation]; !hasSourceId {
+		to.Annotations[sourceIdAnnotation] = fmt.Sprintf("%s:%s", from.Name, from.ObjectMeta.UID)
+		if updated, err := client.UpdateReplicationController(to.Namespace, to); err != nil {
+			return nil, fmt.Errorf("couldn't assign source annotation to deployment %s: %v", deployutil.LabelForDeployment(to), err)
+		} else {
+			to = updated
+		}
+	}
+	return to, nil
+}
+
 type rollingUpdaterClient struct {
 	GetReplicationControllerFn     func(namespace, name string) (*kapi.ReplicationController, error)
 	UpdateReplicationControllerFn  func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error)
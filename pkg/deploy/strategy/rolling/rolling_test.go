@@ -8,10 +8,13 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	// kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/labels"
+	kutil "k8s.io/kubernetes/pkg/util"
 
 	api "github.com/openshift/origin/pkg/api/latest"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
@@ -269,6 +272,144 @@ func TestRolling_deployInitialHooks(t *testing.T) {
 	}
 }
 
+func readinessDeployments(params *deployapi.RollingDeploymentStrategyParams) (*kapi.ReplicationController, *kapi.ReplicationController) {
+	fromConfig := deploytest.OkDeploymentConfig(1)
+	fromConfig.Template.Strategy = deploytest.OkRollingStrategy()
+	from, _ := deployutil.MakeDeployment(fromConfig, kapi.Codec)
+	from.Spec.Replicas = 3
+	config := deploytest.OkDeploymentConfig(2)
+	config.Template.Strategy = deploytest.OkRollingStrategy()
+	config.Template.Strategy.RollingParams = params
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	to.Spec.Replicas = 0
+	return from, to
+}
+
+// readinessStrategy returns a strategy whose pod list contains ready pods
+// for every replica of to when ready is true.
+func readinessStrategy(t *testing.T, deployments map[string]*kapi.ReplicationController, scaler kubectl.Scaler, to *kapi.ReplicationController, ready bool) *RollingDeploymentStrategy {
+	return &RollingDeploymentStrategy{
+		codec: api.Codec,
+		client: &rollingUpdaterClient{
+			GetReplicationControllerFn: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return deployments[name], nil
+			},
+			UpdateReplicationControllerFn: func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				return rc, nil
+			},
+		},
+		initialStrategy: &testStrategy{
+			deployFn: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor kubectl.UpdateAcceptor) error {
+				t.Fatalf("unexpected call to initial strategy")
+				return nil
+			},
+		},
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+			t.Fatalf("unexpected call to rollingUpdate")
+			return nil
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		scaler:            scaler,
+		listPods: func(namespace string, selector labels.Selector) (*kapi.PodList, error) {
+			status := kapi.ConditionFalse
+			if ready {
+				status = kapi.ConditionTrue
+			}
+			pods := &kapi.PodList{}
+			for i := 0; i < deployments[to.Name].Spec.Replicas; i++ {
+				pods.Items = append(pods.Items, kapi.Pod{
+					ObjectMeta: kapi.ObjectMeta{Name: fmt.Sprintf("pod-%d", i)},
+					Status: kapi.PodStatus{
+						Conditions: []kapi.PodCondition{{Type: kapi.PodReady, Status: status}},
+					},
+				})
+			}
+			return pods, nil
+		},
+	}
+}
+
+func TestRolling_deployWithReadiness(t *testing.T) {
+	params := deploytest.OkRollingStrategy().RollingParams
+	maxUnavailable, maxSurge := kutil.NewIntOrStringFromInt(1), kutil.NewIntOrStringFromString("34%")
+	params.MaxUnavailable = &maxUnavailable
+	params.MaxSurge = &maxSurge
+	from, to := readinessDeployments(params)
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+	strategy := readinessStrategy(t, deployments, scaler, to, true)
+
+	if err := strategy.Deploy(from, to, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 34% of 3 replicas allows a surge of 2 pods.
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 2},
+		{Name: from.Name, Size: 2},
+		{Name: to.Name, Size: 3},
+		{Name: from.Name, Size: 0},
+	}
+	if e, a := len(expected), len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale events, got %d: %#v", e, a, scaler.Events)
+	}
+	for i := range expected {
+		if e, a := expected[i], scaler.Events[i]; e != a {
+			t.Errorf("expected scale event %#v, got %#v", e, a)
+		}
+	}
+}
+
+func TestRolling_deployWithReadinessTimeout(t *testing.T) {
+	params := deploytest.OkRollingStrategy().RollingParams
+	maxUnavailable := kutil.NewIntOrStringFromInt(0)
+	params.MaxUnavailable = &maxUnavailable
+	params.TimeoutSeconds = mkintp(1)
+	from, to := readinessDeployments(params)
+	deployments := map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}
+	scaler := &canaryScaler{deployments: deployments}
+	strategy := readinessStrategy(t, deployments, scaler, to, false)
+
+	if err := strategy.Deploy(from, to, 3); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := 3, deployments[from.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", from.Name, e, a)
+	}
+	if e, a := 0, deployments[to.Name].Spec.Replicas; e != a {
+		t.Errorf("expected %s to be scaled back to %d, got %d", to.Name, e, a)
+	}
+	for _, event := range scaler.Events {
+		if event.Name == from.Name && event.Size < 3 {
+			t.Errorf("expected %s not to be scaled down without available pods, got %#v", from.Name, event)
+		}
+	}
+}
+
+func TestRolling_rollingLimits(t *testing.T) {
+	intOrString := func(v kutil.IntOrString) *kutil.IntOrString { return &v }
+	tests := []struct {
+		maxUnavailable *kutil.IntOrString
+		maxSurge       *kutil.IntOrString
+		desired        int
+		unavailable    int
+		surge          int
+	}{
+		{nil, nil, 4, 0, 1},
+		{intOrString(kutil.NewIntOrStringFromInt(2)), nil, 4, 2, 1},
+		{intOrString(kutil.NewIntOrStringFromString("30%")), intOrString(kutil.NewIntOrStringFromString("30%")), 4, 1, 2},
+		{intOrString(kutil.NewIntOrStringFromInt(10)), nil, 4, 4, 1},
+		{intOrString(kutil.NewIntOrStringFromInt(0)), intOrString(kutil.NewIntOrStringFromString("0%")), 4, 1, 0},
+	}
+	for i, test := range tests {
+		params := &deployapi.RollingDeploymentStrategyParams{MaxUnavailable: test.maxUnavailable, MaxSurge: test.maxSurge}
+		unavailable, surge := rollingLimits(params, test.desired)
+		if unavailable != test.unavailable || surge != test.surge {
+			t.Errorf("%d: expected maxUnavailable=%d maxSurge=%d, got %d and %d", i, test.unavailable, test.surge, unavailable, surge)
+		}
+	}
+}
+
 type testStrategy struct {
 	deployFn func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor kubectl.UpdateAcceptor) error
 }
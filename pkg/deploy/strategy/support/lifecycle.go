@@ -17,8 +17,10 @@ import (
 	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/watch"
 
+	"github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	namer "github.com/openshift/origin/pkg/util/namer"
 )
 
@@ -26,6 +28,8 @@ import (
 type HookExecutor struct {
 	// PodClient provides access to pods.
 	PodClient HookExecutorPodClient
+	// ImageStreamClient provides access to image streams for tagging images.
+	ImageStreamClient client.ImageStreamsNamespacer
 }
 
 // Execute executes hook in the context of deployment. The label is used to
@@ -35,6 +39,8 @@ func (e *HookExecutor) Execute(hook *deployapi.LifecycleHook, deployment *kapi.R
 	switch {
 	case hook.ExecNewPod != nil:
 		err = e.executeExecNewPod(hook, deployment, label)
+	case len(hook.TagImages) > 0:
+		err = e.tagImages(hook, deployment)
 	}
 
 	if err == nil {
@@ -95,6 +101,59 @@ func (e *HookExecutor) executeExecNewPod(hook *deployapi.LifecycleHook, deployme
 	}
 }
 
+// tagImages tags the images of the containers referenced by a TagImages hook
+// onto the requested ImageStreamTags. Target image streams which don't exist
+// yet are created.
+func (e *HookExecutor) tagImages(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController) error {
+	for _, action := range hook.TagImages {
+		var image string
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if container.Name == action.ContainerName {
+				image = container.Image
+				break
+			}
+		}
+		if len(image) == 0 {
+			return fmt.Errorf("no container named '%s' found in deployment template", action.ContainerName)
+		}
+
+		namespace := action.To.Namespace
+		if len(namespace) == 0 {
+			namespace = deployment.Namespace
+		}
+		name, tag, ok := imageapi.SplitImageStreamTag(action.To.Name)
+		if !ok {
+			return fmt.Errorf("%q must be of the form <stream>:<tag>", action.To.Name)
+		}
+
+		streams := e.ImageStreamClient.ImageStreams(namespace)
+		stream, err := streams.Get(name)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return fmt.Errorf("couldn't get image stream %s/%s: %v", namespace, name, err)
+			}
+			stream = &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: name}}
+		}
+		if stream.Spec.Tags == nil {
+			stream.Spec.Tags = make(map[string]imageapi.TagReference)
+		}
+		ref := stream.Spec.Tags[tag]
+		ref.From = &kapi.ObjectReference{Kind: "DockerImage", Name: image}
+		stream.Spec.Tags[tag] = ref
+
+		if stream.CreationTimestamp.IsZero() {
+			_, err = streams.Create(stream)
+		} else {
+			_, err = streams.Update(stream)
+		}
+		if err != nil {
+			return fmt.Errorf("couldn't tag %s onto %s/%s: %v", image, namespace, action.To.Name, err)
+		}
+		glog.Infof("Tagged %s onto %s/%s", image, namespace, action.To.Name)
+	}
+	return nil
+}
+
 // makeHookPod makes a pod spec from a hook and deployment.
 func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) (*kapi.Pod, error) {
 	exec := hook.ExecNewPod
@@ -129,6 +188,34 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 		return nil, fmt.Errorf("couldn't clone ResourceRequirements: %v", err)
 	}
 
+	// Include the requested volumes of the pod template, mounted where the base
+	// container mounts them
+	var volumes []kapi.Volume
+	var volumeMounts []kapi.VolumeMount
+	for _, name := range exec.Volumes {
+		var volume *kapi.Volume
+		for i := range deployment.Spec.Template.Spec.Volumes {
+			if deployment.Spec.Template.Spec.Volumes[i].Name == name {
+				volume = &deployment.Spec.Template.Spec.Volumes[i]
+				break
+			}
+		}
+		if volume == nil {
+			return nil, fmt.Errorf("no volume named '%s' found in deployment template", name)
+		}
+		mounted := false
+		for _, mount := range baseContainer.VolumeMounts {
+			if mount.Name == name {
+				volumeMounts = append(volumeMounts, mount)
+				mounted = true
+			}
+		}
+		if !mounted {
+			return nil, fmt.Errorf("volume '%s' is not mounted by container '%s'", name, exec.ContainerName)
+		}
+		volumes = append(volumes, *volume)
+	}
+
 	// Assigning to a variable since its address is required
 	maxDeploymentDurationSeconds := deployapi.MaxDeploymentDurationSeconds
 
@@ -151,14 +238,16 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 		Spec: kapi.PodSpec{
 			Containers: []kapi.Container{
 				{
-					Name:       "lifecycle",
-					Image:      baseContainer.Image,
-					Command:    exec.Command,
-					WorkingDir: baseContainer.WorkingDir,
-					Env:        mergedEnv,
-					Resources:  resources,
+					Name:         "lifecycle",
+					Image:        baseContainer.Image,
+					Command:      exec.Command,
+					WorkingDir:   baseContainer.WorkingDir,
+					Env:          mergedEnv,
+					Resources:    resources,
+					VolumeMounts: volumeMounts,
 				},
 			},
+			Volumes:               volumes,
 			ActiveDeadlineSeconds: &maxDeploymentDurationSeconds,
 			// Setting the node selector on the hook pod so that it is created
 			// on the same set of nodes as the deployment pods.
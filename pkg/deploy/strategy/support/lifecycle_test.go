@@ -7,13 +7,18 @@ import (
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/client/cache"
+	ktestclient "k8s.io/kubernetes/pkg/client/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
 
+	"github.com/openshift/origin/pkg/client/testclient"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	namer "github.com/openshift/origin/pkg/util/namer"
 )
 
@@ -250,6 +255,51 @@ func TestHookExecutor_makeHookPodOk(t *testing.T) {
 	}
 }
 
+func TestHookExecutor_makeHookPodVolumes(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		ExecNewPod: &deployapi.ExecNewPodHook{
+			ContainerName: "container1",
+			Volumes:       []string{"data"},
+		},
+	}
+
+	config := deploytest.OkDeploymentConfig(1)
+	podSpec := &config.Template.ControllerTemplate.Template.Spec
+	podSpec.Volumes = []kapi.Volume{
+		{Name: "data", VolumeSource: kapi.VolumeSource{EmptyDir: &kapi.EmptyDirVolumeSource{}}},
+		{Name: "config", VolumeSource: kapi.VolumeSource{EmptyDir: &kapi.EmptyDirVolumeSource{}}},
+	}
+	podSpec.Containers[0].VolumeMounts = []kapi.VolumeMount{
+		{Name: "data", MountPath: "/var/lib/data"},
+		{Name: "config", MountPath: "/etc/config"},
+	}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	pod, err := makeHookPod(hook, deployment, "hook")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if e, a := []kapi.Volume{podSpec.Volumes[0]}, pod.Spec.Volumes; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected pod volumes %#v, got %#v", e, a)
+	}
+	if e, a := []kapi.VolumeMount{podSpec.Containers[0].VolumeMounts[0]}, pod.Spec.Containers[0].VolumeMounts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected volume mounts %#v, got %#v", e, a)
+	}
+
+	// Volumes which aren't in the template or not mounted by the container
+	// are rejected.
+	podSpec.Volumes = append(podSpec.Volumes, kapi.Volume{Name: "unmounted"})
+	deployment, _ = deployutil.MakeDeployment(config, kapi.Codec)
+	for _, name := range []string{"undefined", "unmounted"} {
+		hook.ExecNewPod.Volumes = []string{name}
+		if _, err := makeHookPod(hook, deployment, "hook"); err == nil {
+			t.Errorf("expected an error for volume %s", name)
+		}
+	}
+}
+
 func TestHookExecutor_makeHookPodRestart(t *testing.T) {
 	hook := &deployapi.LifecycleHook{
 		FailurePolicy: deployapi.LifecycleHookFailurePolicyRetry,
@@ -270,6 +320,101 @@ func TestHookExecutor_makeHookPodRestart(t *testing.T) {
 	}
 }
 
+func TestHookExecutor_tagImages(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		TagImages: []deployapi.TagImageHook{
+			{
+				ContainerName: "container1",
+				To:            kapi.ObjectReference{Kind: "ImageStreamTag", Name: "existing:prod-ready"},
+			},
+			{
+				ContainerName: "container2",
+				To:            kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "missing:prod-ready"},
+			},
+		},
+	}
+
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	deployment.Namespace = "test"
+
+	existing := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "existing", Namespace: "test", CreationTimestamp: kutil.Now()},
+		Spec: imageapi.ImageStreamSpec{
+			Tags: map[string]imageapi.TagReference{
+				"latest": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry:8080/repo1:old"}},
+			},
+		},
+	}
+	saved := map[string]*imageapi.ImageStream{}
+	client := &testclient.Fake{
+		ReactFn: func(action ktestclient.Action) (runtime.Object, error) {
+			switch action.GetVerb() {
+			case "get":
+				if action.GetNamespace() == "test" {
+					return existing, nil
+				}
+				return nil, kerrors.NewNotFound("imageStream", "missing")
+			case "create", "update":
+				stream := action.(ktestclient.CreateAction).GetObject().(*imageapi.ImageStream)
+				saved[action.GetVerb()+" "+action.GetNamespace()+"/"+stream.Name] = stream
+				return stream, nil
+			}
+			t.Fatalf("unexpected action: %#v", action)
+			return nil, nil
+		},
+	}
+
+	executor := &HookExecutor{ImageStreamClient: client}
+	if err := executor.Execute(hook, deployment, "hook"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, ok := saved["update test/existing"]
+	if !ok {
+		t.Fatalf("expected the existing stream to be updated, got %v", saved)
+	}
+	if e, a := "registry:8080/repo1:ref1", updated.Spec.Tags["prod-ready"].From.Name; e != a {
+		t.Errorf("expected existing:prod-ready to point at %s, got %s", e, a)
+	}
+	if _, ok := updated.Spec.Tags["latest"]; !ok {
+		t.Errorf("expected the other tags of the stream to be preserved")
+	}
+	created, ok := saved["create other/missing"]
+	if !ok {
+		t.Fatalf("expected the missing stream to be created, got %v", saved)
+	}
+	if e, a := "registry:8080/repo1:ref2", created.Spec.Tags["prod-ready"].From.Name; e != a {
+		t.Errorf("expected missing:prod-ready to point at %s, got %s", e, a)
+	}
+	if e, a := "DockerImage", created.Spec.Tags["prod-ready"].From.Kind; e != a {
+		t.Errorf("expected tag source kind %s, got %s", e, a)
+	}
+}
+
+func TestHookExecutor_tagImagesInvalidContainerRef(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		TagImages: []deployapi.TagImageHook{
+			{
+				ContainerName: "undefined",
+				To:            kapi.ObjectReference{Kind: "ImageStreamTag", Name: "stream:prod-ready"},
+			},
+		},
+	}
+
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+
+	client := &testclient.Fake{}
+	executor := &HookExecutor{ImageStreamClient: client}
+	if err := executor.Execute(hook, deployment, "hook"); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(client.Actions()) > 0 {
+		t.Errorf("unexpected actions: %#v", client.Actions())
+	}
+}
+
 func TestAcceptNewlyObservedReadyPods_scenarios(t *testing.T) {
 	scenarios := []struct {
 		name string
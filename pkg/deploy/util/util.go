@@ -16,7 +16,12 @@ import (
 
 // LatestDeploymentNameForConfig returns a stable identifier for config based on its version.
 func LatestDeploymentNameForConfig(config *deployapi.DeploymentConfig) string {
-	return fmt.Sprintf("%s-%d", config.Name, config.LatestVersion)
+	return DeploymentNameForConfigVersion(config.Name, config.LatestVersion)
+}
+
+// DeploymentNameForConfigVersion returns the name of the version of the named config.
+func DeploymentNameForConfigVersion(name string, version int) string {
+	return fmt.Sprintf("%s-%d", name, version)
 }
 
 // DeployerPodSuffix is the suffix added to pods created from a deployment
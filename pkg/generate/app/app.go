@@ -97,6 +97,10 @@ type SourceRef struct {
 	Dir        string
 	Name       string
 	ContextDir string
+
+	// DockerfileContents is an inline Dockerfile to build. When URL is not set
+	// the Dockerfile is the only source of the build.
+	DockerfileContents string
 }
 
 func urlWithoutRef(url url.URL) string {
@@ -109,17 +113,32 @@ func (r *SourceRef) SuggestName() (string, bool) {
 	if len(r.Name) > 0 {
 		return r.Name, true
 	}
+	if r.URL == nil {
+		return "", false
+	}
 	return nameFromGitURL(r.URL)
 }
 
 // BuildSource returns an OpenShift BuildSource from the SourceRef
 func (r *SourceRef) BuildSource() (*buildapi.BuildSource, []buildapi.BuildTriggerPolicy) {
+	var dockerfile *string
+	if len(r.DockerfileContents) > 0 {
+		dockerfile = &r.DockerfileContents
+	}
+	if r.URL == nil {
+		return &buildapi.BuildSource{
+			Type:       buildapi.BuildSourceDockerfile,
+			Dockerfile: dockerfile,
+			ContextDir: r.ContextDir,
+		}, nil
+	}
 	return &buildapi.BuildSource{
 			Type: buildapi.BuildSourceGit,
 			Git: &buildapi.GitBuildSource{
 				URI: urlWithoutRef(*r.URL),
 				Ref: r.Ref,
 			},
+			Dockerfile: dockerfile,
 			ContextDir: r.ContextDir,
 		}, []buildapi.BuildTriggerPolicy{
 			{
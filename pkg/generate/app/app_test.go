@@ -314,6 +314,51 @@ func TestSourceRefBuildSourceURI(t *testing.T) {
 	}
 }
 
+func TestSourceRefBuildSourceDockerfile(t *testing.T) {
+	dockerfile := "FROM centos:7\n"
+	s := SourceRef{DockerfileContents: dockerfile}
+	buildSource, triggers := s.BuildSource()
+	if buildSource.Type != buildapi.BuildSourceDockerfile || buildSource.Git != nil {
+		t.Errorf("unexpected build source: %#v", buildSource)
+	}
+	if buildSource.Dockerfile == nil || *buildSource.Dockerfile != dockerfile {
+		t.Errorf("unexpected Dockerfile: %v", buildSource.Dockerfile)
+	}
+	if len(triggers) != 0 {
+		t.Errorf("unexpected webhook triggers for a Dockerfile source: %#v", triggers)
+	}
+
+	u, _ := url.Parse("https://github.com/openshift/ruby-hello-world.git")
+	s = SourceRef{URL: u, DockerfileContents: dockerfile}
+	buildSource, _ = s.BuildSource()
+	if buildSource.Type != buildapi.BuildSourceGit || buildSource.Dockerfile == nil || *buildSource.Dockerfile != dockerfile {
+		t.Errorf("unexpected build source: %#v", buildSource)
+	}
+}
+
+func TestNewSourceRepositoryForDockerfile(t *testing.T) {
+	repo, err := NewSourceRepositoryForDockerfile("FROM centos:7\nEXPOSE 8080\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !repo.IsDockerBuild() {
+		t.Errorf("expected a Docker build")
+	}
+	if err := repo.Detect(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if from, ok := repo.Info().Dockerfile.GetDirective("FROM"); !ok || len(from) != 1 || from[0] != "centos:7" {
+		t.Errorf("unexpected FROM directive: %v", from)
+	}
+	_, source, err := StrategyAndSourceForRepository(repo, &ImageRef{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.URL != nil || len(source.DockerfileContents) == 0 {
+		t.Errorf("unexpected source ref: %#v", source)
+	}
+}
+
 func ExampleGenerateSimpleDockerApp() {
 	// TODO: determine if the repo is secured prior to fetching
 	// TODO: determine whether we want to clone this repo, or use it directly. Using it directly would require setting hooks
@@ -33,6 +33,7 @@ import (
 type AppConfig struct {
 	SourceRepositories util.StringList
 	ContextDir         string
+	Dockerfile         string
 
 	Components    util.StringList
 	ImageStreams  util.StringList
@@ -414,6 +415,31 @@ func (c *AppConfig) ensureHasSource(components app.ComponentReferences, reposito
 	return nil
 }
 
+// addDockerfile attaches the inline Dockerfile to the single source repository, or
+// creates a source repository backed only by the Dockerfile when no code was provided.
+func (c *AppConfig) addDockerfile() error {
+	if len(c.Dockerfile) == 0 {
+		return nil
+	}
+	if len(c.Strategy) != 0 && c.Strategy != "docker" {
+		return fmt.Errorf("when --dockerfile is specified the only supported strategy is docker")
+	}
+	_, repos, _ := c.refBuilder.Result()
+	switch len(repos) {
+	case 0:
+		repo, err := app.NewSourceRepositoryForDockerfile(c.Dockerfile)
+		if err != nil {
+			return err
+		}
+		c.refBuilder.AddExistingSourceRepository(repo)
+		return nil
+	case 1:
+		return repos[0].AddDockerfile(c.Dockerfile)
+	default:
+		return fmt.Errorf("--dockerfile cannot be used with multiple source repositories")
+	}
+}
+
 // detectSource runs a code detector on the passed in repositories to obtain a SourceRepositoryInfo
 func (c *AppConfig) detectSource(repositories []*app.SourceRepository) error {
 	errs := []error{}
@@ -748,6 +774,9 @@ func (c *AppConfig) run(out, errOut io.Writer, acceptors app.Acceptors) (*AppRes
 	if err != nil {
 		return nil, err
 	}
+	if err := c.addDockerfile(); err != nil {
+		return nil, err
+	}
 	components, repositories, environment, parameters, err := c.validate()
 	if err != nil {
 		return nil, err
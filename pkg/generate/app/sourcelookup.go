@@ -40,6 +40,11 @@ type SourceRepository struct {
 
 	usedBy          []ComponentReference
 	buildWithDocker bool
+
+	// dockerfileContents is an inline Dockerfile that replaces the one in the
+	// repository, or defines the whole build when ignoreRepository is set
+	dockerfileContents string
+	ignoreRepository   bool
 }
 
 // NewSourceRepository creates a reference to a local or remote source code repository from
@@ -56,6 +61,35 @@ func NewSourceRepository(s string) (*SourceRepository, error) {
 	}, nil
 }
 
+// NewSourceRepositoryForDockerfile creates a source repository that is defined only by
+// the contents of a Dockerfile and has no backing code repository.
+func NewSourceRepositoryForDockerfile(contents string) (*SourceRepository, error) {
+	r := &SourceRepository{
+		location:         "<inline Dockerfile>",
+		ignoreRepository: true,
+	}
+	if err := r.AddDockerfile(contents); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AddDockerfile sets the inline Dockerfile of the source repository. The Dockerfile
+// replaces any Dockerfile detected in the repository and forces a Docker build.
+func (r *SourceRepository) AddDockerfile(contents string) error {
+	node, err := dockerfile.NewParser().Parse(strings.NewReader(contents))
+	if err != nil {
+		return fmt.Errorf("the provided Dockerfile is not valid: %v", err)
+	}
+	if r.info == nil {
+		r.info = &SourceRepositoryInfo{}
+	}
+	r.info.Dockerfile = node
+	r.dockerfileContents = contents
+	r.buildWithDocker = true
+	return nil
+}
+
 // UsedBy sets up which component uses the source repository
 func (r *SourceRepository) UsedBy(ref ComponentReference) {
 	r.usedBy = append(r.usedBy, ref)
@@ -88,6 +122,9 @@ func (r *SourceRepository) String() string {
 // Detect clones source locally if not already local and runs code detection
 // with the given detector.
 func (r *SourceRepository) Detect(d Detector) error {
+	if r.ignoreRepository {
+		return nil
+	}
 	path, err := r.LocalPath()
 	if err != nil {
 		return err
@@ -284,6 +321,17 @@ func StrategyAndSourceForRepository(repo *SourceRepository, image *ImageRef) (*B
 	if image == nil {
 		return nil, nil, fmt.Errorf("an image ref is required to generate a strategy and sourceref")
 	}
+	if repo.ignoreRepository {
+		strategy := &BuildStrategyRef{
+			Base:          image,
+			IsDockerBuild: true,
+		}
+		source := &SourceRef{
+			DockerfileContents: repo.dockerfileContents,
+			ContextDir:         repo.ContextDir(),
+		}
+		return strategy, source, nil
+	}
 
 	remoteURL, err := repo.RemoteURL()
 	if err != nil {
@@ -294,9 +342,10 @@ func StrategyAndSourceForRepository(repo *SourceRepository, image *ImageRef) (*B
 		IsDockerBuild: repo.IsDockerBuild(),
 	}
 	source := &SourceRef{
-		URL:        remoteURL,
-		Ref:        remoteURL.Fragment,
-		ContextDir: repo.ContextDir(),
+		URL:                remoteURL,
+		Ref:                remoteURL.Fragment,
+		ContextDir:         repo.ContextDir(),
+		DockerfileContents: repo.dockerfileContents,
 	}
 	return strategy, source, nil
 }
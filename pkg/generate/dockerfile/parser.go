@@ -45,9 +45,9 @@ func (_ *parser) Parse(input io.Reader) (Dockerfile, error) {
 	}
 
 	d := dockerfile{}
-	scanner := bufio.NewScanner(input)
+	scanner := &lineScanner{Scanner: bufio.NewScanner(input)}
 	for {
-		line, ok := nextLine(scanner, true)
+		line, _, ok := nextLine(scanner, true)
 		if !ok {
 			break
 		}
@@ -60,6 +60,27 @@ func (_ *parser) Parse(input io.Reader) (Dockerfile, error) {
 	return d, nil
 }
 
+// FindLastDirective returns the first and the last line, counted from zero, of
+// the last instruction with the given name in the Dockerfile contents, and a flag
+// that is true if the directive was found. An instruction spans several lines
+// when it is continued with a backslash.
+func FindLastDirective(contents, name string) (int, int, bool) {
+	start, end, found := 0, 0, false
+	name = strings.ToLower(name)
+	scanner := &lineScanner{Scanner: bufio.NewScanner(strings.NewReader(contents))}
+	for {
+		line, first, ok := nextLine(scanner, true)
+		if !ok {
+			break
+		}
+		parts := dockerLineDelim.Split(line, 2)
+		if strings.ToLower(parts[0]) == name {
+			start, end, found = first, scanner.line-1, true
+		}
+	}
+	return start, end, found
+}
+
 // GetDirective returns a list of lines that begin with the given directive
 // and a flag that is true if the directive was found in the Dockerfile
 func (d dockerfile) GetDirective(s string) ([]string, bool) {
@@ -86,8 +107,25 @@ func stripContinuation(line string) string {
 	return line[:len(line)-1]
 }
 
-func nextLine(scanner *bufio.Scanner, trimLeft bool) (string, bool) {
+// lineScanner is a bufio.Scanner that counts the lines it has read.
+type lineScanner struct {
+	*bufio.Scanner
+	line int
+}
+
+func (s *lineScanner) Scan() bool {
+	if !s.Scanner.Scan() {
+		return false
+	}
+	s.line++
+	return true
+}
+
+// nextLine returns the next instruction, with its continuation lines joined,
+// and the number of the line it starts on.
+func nextLine(scanner *lineScanner, trimLeft bool) (string, int, bool) {
 	if scanner.Scan() {
+		start := scanner.line - 1
 		line := scanner.Text()
 		if trimLeft {
 			line = strings.TrimLeftFunc(line, unicode.IsSpace)
@@ -97,16 +135,16 @@ func nextLine(scanner *bufio.Scanner, trimLeft bool) (string, bool) {
 		}
 		if hasContinuation(line) {
 			line := stripContinuation(line)
-			next, ok := nextLine(scanner, false)
+			next, _, ok := nextLine(scanner, false)
 			if ok {
-				return line + next, true
+				return line + next, start, true
 			} else {
-				return line, true
+				return line, start, true
 			}
 		}
-		return line, true
+		return line, start, true
 	}
-	return "", false
+	return "", 0, false
 }
 
 var dockerLineDelim = regexp.MustCompile(`[\t\v\f\r ]+`)
@@ -49,6 +49,60 @@ func TestParseInvalidFile(t *testing.T) {
 	}
 }
 
+func TestFindLastDirective(t *testing.T) {
+	tests := []struct {
+		name       string
+		contents   string
+		directive  string
+		start, end int
+		found      bool
+	}{
+		{
+			name:      "single line",
+			contents:  "FROM centos\nRUN make\n",
+			directive: "FROM",
+			start:     0,
+			end:       0,
+			found:     true,
+		},
+		{
+			name:      "last of several",
+			contents:  "# builder\nFROM centos\nRUN make\n\nfrom fedora:22\nCMD run",
+			directive: "from",
+			start:     4,
+			end:       4,
+			found:     true,
+		},
+		{
+			name:      "continued",
+			contents:  "FROM centos\nRUN echo hello \\\n  world \\\n\n  again\nCMD run\n",
+			directive: "RUN",
+			start:     1,
+			end:       4,
+			found:     true,
+		},
+		{
+			name:      "not a directive",
+			contents:  "FROM centos\nRUN echo \\\nfrom the past\n",
+			directive: "FROM",
+			start:     0,
+			end:       0,
+			found:     true,
+		},
+		{
+			name:      "missing",
+			contents:  "FROM centos\nRUN make\n",
+			directive: "CMD",
+		},
+	}
+	for _, test := range tests {
+		start, end, found := FindLastDirective(test.contents, test.directive)
+		if start != test.start || end != test.end || found != test.found {
+			t.Errorf("%s: expected (%d, %d, %t), got (%d, %d, %t)", test.name, test.start, test.end, test.found, start, end, found)
+		}
+	}
+}
+
 func testDockerfile() io.Reader {
 	content := `FROM ubuntu:14.04
 RUN echo hello\